@@ -0,0 +1,95 @@
+package jsonlog
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// ReflectiveFormat formats an object like TextlogFormatter.Format, but walks the object with reflection
+// and parses the textlog tags for every call, as Format did before the encoders were compiled per type.
+// It serves as a baseline for tests and benchmarks.
+func ReflectiveFormat(t TextlogFormatter, object any) TextlogEntry {
+	entry := t.reflectiveEntry(reflect.ValueOf(object))
+	deduplicateKeys(entry)
+	return entry
+}
+
+func (t TextlogFormatter) reflectiveEntry(object reflect.Value) TextlogEntry {
+	for object.Kind() == reflect.Ptr || object.Kind() == reflect.Interface {
+		if object.IsNil() {
+			return nil
+		}
+		if marshaler, ok := object.Interface().(TextlogMarshaler); ok {
+			return withUppercaseKeys(marshaler.MarshalTextLog(t))
+		}
+		object = object.Elem()
+	}
+	if object.Kind() == reflect.Invalid {
+		return nil
+	}
+	if marshaler, ok := object.Interface().(TextlogMarshaler); ok {
+		return withUppercaseKeys(marshaler.MarshalTextLog(t))
+	}
+	switch object.Kind() {
+	case reflect.Struct:
+		var details TextlogEntry
+		for i := 0; i < object.NumField(); i++ {
+			typeField := object.Type().Field(i)
+			if !typeField.IsExported() {
+				continue
+			}
+			field := object.Field(i)
+			textlogTag := typeField.Tag.Get("textlog")
+			tagModifiers := strings.Split(textlogTag, ",")
+			logfield := strings.ToUpper(tagModifiers[0])
+			tagModifiers = tagModifiers[1:]
+			if logfield == "-" {
+				continue
+			}
+			if !typeField.Anonymous && textlogTag == "" && !slices.Contains(tagModifiers, modifierExplicit) {
+				continue
+			}
+			if slices.Contains(tagModifiers, modifierOmitempty) && isZero(field) {
+				continue
+			}
+			if t.Omit != nil && t.Omit(tagModifiers, field.Interface()) {
+				continue
+			}
+			if typeField.Anonymous || slices.Contains(tagModifiers, modifierExpand) {
+				for _, subentryValue := range t.reflectiveEntry(field) {
+					details = append(details, TextlogValuePair{
+						Key:   ConcatTextLabels(logfield, subentryValue.Key),
+						Value: subentryValue.Value,
+					})
+				}
+			} else {
+				if field.Kind() == reflect.Ptr && !field.IsNil() {
+					field = field.Elem()
+				}
+				details = append(details, TextlogValuePair{
+					Key:   logfield,
+					Value: t.format(field.Interface(), tagModifiers),
+				})
+			}
+		}
+		return details
+	case reflect.Slice:
+		var details TextlogEntry
+		for i := 0; i < object.Len(); i++ {
+			for _, subentryValue := range t.reflectiveEntry(object.Index(i)) {
+				details = append(details, TextlogValuePair{
+					Key:   ConcatTextLabels(subentryValue.Key, strconv.Itoa(i+1)),
+					Value: subentryValue.Value,
+				})
+			}
+		}
+		return details
+	case reflect.Map:
+		return t.mapEntry(object)
+	default:
+		return nil
+	}
+}
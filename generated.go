@@ -0,0 +1,53 @@
+package jsonlog
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/NextronSystems/jsonlog/jsonpointer"
+)
+
+// Generated contains reflection-free implementations of the JSON and text log encodings and of the
+// reference lookups for a single type, e.g. as generated by the jsonloggen tool in thorlog/v3.
+// Each function is called with a value of the type that the implementations were registered for. Functions may be nil,
+// in which case the reflection based implementation is used.
+//
+// Unlike methods, the implementations are only used for values of exactly this type. Types that embed
+// the type are encoded using reflection, so that their own fields are not lost.
+type Generated struct {
+	AppendJSON          func(object any, buf []byte) ([]byte, error)
+	MarshalTextLog      func(object any, t TextlogFormatter) TextlogEntry
+	RelativeJsonPointer func(object any, pointee any) jsonpointer.Pointer
+	RelativeTextPointer func(object any, pointee any) (string, bool)
+}
+
+// generatedTypes contains the registered implementations by type.
+var generatedTypes = map[reflect.Type]Generated{}
+
+// RegisterGenerated registers generated implementations for a type, which must be a pointer to a struct.
+// The implementations must produce the same output as the reflection based implementations.
+// RegisterGenerated must only be called during initialization, e.g. in an init function.
+func RegisterGenerated(typ reflect.Type, generated Generated) {
+	if typ.Kind() != reflect.Ptr || typ.Elem().Kind() != reflect.Struct {
+		panic("generated implementations must be registered for a pointer to a struct, not " + typ.String())
+	}
+	generatedTypes[typ] = generated
+}
+
+// MarshalJSON returns the JSON encoding of object like json.Marshal.
+// If generated implementations were registered for the type of object, they are used instead of reflection.
+func MarshalJSON(object any) ([]byte, error) {
+	return AppendJSON(nil, object)
+}
+
+// AppendJSON appends the JSON encoding of object to buf, like MarshalJSON.
+func AppendJSON(buf []byte, object any) ([]byte, error) {
+	if generated := generatedTypes[reflect.TypeOf(object)]; generated.AppendJSON != nil {
+		return generated.AppendJSON(object, buf)
+	}
+	encoded, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, encoded...), nil
+}
@@ -0,0 +1,75 @@
+package jsonlog
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/NextronSystems/jsonlog/jsonpointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type generatedObject struct {
+	ObjectHeader
+
+	Name string `json:"name" textlog:"name"`
+}
+
+type embeddingGeneratedObject struct {
+	generatedObject
+	Extra string `json:"extra" textlog:"extra"`
+}
+
+func init() {
+	RegisterGenerated(reflect.TypeOf((*generatedObject)(nil)), Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) {
+			return append(buf, `{"generated":true}`...), nil
+		},
+		MarshalTextLog: func(object any, t TextlogFormatter) TextlogEntry {
+			return TextlogEntry{{Key: "GENERATED", Value: object.(*generatedObject).Name}}
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return jsonpointer.Pointer{"generated"}
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return "GENERATED", true
+		},
+	})
+}
+
+func TestRegisterGenerated(t *testing.T) {
+	object := &generatedObject{Name: "a"}
+	data, err := MarshalJSON(object)
+	require.NoError(t, err)
+	assert.Equal(t, `{"generated":true}`, string(data))
+	data, err = AppendJSON([]byte("prefix "), object)
+	require.NoError(t, err)
+	assert.Equal(t, `prefix {"generated":true}`, string(data))
+	assert.Equal(t, TextlogEntry{{Key: "GENERATED", Value: "a"}}, TextlogFormatter{}.Format(object))
+	assert.Equal(t, jsonpointer.Pointer{"generated"}, RelativeJsonPointer(object, &object.Name))
+	label, found := RelativeTextLabel(object, &object.Name)
+	assert.True(t, found)
+	assert.Equal(t, "GENERATED", label)
+
+	// Values of other types than the registered one don't use the generated implementations
+	data, err = MarshalJSON(generatedObject{Name: "a"})
+	require.NoError(t, err)
+	assert.Equal(t, `{"type":"","name":"a"}`, string(data))
+
+	assert.Panics(t, func() { RegisterGenerated(reflect.TypeOf(generatedObject{}), Generated{}) })
+}
+
+func TestRegisterGenerated_Embedded(t *testing.T) {
+	object := &embeddingGeneratedObject{generatedObject: generatedObject{Name: "a"}, Extra: "b"}
+	expected, err := json.Marshal(object)
+	require.NoError(t, err)
+	data, err := MarshalJSON(object)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(data))
+	assert.Equal(t, `{"type":"","name":"a","extra":"b"}`, string(data))
+	assert.Equal(t, jsonpointer.Pointer{"extra"}, RelativeJsonPointer(object, &object.Extra))
+	label, found := RelativeTextLabel(object, &object.Extra)
+	assert.True(t, found)
+	assert.Equal(t, "EXTRA", label)
+}
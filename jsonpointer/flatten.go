@@ -0,0 +1,56 @@
+package jsonpointer
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+)
+
+// Field is a scalar value in a JSON document together with its location.
+type Field struct {
+	Pointer Pointer
+	// Value is a string, json.Number or bool.
+	Value any
+}
+
+// Flatten marshals value as JSON and returns all scalar values in the result.
+// Object members are visited in the order of their keys, array elements in their order.
+// Null values, empty objects and empty arrays are omitted.
+func Flatten(value any) ([]Field, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+	var fields []Field
+	flatten(decoded, Pointer{}, &fields)
+	return fields, nil
+}
+
+func flatten(value any, pointer Pointer, fields *[]Field) {
+	switch typedValue := value.(type) {
+	case nil:
+	case map[string]any:
+		keys := make([]string, 0, len(typedValue))
+		for key := range typedValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			flatten(typedValue[key], pointer.Append(key), fields)
+		}
+	case []any:
+		for i, element := range typedValue {
+			flatten(element, pointer.Append(strconv.Itoa(i)), fields)
+		}
+	default:
+		// Copy the pointer, since appending to it for siblings may overwrite its elements
+		*fields = append(*fields, Field{Pointer: append(Pointer{}, pointer...), Value: typedValue})
+	}
+}
@@ -0,0 +1,41 @@
+package jsonpointer
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	value := map[string]any{
+		"b":     []any{"x", map[string]any{"c/d": true}},
+		"a":     1.5,
+		"null":  nil,
+		"empty": map[string]any{},
+		"e":     map[string]any{"f": "g", "h": []string{}},
+	}
+	fields, err := Flatten(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, field := range fields {
+		got = append(got, field.Pointer.String())
+	}
+	want := []string{"/a", "/b/0", "/b/1/c~1d", "/e/f"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Flatten() pointers = %v, want %v", got, want)
+	}
+	if fields[0].Value != json.Number("1.5") {
+		t.Errorf("Flatten() value = %#v, want json.Number", fields[0].Value)
+	}
+	if fields[2].Value != true {
+		t.Errorf("Flatten() value = %#v, want true", fields[2].Value)
+	}
+}
+
+func TestFlatten_Error(t *testing.T) {
+	if _, err := Flatten(make(chan int)); err == nil {
+		t.Error("Flatten() error = nil, want error")
+	}
+}
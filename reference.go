@@ -59,6 +59,12 @@ func findRelativeJsonPointer(base reflect.Value, pointedField reflect.Value) jso
 		if base.Equal(pointedField) {
 			return jsonpointer.Pointer{}
 		}
+		if generated := generatedTypes[base.Type()].RelativeJsonPointer; generated != nil {
+			if base.IsNil() {
+				return nil
+			}
+			return generated(base.Interface(), pointedField.Interface())
+		}
 		if resolver, isResolver := base.Interface().(JsonReferenceResolver); isResolver {
 			return resolver.RelativeJsonPointer(pointedField.Interface())
 		}
@@ -107,6 +113,17 @@ func findRelativeJsonPointer(base reflect.Value, pointedField reflect.Value) jso
 	}
 }
 
+// RelativeJsonPointer returns the JSON pointer to pointee relative to base, or nil if pointee is not a field within base.
+// base must be a pointer to a struct, slice or array, and pointee must be a pointer to a (possibly nested) field of base.
+// It can be used to implement JsonReferenceResolver for parts of an object that do not need custom pointers.
+func RelativeJsonPointer(base any, pointee any) jsonpointer.Pointer {
+	baseValue := reflect.ValueOf(base)
+	if !baseValue.IsValid() || pointee == nil {
+		return nil
+	}
+	return findRelativeJsonPointer(baseValue, reflect.ValueOf(pointee))
+}
+
 // JsonReferenceResolver is an interface that can be implemented by a struct to create custom JSON pointers to its fields.
 // This interface should be implemented by log objects when the object has a custom MarshalJSON() and thus the default JSON pointer would not work.
 type JsonReferenceResolver interface {
@@ -117,10 +134,15 @@ type JsonReferenceResolver interface {
 }
 
 // ToTextLabel returns a text label for the pointed field.
+// If the base implements TextReferenceResolver, it is used to find the label.
 func (r *Reference) ToTextLabel() string {
 	if r.textLabel != "" {
 		return r.textLabel
 	}
+	if resolver, isResolver := r.Base.(TextReferenceResolver); isResolver {
+		r.textLabel, _ = resolver.RelativeTextPointer(r.PointedField)
+		return r.textLabel
+	}
 	baseValue := reflect.ValueOf(r.Base).Elem()
 	pointedValue := reflect.ValueOf(r.PointedField)
 	if pointedValue.Kind() != reflect.Ptr {
@@ -139,6 +161,17 @@ type TextReferenceResolver interface {
 	RelativeTextPointer(pointee any) (string, bool)
 }
 
+// RelativeTextLabel returns the text label of pointee relative to base.
+// base must be a pointer to a struct, and pointee must be a pointer to a (possibly nested) field of base.
+// It can be used to implement TextReferenceResolver for parts of an object that do not need custom labels.
+func RelativeTextLabel(base any, pointee any) (string, bool) {
+	baseValue := reflect.ValueOf(base)
+	if baseValue.Kind() != reflect.Ptr || baseValue.IsNil() || pointee == nil {
+		return "", false
+	}
+	return findTextLabel(baseValue.Elem(), reflect.ValueOf(pointee))
+}
+
 func findTextLabel(base reflect.Value, pointedField reflect.Value) (string, bool) {
 	if base.Addr().Equal(pointedField) {
 		return "", true
@@ -149,6 +182,9 @@ func findTextLabel(base reflect.Value, pointedField reflect.Value) (string, bool
 	if base.Kind() != reflect.Struct {
 		return "", false
 	}
+	if generated := generatedTypes[reflect.PointerTo(base.Type())].RelativeTextPointer; generated != nil && base.CanAddr() {
+		return generated(base.Addr().Interface(), pointedField.Interface())
+	}
 	for i := 0; i < base.NumField(); i++ {
 		field := base.Field(i)
 		typefield := base.Type().Field(i)
@@ -163,6 +199,9 @@ func findTextLabel(base reflect.Value, pointedField reflect.Value) (string, bool
 		var labelFound bool
 		if fieldPointer.Equal(pointedField) {
 			label, labelFound = "", true
+		} else if field.Kind() == reflect.Ptr && field.CanAddr() && field.Addr().Equal(pointedField) {
+			// The pointer field itself is referenced
+			label, labelFound = "", true
 		} else if resolver, isResolver := fieldPointer.Interface().(TextReferenceResolver); isResolver {
 			label, labelFound = resolver.RelativeTextPointer(pointedField.Interface())
 		} else {
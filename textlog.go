@@ -3,10 +3,9 @@ package jsonlog
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
-
-	"golang.org/x/exp/slices"
 )
 
 // TextlogEntry represents a single entry in a text log. It is a list of key-value pairs.
@@ -27,12 +26,38 @@ type TextlogValuePair struct {
 //   - explicit: causes the field to be marshalled even if the tag name is empty (requires that the containing struct has a non-empty tag name to avoid an empty text log key).
 //
 // Slice and map values are marshalled as a list of key-value pairs. The key is the index (one-based) for slices and the map key for maps.
+//
+// The textlog tags of a type are resolved once, when a value of that type is first formatted, and cached afterwards.
+// Modifier slices that are passed to FormatValue and Omit are shared between calls and must not be modified.
 type TextlogFormatter struct {
 	// FormatValue is a function that formats a single value into a string. If it is nil, fmt.Sprint is used.
 	FormatValue func(data any, modifiers []string) string
 	// Omit is a function that determines whether a field should be omitted from the log entry.
 	// If it is nil, no fields are omitted.
 	Omit func(modifiers []string, value any) bool
+	// OnError is called for errors that occur while formatting, e.g. for values that can not be represented in a text log.
+	// The affected values are omitted from the log entry. If OnError is nil, such errors are ignored.
+	OnError func(err error)
+	// MapOrder determines the order in which map entries are formatted. By default, they are sorted by key.
+	MapOrder MapOrder
+}
+
+// MapOrder determines the order in which a TextlogFormatter formats the entries of a map.
+type MapOrder int
+
+const (
+	// MapOrderSorted formats map entries sorted by key. Numeric keys are sorted by their value,
+	// all other keys by their string representation.
+	MapOrderSorted MapOrder = iota
+	// MapOrderInsertion formats map entries in insertion order if the map implements OrderedMap.
+	// Other maps are sorted by key, as with MapOrderSorted.
+	MapOrderInsertion
+)
+
+// OrderedMap can be implemented by map types that remember the order in which their keys were inserted.
+type OrderedMap interface {
+	// OrderedKeys returns the keys of the map in insertion order.
+	OrderedKeys() []any
 }
 
 func (t TextlogFormatter) format(data any, modifiers []string) string {
@@ -45,9 +70,29 @@ func (t TextlogFormatter) format(data any, modifiers []string) string {
 // Format formats an object into a text log entry.
 // The object must be a struct, pointer to a struct, slice, or map.
 func (t TextlogFormatter) Format(object any) TextlogEntry {
-	entry := t.toEntry(reflect.ValueOf(object))
-	// Deduplicate keys
+	entry := t.FormatEntry(object)
 	// Keys should already be unique, but this is not guaranteed and we need to guarantee this property for downstream consumers
+	deduplicateKeys(entry)
+	return entry
+}
+
+// FormatEntry formats an object into a text log entry like Format, but does not deduplicate the keys.
+// It is intended for TextlogMarshaler implementations that include the entry of another object in their own entry.
+func (t TextlogFormatter) FormatEntry(object any) TextlogEntry {
+	value := reflect.ValueOf(object)
+	if !value.IsValid() {
+		return nil
+	}
+	return encoderFor(value.Type())(t, value)
+}
+
+// FormatField formats a single field value with the given modifiers, using FormatValue if it is set.
+func (t TextlogFormatter) FormatField(value any, modifiers []string) string {
+	return t.format(value, modifiers)
+}
+
+// deduplicateKeys renames keys that occur multiple times in the entry by appending _2, _3, ... to them.
+func deduplicateKeys(entry TextlogEntry) {
 	keys := make(map[string]struct{})
 	for j := range entry {
 		pair := &entry[j]
@@ -62,7 +107,6 @@ func (t TextlogFormatter) Format(object any) TextlogEntry {
 		}
 		keys[pair.Key] = struct{}{}
 	}
-	return entry
 }
 
 const (
@@ -85,95 +129,52 @@ func withUppercaseKeys(entry TextlogEntry) TextlogEntry {
 	return entry
 }
 
-func (t TextlogFormatter) toEntry(object reflect.Value) TextlogEntry {
-	for object.Kind() == reflect.Ptr || object.Kind() == reflect.Interface {
-		if object.IsNil() {
-			return nil
-		}
-		if marshaler, ok := object.Interface().(TextlogMarshaler); ok {
-			return withUppercaseKeys(marshaler.MarshalTextLog(t))
-		}
-		object = object.Elem()
-	}
-	if object.Kind() == reflect.Invalid {
-		return nil
-	}
-	if marshaler, ok := object.Interface().(TextlogMarshaler); ok {
-		return withUppercaseKeys(marshaler.MarshalTextLog(t))
+func (t TextlogFormatter) mapEntry(object reflect.Value) TextlogEntry {
+	var details TextlogEntry
+	for _, key := range t.mapKeys(object) {
+		details = append(details, TextlogValuePair{
+			Key:   fmt.Sprint(key.Interface()),
+			Value: t.format(object.MapIndex(key).Interface(), nil),
+		})
 	}
-	switch object.Kind() {
-	case reflect.Struct:
-		var details TextlogEntry
-		for i := 0; i < object.NumField(); i++ {
-			typeField := object.Type().Field(i)
-			if !typeField.IsExported() {
-				continue
-			}
-			field := object.Field(i)
-			textlogTag := typeField.Tag.Get("textlog")
-			tagModifiers := strings.Split(textlogTag, ",")
-			logfield := strings.ToUpper(tagModifiers[0])
-			tagModifiers = tagModifiers[1:]
-			if logfield == "-" {
-				continue
-			}
-			if !typeField.Anonymous && textlogTag == "" && !slices.Contains(tagModifiers, modifierExplicit) {
-				continue
-			}
-			if slices.Contains(tagModifiers, modifierOmitempty) && isZero(field) {
-				continue
-			}
-			if t.Omit != nil && t.Omit(tagModifiers, field.Interface()) {
+	return details
+}
+
+// mapKeys returns the keys of a map in the order specified by the formatter's MapOrder.
+func (t TextlogFormatter) mapKeys(object reflect.Value) []reflect.Value {
+	if orderedMap, isOrdered := object.Interface().(OrderedMap); isOrdered && t.MapOrder == MapOrderInsertion {
+		var keys []reflect.Value
+		for _, key := range orderedMap.OrderedKeys() {
+			keyValue := reflect.ValueOf(key)
+			if !keyValue.IsValid() || !keyValue.Type().ConvertibleTo(object.Type().Key()) {
 				continue
 			}
-			if typeField.Anonymous || slices.Contains(tagModifiers, modifierExpand) {
-				// Use the tag as a prefix for the subfields
-				subentry := t.toEntry(field)
-				for _, subentryValue := range subentry {
-					details = append(details, TextlogValuePair{
-						Key:   ConcatTextLabels(logfield, subentryValue.Key),
-						Value: subentryValue.Value,
-					})
-				}
-			} else {
-				// Add the field as a single value
-				key := logfield
-				if field.Kind() == reflect.Ptr {
-					field = field.Elem()
-				}
-				details = append(details, TextlogValuePair{
-					Key:   key,
-					Value: t.format(field.Interface(), tagModifiers),
-				})
+			keyValue = keyValue.Convert(object.Type().Key())
+			if object.MapIndex(keyValue).IsValid() {
+				keys = append(keys, keyValue)
 			}
 		}
-		return details
-	case reflect.Slice:
-		var details TextlogEntry
-		for i := 0; i < object.Len(); i++ {
-			subentry := t.toEntry(object.Index(i))
-			for _, subentryValue := range subentry {
-				details = append(details, TextlogValuePair{
-					Key:   ConcatTextLabels(subentryValue.Key, strconv.Itoa(i+1)),
-					Value: subentryValue.Value,
-				})
-			}
-		}
-		return details
-	case reflect.Map:
-		if object.Type().Key().Kind() != reflect.String {
-			return nil
-		}
-		var details TextlogEntry
-		for _, key := range object.MapKeys() {
-			details = append(details, TextlogValuePair{
-				Key:   key.String(),
-				Value: t.format(object.MapIndex(key).Interface(), nil),
-			})
-		}
-		return details
+		return keys
+	}
+	keys := object.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return lessMapKey(keys[i], keys[j])
+	})
+	return keys
+}
+
+func lessMapKey(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.String:
+		return a.String() < b.String()
 	default:
-		return nil
+		return fmt.Sprint(a.Interface()) < fmt.Sprint(b.Interface())
 	}
 }
 
@@ -193,3 +194,17 @@ func isZero(v reflect.Value) bool {
 	}
 	return false
 }
+
+// String returns the entry as it appears in a text log, i.e. as KEY: VALUE pairs separated by spaces.
+func (e TextlogEntry) String() string {
+	var builder strings.Builder
+	for i, pair := range e {
+		if i > 0 {
+			builder.WriteString(" ")
+		}
+		builder.WriteString(pair.Key)
+		builder.WriteString(": ")
+		builder.WriteString(pair.Value)
+	}
+	return builder.String()
+}
@@ -63,3 +63,30 @@ func TestToDetails(t *testing.T) {
 		{"TIME", "0001-01-01T00:00:00Z"},
 	}, details)
 }
+
+func TestFormat_NilPointer(t *testing.T) {
+	var formatter TextlogFormatter
+	details := formatter.Format(struct {
+		Pointer *int `textlog:"pointer"`
+	}{})
+	assert.Equal(t, TextlogEntry{{"POINTER", "<nil>"}}, details)
+}
+
+type orderedTestMap map[string]int
+
+func (o orderedTestMap) OrderedKeys() []any {
+	return []any{"zeta", "alpha", "missing", "mu"}
+}
+
+func TestFormat_MapOrder(t *testing.T) {
+	var formatter TextlogFormatter
+	for i := 0; i < 10; i++ { // Map ordering is random, so try multiple times
+		assert.Equal(t, TextlogEntry{{"b", "2"}, {"c", "3"}, {"d", "4"}, {"e", "5"}}, formatter.Format(map[string]int{"e": 5, "c": 3, "d": 4, "b": 2}))
+		assert.Equal(t, TextlogEntry{{"-1", "minus"}, {"2", "b"}, {"10", "j"}}, formatter.Format(map[int]string{10: "j", 2: "b", -1: "minus"}))
+		assert.Equal(t, TextlogEntry{{"alpha", "1"}, {"mu", "2"}, {"zeta", "3"}}, formatter.Format(orderedTestMap{"zeta": 3, "alpha": 1, "mu": 2}))
+	}
+
+	formatter.MapOrder = MapOrderInsertion
+	assert.Equal(t, TextlogEntry{{"zeta", "3"}, {"alpha", "1"}, {"mu", "2"}}, formatter.Format(orderedTestMap{"zeta": 3, "alpha": 1, "mu": 2}))
+	assert.Equal(t, TextlogEntry{{"a", "1"}, {"b", "2"}}, formatter.Format(map[string]int{"b": 2, "a": 1}))
+}
@@ -0,0 +1,212 @@
+package jsonlog
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/exp/slices"
+)
+
+// textlogEncoder converts a value of a specific type into a text log entry.
+// Encoders are compiled once per type, so the textlog tags of a type are only parsed once.
+type textlogEncoder func(t TextlogFormatter, object reflect.Value) TextlogEntry
+
+// textlogEncoders caches the compiled encoders by type.
+var textlogEncoders sync.Map // map[reflect.Type]textlogEncoder
+
+var textlogMarshalerType = reflect.TypeOf((*TextlogMarshaler)(nil)).Elem()
+
+// encoderFor returns the compiled encoder for the given type, compiling it on first use.
+func encoderFor(typ reflect.Type) textlogEncoder {
+	if encoder, ok := textlogEncoders.Load(typ); ok {
+		return encoder.(textlogEncoder)
+	}
+	// Recursive types would compile forever, so store an encoder that waits for the
+	// compiled encoder before compiling it.
+	var (
+		wg      sync.WaitGroup
+		encoder textlogEncoder
+	)
+	wg.Add(1)
+	indirect, loaded := textlogEncoders.LoadOrStore(typ, textlogEncoder(func(t TextlogFormatter, object reflect.Value) TextlogEntry {
+		wg.Wait()
+		return encoder(t, object)
+	}))
+	if loaded {
+		return indirect.(textlogEncoder)
+	}
+	encoder = newTextlogEncoder(typ)
+	wg.Done()
+	textlogEncoders.Store(typ, encoder)
+	return encoder
+}
+
+func newTextlogEncoder(typ reflect.Type) textlogEncoder {
+	if typ.Kind() == reflect.Interface {
+		return encodeInterface
+	}
+	if generated := generatedTypes[typ].MarshalTextLog; generated != nil {
+		return func(t TextlogFormatter, object reflect.Value) TextlogEntry {
+			if object.IsNil() {
+				return nil
+			}
+			return generated(object.Interface(), t)
+		}
+	}
+	if typ.Implements(textlogMarshalerType) {
+		if typ.Kind() == reflect.Ptr {
+			return encodeMarshalerPointer
+		}
+		return encodeMarshaler
+	}
+	switch typ.Kind() {
+	case reflect.Ptr:
+		return newPointerEncoder(typ)
+	case reflect.Struct:
+		return newStructEncoder(typ)
+	case reflect.Slice:
+		return newSliceEncoder(typ)
+	case reflect.Map:
+		return TextlogFormatter.mapEntry
+	default:
+		return encodeNothing
+	}
+}
+
+func encodeNothing(TextlogFormatter, reflect.Value) TextlogEntry {
+	return nil
+}
+
+func encodeInterface(t TextlogFormatter, object reflect.Value) TextlogEntry {
+	if object.IsNil() {
+		return nil
+	}
+	if marshaler, ok := object.Interface().(TextlogMarshaler); ok {
+		return withUppercaseKeys(marshaler.MarshalTextLog(t))
+	}
+	object = object.Elem()
+	return encoderFor(object.Type())(t, object)
+}
+
+func encodeMarshaler(t TextlogFormatter, object reflect.Value) TextlogEntry {
+	return withUppercaseKeys(object.Interface().(TextlogMarshaler).MarshalTextLog(t))
+}
+
+func encodeMarshalerPointer(t TextlogFormatter, object reflect.Value) TextlogEntry {
+	if object.IsNil() {
+		return nil
+	}
+	return encodeMarshaler(t, object)
+}
+
+func newPointerEncoder(typ reflect.Type) textlogEncoder {
+	elemEncoder := encoderFor(typ.Elem())
+	return func(t TextlogFormatter, object reflect.Value) TextlogEntry {
+		if object.IsNil() {
+			return nil
+		}
+		return elemEncoder(t, object.Elem())
+	}
+}
+
+// textlogField is a struct field whose textlog tag has been resolved.
+type textlogField struct {
+	index     int
+	key       string
+	modifiers []string
+	omitempty bool
+	// expand is set for embedded fields and fields with the expand modifier.
+	// Their subfields are encoded using encoder.
+	expand  bool
+	encoder textlogEncoder
+}
+
+func newStructEncoder(typ reflect.Type) textlogEncoder {
+	var fields []textlogField
+	for i := 0; i < typ.NumField(); i++ {
+		typeField := typ.Field(i)
+		if !typeField.IsExported() {
+			continue
+		}
+		textlogTag := typeField.Tag.Get("textlog")
+		tagModifiers := strings.Split(textlogTag, ",")
+		logfield := strings.ToUpper(tagModifiers[0])
+		tagModifiers = tagModifiers[1:]
+		if logfield == "-" {
+			continue
+		}
+		if !typeField.Anonymous && textlogTag == "" && !slices.Contains(tagModifiers, modifierExplicit) {
+			continue
+		}
+		field := textlogField{
+			index:     i,
+			key:       logfield,
+			modifiers: tagModifiers,
+			omitempty: slices.Contains(tagModifiers, modifierOmitempty),
+			expand:    typeField.Anonymous || slices.Contains(tagModifiers, modifierExpand),
+		}
+		if field.expand {
+			field.encoder = encoderFor(typeField.Type)
+		}
+		fields = append(fields, field)
+	}
+	return func(t TextlogFormatter, object reflect.Value) TextlogEntry {
+		var details TextlogEntry
+		for i := range fields {
+			typeField := &fields[i]
+			field := object.Field(typeField.index)
+			if typeField.omitempty && isZero(field) {
+				continue
+			}
+			if t.Omit != nil && t.Omit(typeField.modifiers, field.Interface()) {
+				continue
+			}
+			if typeField.expand {
+				// Use the tag as a prefix for the subfields
+				subentry := typeField.encoder(t, field)
+				details = slices.Grow(details, len(subentry))
+				for _, subentryValue := range subentry {
+					details = append(details, TextlogValuePair{
+						Key:   ConcatTextLabels(typeField.key, subentryValue.Key),
+						Value: subentryValue.Value,
+					})
+				}
+			} else {
+				// Add the field as a single value
+				// Nil pointers are passed on as they are; dereferencing them would yield an invalid value
+				if field.Kind() == reflect.Ptr && !field.IsNil() {
+					field = field.Elem()
+				}
+				details = append(details, TextlogValuePair{
+					Key:   typeField.key,
+					Value: t.format(field.Interface(), typeField.modifiers),
+				})
+			}
+		}
+		return details
+	}
+}
+
+func newSliceEncoder(typ reflect.Type) textlogEncoder {
+	elemEncoder := encoderFor(typ.Elem())
+	return func(t TextlogFormatter, object reflect.Value) TextlogEntry {
+		var details TextlogEntry
+		for i := 0; i < object.Len(); i++ {
+			subentry := elemEncoder(t, object.Index(i))
+			if len(subentry) == 0 {
+				continue
+			}
+			details = slices.Grow(details, len(subentry))
+			index := strconv.Itoa(i + 1)
+			for _, subentryValue := range subentry {
+				details = append(details, TextlogValuePair{
+					Key:   ConcatTextLabels(subentryValue.Key, index),
+					Value: subentryValue.Value,
+				})
+			}
+		}
+		return details
+	}
+}
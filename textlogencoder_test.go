@@ -0,0 +1,97 @@
+package jsonlog_test
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/NextronSystems/jsonlog"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const benchmarkAssessment = `{"type":"THOR assessment","meta":{"time":"2024-09-24T14:18:46+02:00","level":"Alert","module":"Filescan","scan_id":"S-abcdef","event_id":"d2a1c9e0","hostname":"host"},"message":"Malicious file found","subject":{"type":"file","path":"/tmp/payload/beacon.exe","exists":"yes","extension":".exe","magic_header":"EXE","hashes":{"md5":"d41d8cd98f00b204e9800998ecf8427e","sha1":"da39a3ee5e6b4b0d3255bfef95601890afd80709","sha256":"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},"first_bytes":{"hex":"4d5a90000300000004000000ffff0000b8000000","ascii":"MZ"},"file_times":{"modified":"2024-09-20T10:00:00.123Z","accessed":"2024-09-24T09:00:00Z","changed":"2024-09-20T10:00:00Z"},"size":482304,"permissions":{"type":"Unix permissions","owner":"root","group":"root","mask":{"user":{"readable":true,"writable":true,"executable":true},"group":{"readable":true,"writable":false,"executable":true},"world":{"readable":false,"writable":false,"executable":false}}}},"score":95,"reasons":[{"type":"reason","summary":"Cobalt Strike beacon","signature":{"score":80,"reference":["https://example.com/report","https://example.com/iocs"],"origin":"internal","kind":"YARA Rule","date":"2024-01-01T00:00:00Z","tags":["APT","CobaltStrike"],"rule_name":"MAL_CobaltStrike_Beacon","description":"Detects Cobalt Strike beacons","author":"Florian Roth"},"matched":[{"data":{"data":"beacon.dll","encoding":"plain"},"offset":1024},{"data":{"data":"ReflectiveLoader","encoding":"plain"},"offset":2048}]},{"type":"reason","summary":"Suspicious location","signature":{"score":60,"origin":"internal","kind":"Filename IOC","description":"Executable in temp folder"},"matched":[{"data":{"data":"/tmp/","encoding":"plain"},"field":"/path"}]}],"reason_count":2,"context":[{"object":{"type":"process","pid":1337,"name":"beacon","command":"/tmp/payload/beacon.exe --connect","owner":"www-data","image":{"type":"file","path":"/tmp/payload/beacon.exe"},"parent_info":{"pid":1,"exe":"/sbin/init","command":"/sbin/init"},"tree":["/sbin/init","/tmp/payload/beacon.exe"],"created":"2024-09-24T08:00:00Z"},"relations":[{"relation_type":"loaded by","relation_name":"process","unique":true}]}],"log_version":"v3.0.0"}`
+
+func loadBenchmarkAssessment(t testing.TB) *thorlog.Assessment {
+	// Decode strictly, so that fields that don't exist in the assessment are noticed
+	var object thorlog.EmbeddedObject
+	require.NoError(t, json.Unmarshal([]byte(benchmarkAssessment), &object))
+	require.IsType(t, &thorlog.Assessment{}, object.Object)
+	return object.Object.(*thorlog.Assessment)
+}
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestFormat_Golden(t *testing.T) {
+	for _, testcase := range []struct {
+		name      string
+		formatter jsonlog.TextlogFormatter
+	}{
+		{"plain", jsonlog.TextlogFormatter{}},
+		{"thor", thorlog.NewTextlogFormatter()},
+		{"omit", jsonlog.TextlogFormatter{Omit: func(modifiers []string, value any) bool { return len(modifiers) > 0 }}},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			entry := testcase.formatter.Format(loadBenchmarkAssessment(t))
+			assert.Equal(t, jsonlog.ReflectiveFormat(testcase.formatter, loadBenchmarkAssessment(t)), entry)
+			var actual strings.Builder
+			for _, pair := range entry {
+				fmt.Fprintf(&actual, "%s: %s\n", pair.Key, pair.Value)
+			}
+			goldenFile := "testdata/assessment_" + testcase.name + ".txt"
+			if *update {
+				require.NoError(t, os.WriteFile(goldenFile, []byte(actual.String()), 0644))
+			}
+			expected, err := os.ReadFile(goldenFile)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), actual.String())
+		})
+	}
+}
+
+func TestFormat_Values(t *testing.T) {
+	var formatter jsonlog.TextlogFormatter
+	assert.Nil(t, formatter.Format(nil))
+	type element struct {
+		Name string `textlog:"name"`
+	}
+	assert.Equal(t, jsonlog.TextlogEntry{{Key: "NAME_1", Value: "a"}, {Key: "NAME_2", Value: "b"}}, formatter.Format([]element{{"a"}, {"b"}}))
+	assert.Equal(t, jsonlog.TextlogEntry{{Key: "1", Value: "a"}, {Key: "2", Value: "b"}}, formatter.Format(map[int]string{2: "b", 1: "a"}))
+}
+
+func TestFormat_EmptyObjects(t *testing.T) {
+	formatter := thorlog.NewTextlogFormatter()
+	for name, object := range thorlog.LogObjectTypes {
+		empty := reflect.New(reflect.TypeOf(object).Elem()).Interface()
+		assert.NotPanics(t, func() { formatter.Format(empty) }, name)
+	}
+}
+
+func BenchmarkFormat(b *testing.B) {
+	assessment := loadBenchmarkAssessment(b)
+	for _, benchmark := range []struct {
+		name      string
+		formatter jsonlog.TextlogFormatter
+	}{
+		{"Plain", jsonlog.TextlogFormatter{}},
+		{"THOR", thorlog.NewTextlogFormatter()},
+	} {
+		b.Run(benchmark.name+"/Compiled", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchmark.formatter.Format(assessment)
+			}
+		})
+		b.Run(benchmark.name+"/Reflective", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				jsonlog.ReflectiveFormat(benchmark.formatter, assessment)
+			}
+		})
+	}
+}
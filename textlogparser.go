@@ -0,0 +1,37 @@
+package jsonlog
+
+import (
+	"regexp"
+)
+
+// textlogKey matches a key in a text log entry, including the separating whitespace before it
+// and the colon (and space, unless the key is at the end of the line) after it.
+var textlogKey = regexp.MustCompile(`(?:^| )([A-Z][A-Z0-9_]*):(?: |$)`)
+
+// ParseTextlogEntry parses the body of a text log line (a sequence of KEY: VALUE pairs) into a TextlogEntry.
+//
+// Keys must consist of upper case letters, digits and underscores, and start with a letter.
+// Values may contain spaces and colons; a value ends where the next key starts.
+// Consequently, a value that itself contains an upper case word followed by ": " can not be
+// distinguished from a new key and is split at this point.
+//
+// Any text before the first key is ignored. If a key occurs multiple times, it is renamed
+// the same way as in TextlogFormatter.Format (by appending _2, _3, ...), so keys in the
+// returned entry are always unique.
+func ParseTextlogEntry(text string) TextlogEntry {
+	matches := textlogKey.FindAllStringSubmatchIndex(text, -1)
+	var entry TextlogEntry
+	for i, match := range matches {
+		valueStart := match[1]
+		valueEnd := len(text)
+		if i+1 < len(matches) {
+			valueEnd = matches[i+1][0]
+		}
+		entry = append(entry, TextlogValuePair{
+			Key:   text[match[2]:match[3]],
+			Value: text[valueStart:valueEnd],
+		})
+	}
+	deduplicateKeys(entry)
+	return entry
+}
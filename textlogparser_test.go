@@ -0,0 +1,66 @@
+package jsonlog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTextlogEntry(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want TextlogEntry
+	}{
+		{
+			name: "empty",
+			text: "",
+			want: nil,
+		},
+		{
+			name: "simple",
+			text: "KEY1: value1 KEY2: value2",
+			want: TextlogEntry{{"KEY1", "value1"}, {"KEY2", "value2"}},
+		},
+		{
+			name: "values with spaces and colons",
+			text: "MESSAGE: Found file: C:\\Windows\\evil.exe TIME: 12:00:00 Z",
+			want: TextlogEntry{{"MESSAGE", "Found file: C:\\Windows\\evil.exe"}, {"TIME", "12:00:00 Z"}},
+		},
+		{
+			name: "empty values",
+			text: "KEY1:  KEY2: value2 KEY3:",
+			want: TextlogEntry{{"KEY1", ""}, {"KEY2", "value2"}, {"KEY3", ""}},
+		},
+		{
+			name: "duplicate keys",
+			text: "FILE: a FILE: b FILE_2: c",
+			want: TextlogEntry{{"FILE", "a"}, {"FILE_2", "b"}, {"FILE_2_2", "c"}},
+		},
+		{
+			name: "deduplicated keys",
+			text: "FILE: a FILE_2: b",
+			want: TextlogEntry{{"FILE", "a"}, {"FILE_2", "b"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseTextlogEntry(tt.text))
+		})
+	}
+}
+
+func TestParseTextlogEntry_RoundTrip(t *testing.T) {
+	var test = struct {
+		Message string   `textlog:"message"`
+		Path    string   `textlog:"file"`
+		Files   []string `textlog:"file"`
+		Empty   string   `textlog:"empty"`
+	}{
+		Message: "Suspicious file: found",
+		Path:    "C:\\Program Files\\test.exe",
+		Files:   []string{"a b", "c"},
+	}
+	entry := TextlogFormatter{}.Format(test)
+	assert.Equal(t, entry, ParseTextlogEntry(entry.String()))
+}
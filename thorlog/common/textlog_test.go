@@ -0,0 +1,73 @@
+package common
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+type testEvent struct {
+	Meta LogEventMetadata `textlog:",expand"`
+	Text string           `textlog:"message"`
+}
+
+func (t *testEvent) Metadata() *LogEventMetadata { return &t.Meta }
+
+func (t *testEvent) Message() string { return t.Text }
+
+func (t *testEvent) Version() Version { return "v1.0.0" }
+
+func TestTextlogWriter_Write(t *testing.T) {
+	event := &testEvent{
+		Meta: LogEventMetadata{
+			Time:   time.Date(2024, 9, 24, 12, 35, 41, 123456789, time.UTC),
+			Lvl:    Warning,
+			Mod:    "Filescan",
+			Source: "host",
+		},
+		Text: "Suspicious file found",
+	}
+	tests := []struct {
+		name   string
+		writer TextlogWriter
+		want   string
+	}{
+		{
+			name: "default",
+			want: "2024-09-24T12:35:41Z host THOR: Warning: MODULE: Filescan MESSAGE: Suspicious file found\n",
+		},
+		{
+			name:   "milliseconds",
+			writer: TextlogWriter{Precision: time.Millisecond},
+			want:   "2024-09-24T12:35:41.123Z host THOR: Warning: MODULE: Filescan MESSAGE: Suspicious file found\n",
+		},
+		{
+			name:   "nanoseconds",
+			writer: TextlogWriter{Precision: time.Nanosecond},
+			want:   "2024-09-24T12:35:41.123456789Z host THOR: Warning: MODULE: Filescan MESSAGE: Suspicious file found\n",
+		},
+		{
+			name:   "timezone",
+			writer: TextlogWriter{Location: time.FixedZone("CEST", 2*60*60)},
+			want:   "2024-09-24T14:35:41+02:00 host THOR: Warning: MODULE: Filescan MESSAGE: Suspicious file found\n",
+		},
+		{
+			name:   "line terminator",
+			writer: TextlogWriter{LineTerminator: "\r\n"},
+			want:   "2024-09-24T12:35:41Z host THOR: Warning: MODULE: Filescan MESSAGE: Suspicious file found\r\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writer := tt.writer
+			writer.w = &buf
+			if err := writer.Write(event); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("TextlogWriter.Write() = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
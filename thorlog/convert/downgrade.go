@@ -0,0 +1,152 @@
+package convert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	thorlogv2 "github.com/NextronSystems/jsonlog/thorlog/v2"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"golang.org/x/mod/semver"
+)
+
+// Downgrade converts a version 3 event to a version 2 event, e.g. for parsers that expect the v2 JSON format.
+//
+// The fields of the v2 event follow the conventions of the v2 format:
+//   - A File or Process subject is stored as a "file" or "process" subobject. Subjects of other types
+//     are stored as top level fields.
+//   - Reasons are stored as a "reasons" list, with the reason's summary stored as "name" and
+//     its string matches as a "matched" list.
+//   - Scalar message fields keep their type; other fields are stored as strings.
+//
+// Keys and values are derived from the text log representation of the v3 event, using the given formatter.
+// Consequently, the text log of the returned event is the same as the text log of the v3 event,
+// except for the formatting of string matches, which follows the v2 conventions.
+func Downgrade(event common.Event, formatter jsonlog.TextlogFormatter) (*thorlogv2.Event, error) {
+	var fields thorlogv2.Fields
+	switch typedEvent := event.(type) {
+	case *thorlogv2.Event:
+		return typedEvent, nil
+	case *thorlog.Assessment:
+		fields = downgradeAssessment(typedEvent, formatter)
+	case *thorlog.Message:
+		fields = downgradeMessage(typedEvent, formatter)
+	default:
+		return nil, fmt.Errorf("unsupported event type %T", event)
+	}
+	return &thorlogv2.Event{
+		LogEventMetadata: thorlogv2.Metadata(*event.Metadata()),
+		Data:             fields,
+		EventVersion:     common.Version(semver.Canonical(common.JsonV2)),
+	}, nil
+}
+
+func downgradeMessage(message *thorlog.Message, formatter jsonlog.TextlogFormatter) thorlogv2.Fields {
+	fields := thorlogv2.Fields{{Key: "message", Value: message.Text}}
+	for _, field := range message.Fields {
+		if isScalar(field.Value) {
+			fields = append(fields, thorlogv2.Field{Key: field.Key, Value: field.Value})
+		} else {
+			fields = append(fields, toV2Fields(formatter.Format(thorlog.MessageFields{field}))...)
+		}
+	}
+	return fields
+}
+
+// isScalar returns whether the value is rendered as a single value in both the v2 and the v3 text log.
+func isScalar(value any) bool {
+	if value == nil {
+		return true
+	}
+	switch reflect.TypeOf(value).Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return true
+	default:
+		return false
+	}
+}
+
+func downgradeAssessment(assessment *thorlog.Assessment, formatter jsonlog.TextlogFormatter) thorlogv2.Fields {
+	fields := thorlogv2.Fields{
+		{Key: "message", Value: assessment.Text},
+		{Key: "score", Value: assessment.Score},
+	}
+
+	switch subject := assessment.Subject.(type) {
+	case nil:
+	case *thorlog.File:
+		subjectFields := toV2Fields(formatter.Format(subject))
+		for i := range subjectFields {
+			if subjectFields[i].Key == "file" {
+				subjectFields[i].Key = "path" // Restored to "file" for the text log
+			}
+		}
+		fields = append(fields, thorlogv2.Field{Key: "file", Value: subjectFields})
+	case *thorlog.Process:
+		fields = append(fields, thorlogv2.Field{Key: "process", Value: toV2Fields(formatter.Format(subject))})
+	default:
+		fields = append(fields, toV2Fields(formatter.Format(subject))...)
+	}
+
+	if len(assessment.Reasons) > 0 {
+		var reasons []any
+		for _, reason := range assessment.Reasons {
+			reasons = append(reasons, downgradeReason(reason, formatter))
+		}
+		fields = append(fields, thorlogv2.Field{Key: "reasons", Value: reasons})
+	}
+
+	var remainder = struct {
+		ReasonCount  int             `textlog:"reasons_count,omitempty"`
+		EventContext thorlog.Context `textlog:",expand"`
+	}{assessment.ReasonCount, assessment.EventContext}
+	fields = append(fields, toV2Fields(formatter.Format(remainder))...)
+	return fields
+}
+
+func downgradeReason(reason thorlog.Reason, formatter jsonlog.TextlogFormatter) thorlogv2.Fields {
+	var fields thorlogv2.Fields
+	for _, field := range toV2Fields(formatter.Format(reason)) {
+		switch field.Key {
+		case "reason":
+			field.Key = "name" // Restored to "reason" for the text log
+		case "matched":
+			if len(reason.StringMatches) > 0 {
+				field.Value = downgradeMatches(reason.StringMatches)
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func downgradeMatches(matches thorlog.MatchStrings) []any {
+	var matchList []any
+	for _, match := range matches {
+		matchFields := thorlogv2.Fields{{Key: "data", Value: string(match.Match.Data())}}
+		if match.Context != nil {
+			matchFields = append(matchFields, thorlogv2.Field{Key: "context", Value: string(match.Context.Data())})
+		}
+		if match.Offset != nil && !match.HideOffset {
+			matchFields = append(matchFields, thorlogv2.Field{Key: "offset", Value: *match.Offset})
+		}
+		if match.Field != nil {
+			matchFields = append(matchFields, thorlogv2.Field{Key: "field", Value: match.Field.String()})
+		}
+		matchList = append(matchList, matchFields)
+	}
+	return matchList
+}
+
+// toV2Fields converts a text log entry to v2 fields with lower case keys.
+func toV2Fields(entry jsonlog.TextlogEntry) thorlogv2.Fields {
+	var fields thorlogv2.Fields
+	for _, pair := range entry {
+		fields = append(fields, thorlogv2.Field{Key: strings.ToLower(pair.Key), Value: pair.Value})
+	}
+	return fields
+}
@@ -0,0 +1,113 @@
+package convert
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	thorlogv2 "github.com/NextronSystems/jsonlog/thorlog/v2"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDowngrade_Textlog(t *testing.T) {
+	meta := thorlog.LogEventMetadata{
+		Time:   mustTime("2024-09-24T12:35:41Z"),
+		Lvl:    common.Alert,
+		Mod:    "Filescan",
+		ScanID: "S-abc",
+		Source: "host",
+	}
+
+	file := thorlog.NewFile("C:\\evil.exe")
+	file.Hashes = &thorlog.FileHashes{Md5: "d41d8cd98f00b204e9800998ecf8427e"}
+	file.Size = 1234
+	fileAssessment := thorlog.NewAssessment(file, "Malware file found")
+	fileAssessment.Meta = meta
+	fileAssessment.Score = 80
+	fileAssessment.Reasons = []thorlog.Reason{
+		thorlog.NewReason("YARA rule Evil", thorlog.Signature{Score: 80, Rulename: "Evil", Class: thorlog.ClassYaraRule}, nil),
+		thorlog.NewReason("Filename IOC", thorlog.Signature{Score: 20, Tags: thorlog.StringList{"APT", "MAL"}}, nil),
+	}
+	fileAssessment.ReasonCount = 2
+	fileAssessment.EventContext = thorlog.Context{
+		{
+			Object:    thorlog.NewFile("C:\\archive.zip"),
+			Relations: []thorlog.Relation{{Type: "derives from", Name: "parent", Unique: true}},
+		},
+	}
+
+	process := thorlog.NewProcess(1234)
+	process.Name = "evil"
+	process.Image = thorlog.NewFile("/tmp/evil")
+	processAssessment := thorlog.NewAssessment(process, "Suspicious process found")
+	processAssessment.Meta = meta
+
+	message := thorlog.NewMessage(meta, "Starting module", "count", 5, "nested", thorlog.MessageFields{{Key: "key", Value: "value"}})
+
+	for _, event := range []common.Event{fileAssessment, processAssessment, message} {
+		t.Run(event.Message(), func(t *testing.T) {
+			var formatter jsonlog.TextlogFormatter
+			downgraded, err := Downgrade(event, formatter)
+			require.NoError(t, err)
+			assert.Equal(t, formatter.Format(event), formatter.Format(downgraded))
+		})
+	}
+}
+
+func TestDowngrade_Json(t *testing.T) {
+	file := thorlog.NewFile("C:\\evil.exe")
+	assessment := thorlog.NewAssessment(file, "Malware file found")
+	assessment.Meta = thorlog.LogEventMetadata{
+		Time:   mustTime("2024-09-24T12:35:41Z"),
+		Lvl:    common.Alert,
+		Mod:    "Filescan",
+		Source: "host",
+	}
+	offset := uint64(16)
+	assessment.Reasons = []thorlog.Reason{
+		thorlog.NewReason("YARA rule Evil", thorlog.Signature{Score: 80}, thorlog.MatchStrings{
+			{Match: thorlog.EncodeString("evil string"), Offset: &offset, Field: jsonlog.NewReference(file, &file.Path)},
+		}),
+	}
+	downgraded, err := Downgrade(assessment, jsonlog.TextlogFormatter{})
+	require.NoError(t, err)
+
+	jsonForm, err := json.Marshal(downgraded)
+	require.NoError(t, err)
+	var parsed thorlogv2.Event
+	require.NoError(t, json.Unmarshal(jsonForm, &parsed))
+
+	reasons, _ := findField(parsed.Data, "reasons")
+	require.Len(t, reasons, 1)
+	matched, _ := findField(reasons.([]any)[0].(thorlogv2.Fields), "matched")
+	assert.Equal(t, []any{thorlogv2.Fields{
+		{Key: "data", Value: "evil string"},
+		{Key: "offset", Value: float64(16)},
+		{Key: "field", Value: "FILE"},
+	}}, matched)
+
+	assert.Equal(t, thorlogv2.Fields{{Key: "reason_1", Value: "YARA rule Evil"}},
+		filterFields(parsed.Data.MarshalTextLog(jsonlog.TextlogFormatter{}), "reason_1"))
+}
+
+func findField(fields thorlogv2.Fields, key string) (any, bool) {
+	for _, field := range fields {
+		if field.Key == key {
+			return field.Value, true
+		}
+	}
+	return nil, false
+}
+
+func filterFields(entry jsonlog.TextlogEntry, key string) thorlogv2.Fields {
+	var fields thorlogv2.Fields
+	for _, pair := range entry {
+		if pair.Key == key {
+			fields = append(fields, thorlogv2.Field{Key: pair.Key, Value: pair.Value})
+		}
+	}
+	return fields
+}
@@ -0,0 +1,507 @@
+package convert
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	thorlogv1 "github.com/NextronSystems/jsonlog/thorlog/v1"
+	thorlogv2 "github.com/NextronSystems/jsonlog/thorlog/v2"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+// TypeLegacyFields is the object type of the UnknownObject that Upgrade uses to
+// pass through fields of an older event that have no equivalent in an Assessment.
+const TypeLegacyFields = "legacy fields"
+
+// Upgrade converts an event of an older log version (v1 or v2) to a version 3 event.
+// Events that already are version 3 events are returned unchanged.
+//
+// Alerts and warnings that describe a file or a process are converted to a *thorlog.Assessment
+// with a File or Process subject. Their reasons are converted to Reasons, as far as possible.
+// Fields that have no equivalent in an Assessment are passed through in an UnknownObject with
+// type TypeLegacyFields in the assessment's context. Values that have an equivalent, but could not
+// be converted (e.g. a non-numeric score) are passed through in the same way, and an Issue with
+// category IssueCategoryNotConverted is added for each of them.
+//
+// All other events are converted to a *thorlog.Message, with all fields except the message itself
+// kept as MessageFields.
+func Upgrade(event common.Event) (common.Event, error) {
+	var fields thorlogv2.Fields
+	switch typedEvent := event.(type) {
+	case *thorlog.Assessment, *thorlog.Message:
+		return event, nil
+	case *thorlogv1.Event:
+		for _, field := range typedEvent.Data {
+			fields = append(fields, thorlogv2.Field{Key: field.Key, Value: field.Value})
+		}
+	case *thorlogv2.Event:
+		fields = append(fields, typedEvent.Data...)
+	default:
+		return nil, fmt.Errorf("unsupported event type %T", event)
+	}
+	meta := *event.Metadata()
+	if meta.Lvl == common.Alert || meta.Lvl == common.Warning {
+		if _, hasFile := find(fields, "file"); hasFile {
+			return upgradeAssessment(meta, fields), nil
+		}
+		if _, hasPid := find(fields, "pid"); hasPid {
+			return upgradeAssessment(meta, fields), nil
+		}
+		if process, hasProcess := find(fields, "process"); hasProcess {
+			if _, isFields := process.(thorlogv2.Fields); isFields {
+				return upgradeAssessment(meta, fields), nil
+			}
+		}
+	}
+	return upgradeMessage(meta, fields), nil
+}
+
+func upgradeMessage(meta common.LogEventMetadata, fields thorlogv2.Fields) *thorlog.Message {
+	message := thorlog.NewMessage(meta, "")
+	for _, field := range fields {
+		if field.Key == "message" {
+			message.Text = fmt.Sprint(field.Value)
+			continue
+		}
+		message.Fields = append(message.Fields, thorlog.MessageField{
+			Key:   field.Key,
+			Value: toMessageValue(field.Value),
+		})
+	}
+	return message
+}
+
+// toMessageValue converts a value of v2 fields to a value that can be used in MessageFields.
+func toMessageValue(value any) any {
+	switch typedValue := value.(type) {
+	case thorlogv2.Fields:
+		var fields thorlog.MessageFields
+		for _, field := range typedValue {
+			fields = append(fields, thorlog.MessageField{
+				Key:   field.Key,
+				Value: toMessageValue(field.Value),
+			})
+		}
+		return fields
+	case []any:
+		var values = make([]any, len(typedValue))
+		for i := range typedValue {
+			values[i] = toMessageValue(typedValue[i])
+		}
+		return values
+	default:
+		return value
+	}
+}
+
+// upgrader keeps track of the fields that are not yet converted and the issues that occurred during an upgrade.
+type upgrader struct {
+	remaining thorlogv2.Fields
+	issues    []thorlog.Issue
+}
+
+func upgradeAssessment(meta common.LogEventMetadata, fields thorlogv2.Fields) *thorlog.Assessment {
+	u := &upgrader{remaining: fields}
+
+	var subject thorlog.ObservedObject
+	if process := u.takeProcess(); process != nil {
+		subject = process
+	} else {
+		subject = u.takeFile(&u.remaining)
+	}
+
+	assessment := thorlog.NewAssessment(subject, "")
+	assessment.Meta = meta
+	if message, found := u.take(&u.remaining, "message"); found {
+		assessment.Text = fmt.Sprint(message)
+	}
+	if score, found := u.takeInt(&u.remaining, "score"); found {
+		assessment.Score = score
+	}
+	assessment.Reasons = u.takeReasons()
+	assessment.ReasonCount = len(assessment.Reasons)
+
+	if len(u.remaining) > 0 {
+		assessment.EventContext = append(assessment.EventContext, thorlog.ContextObject{
+			Object: &thorlog.UnknownObject{
+				ObjectHeader: jsonlog.ObjectHeader{Type: TypeLegacyFields},
+				Data:         toMap(u.remaining),
+			},
+			Relations: []thorlog.Relation{{
+				Type:   "related to",
+				Name:   "legacy",
+				Unique: true,
+			}},
+		})
+	}
+	assessment.Issues = u.issues
+	return assessment
+}
+
+// takeProcess converts the process related fields, either from a "process" subobject (v2) or from top level fields (v1).
+// It returns nil if there are no process related fields.
+//
+// A "process" field that is not a subobject can't be converted; it is kept as a legacy field.
+func (u *upgrader) takeProcess() *thorlog.Process {
+	var processFields = &u.remaining
+	value, found := find(u.remaining, "process")
+	if subfields, isFields := value.(thorlogv2.Fields); isFields {
+		processFields = &subfields
+		defer func() { u.restore(&u.remaining, "process", subfields) }()
+	} else {
+		if found {
+			u.addIssue("process", value)
+		}
+		if _, hasPid := find(u.remaining, "pid"); !hasPid {
+			return nil
+		}
+	}
+
+	process := thorlog.NewProcess(0)
+	if pid, found := u.takeInt(processFields, "pid"); found {
+		process.Pid = int32(pid)
+	}
+	process.Name = u.takeString(processFields, "name")
+	process.Cmdline = u.takeString(processFields, "command")
+	process.User = u.takeString(processFields, "owner")
+	if ppid, found := u.takeInt(processFields, "ppid"); found {
+		process.ParentInfo.Pid = int32(ppid)
+	}
+	process.ParentInfo.Exe = u.takeString(processFields, "parent")
+	process.ParentInfo.CommandLine = u.takeString(processFields, "parent_command")
+	if _, hasPath := find(*processFields, "path"); hasPath {
+		process.Image = u.takeFile(processFields)
+	} else if _, hasFile := find(u.remaining, "file"); hasFile {
+		process.Image = u.takeFile(&u.remaining)
+	}
+	return process
+}
+
+// takeFile converts the file related fields in the given fields.
+// The file may either be a subobject or a file path with the other file related fields on the same level.
+func (u *upgrader) takeFile(fields *thorlogv2.Fields) *thorlog.File {
+	var fileFields = fields
+	file := thorlog.NewFile("")
+	if value, found := find(*fields, "file"); found {
+		if subfields, isFields := value.(thorlogv2.Fields); isFields {
+			fileFields = &subfields
+			defer func() { u.restore(fields, "file", subfields) }()
+			file.Path = u.takeString(fileFields, "path")
+		} else {
+			file.Path = u.takeString(fields, "file")
+		}
+	} else {
+		file.Path = u.takeString(fields, "path")
+	}
+
+	var hashes thorlog.FileHashes
+	hashes.Md5 = u.takeString(fileFields, "md5")
+	hashes.Sha1 = u.takeString(fileFields, "sha1")
+	hashes.Sha256 = u.takeString(fileFields, "sha256")
+	if hashes != (thorlog.FileHashes{}) {
+		file.Hashes = &hashes
+	}
+	if size, found := u.takeInt(fileFields, "size"); found {
+		file.Size = uint64(size)
+	}
+	file.MagicHeader = u.takeString(fileFields, "type")
+	file.Extension = u.takeString(fileFields, "extension")
+	return file
+}
+
+// flatReasonField matches the keys of reason fields in v1 events, e.g. "reason_1" or "subscore_2".
+var flatReasonField = regexp.MustCompile(`^(reason|subscore|ref|rulename|sigtype|sigclass|ruledate|tags|author|description|id|falsepositives|matched)_(\d+)$`)
+
+// unsuffixedReasonFields are keys that are used for the fields of a single reason if no suffix is present.
+var unsuffixedReasonFields = []string{"reason", "subscore", "rulename", "sigtype", "sigclass", "ruledate", "matched"}
+
+// takeReasons converts the reasons, either from a "reasons" list (v2) or from suffixed top level fields (v1).
+func (u *upgrader) takeReasons() []thorlog.Reason {
+	var reasons []thorlog.Reason
+	if value, found := find(u.remaining, "reasons"); found {
+		if list, isList := value.([]any); isList {
+			u.take(&u.remaining, "reasons")
+			for i, element := range list {
+				reasonFields, isFields := element.(thorlogv2.Fields)
+				if !isFields {
+					u.addIssue(fmt.Sprintf("reasons_%d", i+1), element)
+					continue
+				}
+				reason := thorlog.NewReason("", thorlog.Signature{}, nil)
+				for _, field := range reasonFields {
+					if !u.setReasonField(&reason, field.Key, field.Value) {
+						u.addIssue(fmt.Sprintf("reasons_%d_%s", i+1, field.Key), field.Value)
+					}
+				}
+				reasons = append(reasons, reason)
+			}
+		}
+	}
+
+	var flatReasons = map[int]*thorlog.Reason{}
+	var remaining thorlogv2.Fields
+	for _, field := range u.remaining {
+		var index int
+		var reasonKey = field.Key
+		if match := flatReasonField.FindStringSubmatch(field.Key); match != nil {
+			index, _ = strconv.Atoi(match[2])
+			reasonKey = match[1]
+		} else if isUnsuffixedReasonField(field.Key) {
+			index = 0
+		} else {
+			remaining = append(remaining, field)
+			continue
+		}
+		reason, exists := flatReasons[index]
+		if !exists {
+			newReason := thorlog.NewReason("", thorlog.Signature{}, nil)
+			reason = &newReason
+			flatReasons[index] = reason
+		}
+		if !u.setReasonField(reason, reasonKey, field.Value) {
+			remaining = append(remaining, field)
+			u.addIssue(field.Key, field.Value)
+		}
+	}
+	u.remaining = remaining
+
+	var indices []int
+	for index := range flatReasons {
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+	for _, index := range indices {
+		reasons = append(reasons, *flatReasons[index])
+	}
+	return reasons
+}
+
+func isUnsuffixedReasonField(key string) bool {
+	for _, reasonField := range unsuffixedReasonFields {
+		if key == reasonField {
+			return true
+		}
+	}
+	return false
+}
+
+// setReasonField sets the reason field that corresponds to the given key.
+// It returns false if there is no such field or the value could not be converted.
+func (u *upgrader) setReasonField(reason *thorlog.Reason, key string, value any) bool {
+	switch key {
+	case "reason", "name", "summary":
+		reason.Summary = fmt.Sprint(value)
+	case "subscore", "score":
+		score, ok := toInt(value)
+		if !ok {
+			return false
+		}
+		reason.Score = score
+	case "ref", "reference":
+		reason.Ref = toStringList(value)
+	case "rulename", "rule_name":
+		reason.Rulename = fmt.Sprint(value)
+	case "sigtype", "origin":
+		switch fmt.Sprint(value) {
+		case "internal":
+			reason.Signature.Type = thorlog.Internal
+		case "custom":
+			reason.Signature.Type = thorlog.Custom
+		case "external":
+			reason.Signature.Type = thorlog.External
+		default:
+			return false
+		}
+	case "sigclass", "kind":
+		reason.Class = thorlog.Sigclass(fmt.Sprint(value))
+	case "ruledate", "date":
+		reason.Date = fmt.Sprint(value)
+	case "tags":
+		reason.Tags = toStringList(value)
+	case "author":
+		reason.Author = fmt.Sprint(value)
+	case "description":
+		reason.LongDescription = fmt.Sprint(value)
+	case "id":
+		reason.RuleId = fmt.Sprint(value)
+	case "falsepositives", "false_positives":
+		reason.FalsePositives = toStringList(value)
+	case "matched":
+		reason.StringMatches = u.toMatchStrings(value)
+	case "signature":
+		signatureFields, isFields := value.(thorlogv2.Fields)
+		if !isFields {
+			return false
+		}
+		for _, field := range signatureFields {
+			if !u.setReasonField(reason, field.Key, field.Value) {
+				u.addIssue("signature_"+field.Key, field.Value)
+			}
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// toMatchStrings converts matched strings. In v1 events, these are only available in their
+// text representation, which is kept as a single match.
+func (u *upgrader) toMatchStrings(value any) thorlog.MatchStrings {
+	list, isList := value.([]any)
+	if !isList {
+		return thorlog.MatchStrings{{Match: thorlog.EncodeString(fmt.Sprint(value))}}
+	}
+	var matches thorlog.MatchStrings
+	for _, element := range list {
+		matchFields, isFields := element.(thorlogv2.Fields)
+		if !isFields {
+			matches = append(matches, thorlog.MatchString{Match: thorlog.EncodeString(fmt.Sprint(element))})
+			continue
+		}
+		var match thorlog.MatchString
+		for _, field := range matchFields {
+			switch field.Key {
+			case "data":
+				match.Match = thorlog.EncodeString(fmt.Sprint(field.Value))
+			case "context":
+				context := thorlog.EncodeString(fmt.Sprint(field.Value))
+				match.Context = &context
+			case "offset":
+				if offset, ok := toInt(field.Value); ok && offset >= 0 {
+					unsignedOffset := uint64(offset)
+					match.Offset = &unsignedOffset
+				} else {
+					u.addIssue("matched_offset", field.Value)
+				}
+			default:
+				// Field references can't be restored since the original object structure is unknown
+				u.addIssue("matched_"+field.Key, field.Value)
+			}
+		}
+		matches = append(matches, match)
+	}
+	return matches
+}
+
+func (u *upgrader) addIssue(key string, value any) {
+	u.issues = append(u.issues, thorlog.Issue{
+		Category:    thorlog.IssueCategoryNotConverted,
+		Description: fmt.Sprintf("Could not convert field %s with value %v", key, value),
+	})
+}
+
+// restore replaces the subobject with the given key in fields by the subfields that were not converted.
+// If all subfields were converted, the subobject is removed.
+func (u *upgrader) restore(fields *thorlogv2.Fields, key string, subfields thorlogv2.Fields) {
+	if _, found := u.take(fields, key); !found || len(subfields) == 0 {
+		return
+	}
+	*fields = append(*fields, thorlogv2.Field{Key: key, Value: subfields})
+}
+
+// take removes the field with the given key from fields and returns its value.
+func (u *upgrader) take(fields *thorlogv2.Fields, key string) (any, bool) {
+	for i, field := range *fields {
+		if field.Key == key {
+			*fields = append((*fields)[:i:i], (*fields)[i+1:]...)
+			return field.Value, true
+		}
+	}
+	return nil, false
+}
+
+func (u *upgrader) takeString(fields *thorlogv2.Fields, key string) string {
+	value, found := u.take(fields, key)
+	if !found {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// takeInt removes the field with the given key from fields and returns its value as an integer.
+// If the value is not an integer, the field is kept and an issue is added.
+func (u *upgrader) takeInt(fields *thorlogv2.Fields, key string) (int64, bool) {
+	value, found := find(*fields, key)
+	if !found {
+		return 0, false
+	}
+	number, ok := toInt(value)
+	if !ok {
+		u.addIssue(key, value)
+		return 0, false
+	}
+	u.take(fields, key)
+	return number, true
+}
+
+func find(fields thorlogv2.Fields, key string) (any, bool) {
+	for _, field := range fields {
+		if field.Key == key {
+			return field.Value, true
+		}
+	}
+	return nil, false
+}
+
+func toInt(value any) (int64, bool) {
+	switch typedValue := value.(type) {
+	case float64:
+		return int64(typedValue), typedValue == math.Trunc(typedValue)
+	case string:
+		number, err := strconv.ParseInt(strings.TrimSpace(typedValue), 10, 64)
+		return number, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func toStringList(value any) thorlog.StringList {
+	switch typedValue := value.(type) {
+	case []any:
+		var list thorlog.StringList
+		for _, element := range typedValue {
+			list = append(list, fmt.Sprint(element))
+		}
+		return list
+	case string:
+		var list thorlog.StringList
+		for _, element := range strings.Split(typedValue, ",") {
+			if element = strings.TrimSpace(element); element != "" {
+				list = append(list, element)
+			}
+		}
+		return list
+	default:
+		return thorlog.StringList{fmt.Sprint(value)}
+	}
+}
+
+// toMap converts v2 fields to the representation used in UnknownObject.
+func toMap(fields thorlogv2.Fields) map[string]any {
+	var data = map[string]any{}
+	for _, field := range fields {
+		data[field.Key] = toMapValue(field.Value)
+	}
+	return data
+}
+
+func toMapValue(value any) any {
+	switch typedValue := value.(type) {
+	case thorlogv2.Fields:
+		return toMap(typedValue)
+	case []any:
+		var values = make([]any, len(typedValue))
+		for i := range typedValue {
+			values[i] = toMapValue(typedValue[i])
+		}
+		return values
+	default:
+		return value
+	}
+}
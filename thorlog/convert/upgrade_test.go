@@ -0,0 +1,255 @@
+package convert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	"github.com/NextronSystems/jsonlog/thorlog/parser"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func uint64Pointer(value uint64) *uint64 {
+	return &value
+}
+
+func TestUpgrade(t *testing.T) {
+	for _, testcase := range []struct {
+		name     string
+		rawEvent string
+		expected common.Event
+	}{
+		{
+			"JsonV1Message",
+			`{"time":"2024-09-24T12:35:41Z","hostname":"host","level":"Info","module":"Startup","message":"Sigma Database loaded","version":"r2024-07-17","scanid":"S-NtvqbRLWbf8","log_version":"v1.0.0"}`,
+			&thorlog.Message{
+				ObjectHeader: jsonlog.ObjectHeader{Type: "THOR message"},
+				Meta: thorlog.LogEventMetadata{
+					Time:   mustTime("2024-09-24T12:35:41Z"),
+					Lvl:    common.Info,
+					Mod:    "Startup",
+					ScanID: "S-NtvqbRLWbf8",
+					Source: "host",
+				},
+				Text: "Sigma Database loaded",
+				Fields: thorlog.MessageFields{
+					{Key: "version", Value: "r2024-07-17"},
+				},
+				LogVersion: "v3.0.0",
+			},
+		},
+		{
+			"JsonV1FileAlert",
+			`{"time":"2024-09-24T12:35:41Z","hostname":"host","level":"Alert","module":"Filescan","message":"Malware file found","file":"C:\\evil.exe","md5":"d41d8cd98f00b204e9800998ecf8427e","size":"1234","score":"120","reason_1":"YARA rule Evil","subscore_1":"100","ref_1":"https://example.org","rulename_1":"Evil","matched_1":"Str1: evil","reason_2":"Filename IOC","subscore_2":"20","sigtype_2":"invalid","owner":"root","scanid":"S-abc","log_version":"v1.0.0"}`,
+			&thorlog.Assessment{
+				ObjectHeader: jsonlog.ObjectHeader{Type: "THOR assessment"},
+				Meta: thorlog.LogEventMetadata{
+					Time:   mustTime("2024-09-24T12:35:41Z"),
+					Lvl:    common.Alert,
+					Mod:    "Filescan",
+					ScanID: "S-abc",
+					Source: "host",
+				},
+				Text: "Malware file found",
+				Subject: &thorlog.File{
+					ObjectHeader: jsonlog.ObjectHeader{Type: "file"},
+					Path:         "C:\\evil.exe",
+					Hashes:       &thorlog.FileHashes{Md5: "d41d8cd98f00b204e9800998ecf8427e"},
+					Size:         1234,
+				},
+				Score: 120,
+				Reasons: []thorlog.Reason{
+					{
+						ObjectHeader: jsonlog.ObjectHeader{Type: "reason"},
+						Summary:      "YARA rule Evil",
+						Signature: thorlog.Signature{
+							Score:    100,
+							Ref:      thorlog.StringList{"https://example.org"},
+							Rulename: "Evil",
+						},
+						StringMatches: thorlog.MatchStrings{{Match: thorlog.EncodeString("Str1: evil")}},
+					},
+					{
+						ObjectHeader: jsonlog.ObjectHeader{Type: "reason"},
+						Summary:      "Filename IOC",
+						Signature: thorlog.Signature{
+							Score: 20,
+						},
+					},
+				},
+				ReasonCount: 2,
+				EventContext: thorlog.Context{
+					{
+						Object: &thorlog.UnknownObject{
+							ObjectHeader: jsonlog.ObjectHeader{Type: TypeLegacyFields},
+							Data: map[string]any{
+								"owner":     "root",
+								"sigtype_2": "invalid",
+							},
+						},
+						Relations: []thorlog.Relation{{Type: "related to", Name: "legacy", Unique: true}},
+					},
+				},
+				Issues: []thorlog.Issue{
+					{Category: thorlog.IssueCategoryNotConverted, Description: "Could not convert field sigtype_2 with value invalid"},
+				},
+				LogVersion: "v3.0.0",
+			},
+		},
+		{
+			"JsonV2ProcessWarning",
+			`{"time":"2024-09-24T12:37:04Z","hostname":"host","level":"Warning","module":"ProcessCheck","message":"Suspicious process found","process":{"pid":1234,"name":"evil","command":"evil --run","path":"/tmp/evil","md5":"d41d8cd98f00b204e9800998ecf8427e","unknown":"value"},"score":75,"reasons":[{"name":"Suspicious location","signature":{"score":75,"origin":"custom","kind":"Internal Heuristic"},"matched":[{"data":"/tmp","offset":0,"field":"/image/path"}]}],"scanid":"S-kgKxYyJFQd0","log_version":"v2.0.0"}`,
+			&thorlog.Assessment{
+				ObjectHeader: jsonlog.ObjectHeader{Type: "THOR assessment"},
+				Meta: thorlog.LogEventMetadata{
+					Time:   mustTime("2024-09-24T12:37:04Z"),
+					Lvl:    common.Warning,
+					Mod:    "ProcessCheck",
+					ScanID: "S-kgKxYyJFQd0",
+					Source: "host",
+				},
+				Text: "Suspicious process found",
+				Subject: &thorlog.Process{
+					ObjectHeader: jsonlog.ObjectHeader{Type: "process"},
+					Pid:          1234,
+					ProcessInfo: thorlog.ProcessInfo{
+						Name:    "evil",
+						Cmdline: "evil --run",
+						Image: &thorlog.File{
+							ObjectHeader: jsonlog.ObjectHeader{Type: "file"},
+							Path:         "/tmp/evil",
+							Hashes:       &thorlog.FileHashes{Md5: "d41d8cd98f00b204e9800998ecf8427e"},
+						},
+					},
+				},
+				Score: 75,
+				Reasons: []thorlog.Reason{
+					{
+						ObjectHeader: jsonlog.ObjectHeader{Type: "reason"},
+						Summary:      "Suspicious location",
+						Signature: thorlog.Signature{
+							Score: 75,
+							Type:  thorlog.Custom,
+							Class: thorlog.ClassInternalHeuristic,
+						},
+						StringMatches: thorlog.MatchStrings{{Match: thorlog.EncodeString("/tmp"), Offset: uint64Pointer(0)}},
+					},
+				},
+				ReasonCount: 1,
+				EventContext: thorlog.Context{
+					{
+						Object: &thorlog.UnknownObject{
+							ObjectHeader: jsonlog.ObjectHeader{Type: TypeLegacyFields},
+							Data: map[string]any{
+								"process": map[string]any{"unknown": "value"},
+							},
+						},
+						Relations: []thorlog.Relation{{Type: "related to", Name: "legacy", Unique: true}},
+					},
+				},
+				Issues: []thorlog.Issue{
+					{Category: thorlog.IssueCategoryNotConverted, Description: "Could not convert field matched_field with value /image/path"},
+				},
+				LogVersion: "v3.0.0",
+			},
+		},
+		{
+			"JsonV2AlertWithProcessName",
+			`{"time":"2024-09-24T12:37:04Z","hostname":"host","level":"Alert","module":"Filescan","message":"Malware file found","file":"/tmp/evil","process":"evil","scanid":"S-kgKxYyJFQd0","log_version":"v2.0.0"}`,
+			&thorlog.Assessment{
+				ObjectHeader: jsonlog.ObjectHeader{Type: "THOR assessment"},
+				Meta: thorlog.LogEventMetadata{
+					Time:   mustTime("2024-09-24T12:37:04Z"),
+					Lvl:    common.Alert,
+					Mod:    "Filescan",
+					ScanID: "S-kgKxYyJFQd0",
+					Source: "host",
+				},
+				Text: "Malware file found",
+				Subject: &thorlog.File{
+					ObjectHeader: jsonlog.ObjectHeader{Type: "file"},
+					Path:         "/tmp/evil",
+				},
+				EventContext: thorlog.Context{
+					{
+						Object: &thorlog.UnknownObject{
+							ObjectHeader: jsonlog.ObjectHeader{Type: TypeLegacyFields},
+							Data: map[string]any{
+								"process": "evil",
+							},
+						},
+						Relations: []thorlog.Relation{{Type: "related to", Name: "legacy", Unique: true}},
+					},
+				},
+				Issues: []thorlog.Issue{
+					{Category: thorlog.IssueCategoryNotConverted, Description: "Could not convert field process with value evil"},
+				},
+				LogVersion: "v3.0.0",
+			},
+		},
+		{
+			"JsonV2AlertWithOnlyProcessName",
+			`{"time":"2024-09-24T12:37:04Z","hostname":"host","level":"Alert","module":"ProcessCheck","message":"something","process":"evil","scanid":"S-kgKxYyJFQd0","log_version":"v2.0.0"}`,
+			&thorlog.Message{
+				ObjectHeader: jsonlog.ObjectHeader{Type: "THOR message"},
+				Meta: thorlog.LogEventMetadata{
+					Time:   mustTime("2024-09-24T12:37:04Z"),
+					Lvl:    common.Alert,
+					Mod:    "ProcessCheck",
+					ScanID: "S-kgKxYyJFQd0",
+					Source: "host",
+				},
+				Text: "something",
+				Fields: thorlog.MessageFields{
+					{Key: "process", Value: "evil"},
+				},
+				LogVersion: "v3.0.0",
+			},
+		},
+		{
+			"JsonV2AlertWithoutSubject",
+			`{"time":"2024-09-24T12:37:04Z","hostname":"host","level":"Alert","module":"Hosts","message":"something","entries":[{"ip":"1.2.3.4"}],"scanid":"S-kgKxYyJFQd0","log_version":"v2.0.0"}`,
+			&thorlog.Message{
+				ObjectHeader: jsonlog.ObjectHeader{Type: "THOR message"},
+				Meta: thorlog.LogEventMetadata{
+					Time:   mustTime("2024-09-24T12:37:04Z"),
+					Lvl:    common.Alert,
+					Mod:    "Hosts",
+					ScanID: "S-kgKxYyJFQd0",
+					Source: "host",
+				},
+				Text: "something",
+				Fields: thorlog.MessageFields{
+					{Key: "entries", Value: []any{thorlog.MessageFields{{Key: "ip", Value: "1.2.3.4"}}}},
+				},
+				LogVersion: "v3.0.0",
+			},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			event, err := parser.ParseEvent([]byte(testcase.rawEvent))
+			require.NoError(t, err)
+			upgraded, err := Upgrade(event)
+			require.NoError(t, err)
+			assert.Equal(t, testcase.expected, upgraded)
+		})
+	}
+}
+
+func TestUpgrade_V3Unchanged(t *testing.T) {
+	message := thorlog.NewMessage(thorlog.LogEventMetadata{Mod: "Test"}, "test")
+	upgraded, err := Upgrade(message)
+	require.NoError(t, err)
+	assert.Same(t, message, upgraded)
+}
@@ -0,0 +1,207 @@
+// Package ecs maps THOR events to documents in the Elastic Common Schema (ECS).
+//
+// Fields that have an ECS equivalent are mapped to it, e.g. the SHA256 hash of a file subject
+// is mapped to file.hash.sha256. The original event is kept in the thor namespace,
+// so that fields without an ECS equivalent remain available.
+package ecs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+// Version is the ECS version that the documents conform to.
+const Version = "8.11.0"
+
+// Document is an ECS document. Nested fields are stored in nested documents,
+// e.g. file.hash.sha256 is stored as {"file": {"hash": {"sha256": ...}}}.
+type Document map[string]any
+
+// Set sets the field at the dotted path to value, creating nested documents as necessary.
+// Empty values (empty strings, zero times, nil and empty slices) are ignored.
+func (d Document) Set(path string, value any) {
+	if isEmpty(value) {
+		return
+	}
+	keys := strings.Split(path, ".")
+	current := d
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := asDocument(current[key])
+		if !ok {
+			next = Document{}
+			current[key] = next
+		}
+		current = next
+	}
+	current[keys[len(keys)-1]] = value
+}
+
+// Get returns the field at the dotted path, or nil if it is not set.
+func (d Document) Get(path string) any {
+	keys := strings.Split(path, ".")
+	current := d
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := asDocument(current[key])
+		if !ok {
+			return nil
+		}
+		current = next
+	}
+	return current[keys[len(keys)-1]]
+}
+
+// asDocument returns value as a document if it is one. Nested objects that were decoded from JSON are plain maps.
+func asDocument(value any) (Document, bool) {
+	switch v := value.(type) {
+	case Document:
+		return v, true
+	case map[string]any:
+		return v, true
+	default:
+		return nil, false
+	}
+}
+
+// merge copies all fields of other into d. Nested documents are merged recursively.
+func (d Document) merge(other Document) {
+	for key, value := range other {
+		nested, isDocument := asDocument(value)
+		existing, existingIsDocument := asDocument(d[key])
+		if isDocument && existingIsDocument {
+			existing.merge(nested)
+		} else {
+			d[key] = value
+		}
+	}
+}
+
+func isEmpty(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []string:
+		return len(v) == 0
+	case Document:
+		return len(v) == 0
+	case interface{ IsZero() bool }:
+		return v.IsZero()
+	default:
+		return false
+	}
+}
+
+// Map maps a version 3 event to an ECS document.
+func Map(event common.Event) (Document, error) {
+	switch typedEvent := event.(type) {
+	case *thorlog.Assessment:
+		return MapAssessment(typedEvent)
+	case *thorlog.Message:
+		return MapMessage(typedEvent)
+	default:
+		return nil, fmt.Errorf("unsupported event type %T", event)
+	}
+}
+
+// MapAssessment maps an assessment to an ECS document.
+//
+// Its subject is mapped with MapObject and the signature of its first reason is mapped to the rule fields.
+// The tags of all signatures are collected in tags.
+func MapAssessment(assessment *thorlog.Assessment) (Document, error) {
+	document, err := mapEvent(assessment, &assessment.Meta, assessment.Text)
+	if err != nil {
+		return nil, err
+	}
+	if len(assessment.Reasons) > 0 {
+		document.Set("event.kind", "alert")
+	} else {
+		document.Set("event.kind", "event")
+	}
+	document.Set("event.dataset", "thor.assessment")
+	document.Set("event.risk_score", float64(assessment.Score))
+
+	if len(assessment.Reasons) > 0 {
+		signature := assessment.Reasons[0].Signature
+		document.Set("rule.id", signature.RuleId)
+		document.Set("rule.name", signature.Rulename)
+		document.Set("rule.description", signature.LongDescription)
+		document.Set("rule.author", signature.Author)
+		document.Set("rule.category", string(signature.Class))
+		document.Set("rule.ruleset", signature.Type.String())
+		if len(signature.Ref) > 0 {
+			document.Set("rule.reference", signature.Ref[0])
+		}
+	}
+	var tags []string
+	seenTags := map[string]bool{}
+	for _, reason := range assessment.Reasons {
+		for _, tag := range reason.Tags {
+			if !seenTags[tag] {
+				seenTags[tag] = true
+				tags = append(tags, tag)
+			}
+		}
+	}
+	document.Set("tags", tags)
+
+	if assessment.Subject != nil {
+		document.merge(MapObject(assessment.Subject))
+	}
+	return document, nil
+}
+
+// MapMessage maps a message to an ECS document. The message fields are kept in thor.fields.
+func MapMessage(message *thorlog.Message) (Document, error) {
+	document, err := mapEvent(message, &message.Meta, message.Text)
+	if err != nil {
+		return nil, err
+	}
+	document.Set("event.kind", "event")
+	document.Set("event.dataset", "thor.message")
+	return document, nil
+}
+
+// mapEvent maps the fields that are common to all events and stores the original event in the thor namespace.
+func mapEvent(event common.Event, meta *common.LogEventMetadata, message string) (Document, error) {
+	original, err := toDocument(event)
+	if err != nil {
+		return nil, err
+	}
+	// The metadata and message are completely represented by ECS fields
+	delete(original, "meta")
+	delete(original, "message")
+	original.Set("scan_id", meta.ScanID)
+
+	document := Document{}
+	document.Set("@timestamp", meta.Time)
+	document.Set("ecs.version", Version)
+	document.Set("message", message)
+	document.Set("log.level", strings.ToLower(string(meta.Lvl)))
+	document.Set("host.name", meta.Source)
+	document.Set("event.id", meta.GenID)
+	document.Set("event.module", "thor")
+	document.Set("event.provider", meta.Mod)
+	document["thor"] = original
+	return document, nil
+}
+
+// toDocument converts the JSON representation of value to a document.
+func toDocument(value any) (Document, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var document Document
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
@@ -0,0 +1,86 @@
+package ecs
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	"github.com/NextronSystems/jsonlog/thorlog/parser"
+	thorlogv1 "github.com/NextronSystems/jsonlog/thorlog/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// goldenEvents contains the events for the golden files in testdata, keyed by the name of the golden file.
+// Together, they cover every object type that MapObject supports.
+var goldenEvents = map[string]string{
+	"file":                      `{"type":"THOR assessment","meta":{"time":"2024-03-11T08:14:02Z","level":"Alert","module":"Filescan","scan_id":"S-pQ4vT1xr9Lw","event_id":"a41c0f7e9d2b","hostname":"ws-fin-042"},"message":"Malicious file found","score":92,"subject":{"type":"file","path":"C:\\ProgramData\\OneDriveUpdate\\version.dll","exists":"yes","extension":".dll","magic_header":"EXE","hashes":{"md5":"5a5d6b3f2e8c11d98a5f6f3c0b1d7e42","sha1":"0c8f2a1e7d9b44c3f5e6a7b8c9d0e1f203142536","sha256":"8d2c3f4a5b6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708"},"file_times":{"modified":"2024-03-10T22:41:17Z","created":"2024-03-10T22:41:15Z"},"size":194560,"permissions":{"type":"Windows permissions","owner":"NT AUTHORITY\\SYSTEM","acl":null},"pe_info":{"company":"Microsoft Corporation","description":"Version Checking and File Installation Libraries","product":"Microsoft Windows Operating System","original_name":"version.dll","imphash":"b8e3c1d0a9f74e2c6d5b4a3f2e1d0c9b","signed":false}},"reasons":[{"summary":"YARA rule MAL_DLL_Sideloading_Version","signature":{"score":80,"reference":["https://attack.mitre.org/techniques/T1574/002/"],"kind":"YARA Rule","tags":["T1574.002","DLL-Sideloading"],"rule_name":"MAL_DLL_Sideloading_Version","description":"Detects an unsigned version.dll outside of the system directories","author":"Florian Roth","id":"5f0c6e3a-2d1b-4c8e-9a7f-0b3d2e1c4a5f"}},{"summary":"Unsigned DLL with Microsoft version information","signature":{"score":60,"kind":"Internal Heuristic","tags":["T1036.005"]}}],"log_version":"v3.0.0"}`,
+	"unix_file":                 `{"type":"THOR assessment","meta":{"time":"2024-03-11T08:20:45Z","level":"Warning","module":"Filescan","scan_id":"S-pQ4vT1xr9Lw","hostname":"web-dmz-01"},"message":"Suspicious file found","score":70,"subject":{"type":"file","path":"/var/www/html/uploads/.cache.php","exists":"yes","extension":".php","hashes":{"sha256":"41f6e0a3c7b2d9e8f1a0b3c6d5e4f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4"},"file_times":{"modified":"2024-03-09T03:12:08Z","accessed":"2024-03-11T07:59:30Z","changed":"2024-03-09T03:12:08Z"},"size":1312,"permissions":{"type":"Unix permissions","owner":"www-data","group":"www-data","mask":{"user":{"readable":true,"writable":true},"group":{"readable":true},"world":{"readable":true}}}},"reasons":[{"summary":"YARA rule WEBSHELL_PHP_Eval_Base64","signature":{"score":70,"kind":"YARA Rule","tags":["T1505.003"],"rule_name":"WEBSHELL_PHP_Eval_Base64","author":"Arnim Rupp"}}],"log_version":"v3.0.0"}`,
+	"process":                   `{"type":"THOR assessment","meta":{"time":"2024-03-11T08:16:30Z","level":"Alert","module":"ProcessCheck","scan_id":"S-pQ4vT1xr9Lw","event_id":"c93e1b0a7f64","hostname":"ws-fin-042"},"message":"Suspicious process found","score":85,"subject":{"type":"process","pid":6712,"name":"rundll32.exe","command":"rundll32.exe C:\\ProgramData\\OneDriveUpdate\\version.dll,DllRegisterServer","owner":"CORP\\j.doe","image":{"type":"file","path":"C:\\Windows\\System32\\rundll32.exe","hashes":{"md5":"ef3179d498793bf4234f708d3be28633","sha1":"3ba2e6e1fa9c5f3f5e6c0d6fc0a3bf1e5c1f2ad0","sha256":"b53f3c0cd32d7f20849850768da6431e5f876b7bfa61db0aa0700b02873393fa"}},"parent_info":{"pid":4120,"exe":"C:\\Windows\\System32\\svchost.exe","command":"svchost.exe -k netsvcs -p -s Schedule"},"created":"2024-03-11T07:02:11Z","listen_ports":[8443]},"reasons":[{"summary":"Sigma rule Rundll32 Execution From ProgramData","signature":{"score":85,"reference":["https://attack.mitre.org/techniques/T1218/011/"],"kind":"Sigma Rule","tags":["T1218.011"],"rule_name":"Rundll32 Execution From ProgramData","author":"Nasreddine Bencherchali","id":"3d2b1c0a-9e8f-4a7b-8c6d-5e4f3a2b1c0d"}}],"log_version":"v3.0.0"}`,
+	"dead_process":              `{"type":"THOR assessment","meta":{"time":"2024-03-11T08:16:31Z","level":"Notice","module":"ProcessCheck","scan_id":"S-pQ4vT1xr9Lw","hostname":"ws-fin-042"},"message":"Process exited during the scan","score":40,"subject":{"type":"process","pid":7344,"dead":true},"reasons":[{"summary":"Process handle could not be opened","signature":{"score":40,"kind":"Internal Heuristic"}}],"log_version":"v3.0.0"}`,
+	"registry_value":            `{"type":"THOR assessment","meta":{"time":"2024-03-11T08:09:54Z","level":"Alert","module":"Autoruns","scan_id":"S-pQ4vT1xr9Lw","event_id":"1f7d3a9c0e25","hostname":"ws-fin-042"},"message":"Suspicious autorun entry found","score":75,"subject":{"type":"registry value","key":"HKCU\\Software\\Microsoft\\Windows\\CurrentVersion\\Run\\OneDriveUpdate","modified":"2024-03-10T22:41:20Z","value":"rundll32.exe C:\\ProgramData\\OneDriveUpdate\\version.dll,DllRegisterServer","size":142},"reasons":[{"summary":"Sigma rule Run Key Pointing To ProgramData","signature":{"score":75,"kind":"Sigma Rule","tags":["T1547.001","Persistence"],"rule_name":"Run Key Pointing To ProgramData"}}],"log_version":"v3.0.0"}`,
+	"windows_service":           `{"type":"THOR assessment","meta":{"time":"2024-03-11T08:11:07Z","level":"Warning","module":"ServiceCheck","scan_id":"S-pQ4vT1xr9Lw","hostname":"srv-file-03"},"message":"Suspicious service found","score":65,"subject":{"type":"Windows service","key":"HKLM\\SYSTEM\\CurrentControlSet\\Services\\PSEXESVC","key_name":"PSEXESVC","service_name":"PSEXESVC","start_type":"DEMAND_START","service_type":"WIN32_OWN_PROCESS","user":"LocalSystem","image":{"type":"file","path":"C:\\Windows\\PSEXESVC.exe","extension":".exe","size":181064,"pe_info":{"company":"Sysinternals - www.sysinternals.com","original_name":"psexesvc.exe","signed":true,"signatures":[{"certificate_name":"Microsoft Corporation","signature_valid":true}]}}},"reasons":[{"summary":"Remote execution service installed","signature":{"score":65,"kind":"Sigma Rule","tags":["T1569.002","Lateral-Movement"],"rule_name":"PsExec Service Installation"}}],"log_version":"v3.0.0"}`,
+	"network_connecting_thread": `{"type":"THOR assessment","meta":{"time":"2024-03-11T08:18:12Z","level":"Alert","module":"BeaconCheck","scan_id":"S-pQ4vT1xr9Lw","hostname":"ws-fin-042"},"message":"Beaconing thread found","score":90,"subject":{"type":"network connecting thread","thread_id":6820,"process":{"type":"process","pid":6712,"name":"rundll32.exe","command":"rundll32.exe C:\\ProgramData\\OneDriveUpdate\\version.dll,DllRegisterServer","owner":"CORP\\j.doe","created":"2024-03-11T07:02:11Z"},"callback_interval":30000000000,"connections":[{"protocol":"https","server":"cdn-update.example.net"}]},"reasons":[{"summary":"Thread connects in regular intervals","signature":{"score":90,"kind":"Internal Heuristic","tags":["T1071.001"]}}],"log_version":"v3.0.0"}`,
+	"process_connection":        `{"type":"THOR assessment","meta":{"time":"2024-03-11T08:17:44Z","level":"Warning","module":"ProcessConnections","scan_id":"S-pQ4vT1xr9Lw","hostname":"ws-fin-042"},"message":"Connection to suspicious port found","score":60,"subject":{"type":"process connection","status":"ESTABLISHED","ip":"10.20.4.42","port":51873,"remote_ip":"198.51.100.23","remote_port":8443,"protocol":"TCP"},"reasons":[{"summary":"Connection to uncommon HTTPS port","signature":{"score":60,"kind":"Internal Heuristic","tags":["T1571"]}}],"log_version":"v3.0.0"}`,
+	"message":                   `{"type":"THOR message","meta":{"time":"2024-03-11T08:02:00Z","level":"Info","module":"Startup","scan_id":"S-pQ4vT1xr9Lw","hostname":"ws-fin-042"},"message":"Scan started","fields":{"version":"11.0.0","threads":8,"arguments":["--quick","--nocsv"]},"log_version":"v3.0.0"}`,
+}
+
+func parseEvent(t *testing.T, event string) common.Event {
+	t.Helper()
+	parsed, err := parser.ParseEvent([]byte(event))
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestMap_Golden(t *testing.T) {
+	for name, event := range goldenEvents {
+		event := event
+		t.Run(name, func(t *testing.T) {
+			document, err := Map(parseEvent(t, event))
+			require.NoError(t, err)
+			actual, err := json.MarshalIndent(document, "", "  ")
+			require.NoError(t, err)
+			actual = append(actual, '\n')
+
+			goldenFile := filepath.Join("testdata", name+".json")
+			if *update {
+				require.NoError(t, os.WriteFile(goldenFile, actual, 0644))
+			}
+			expected, err := os.ReadFile(goldenFile)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(expected), string(actual))
+		})
+	}
+}
+
+func TestMap_ProcessWithoutPid(t *testing.T) {
+	document, err := Map(parseEvent(t, `{"type":"THOR assessment","meta":{"time":"2024-03-11T08:16:30Z","level":"Notice","module":"ProcessCheck","hostname":"ws-fin-042"},"message":"Suspicious process found","score":40,"subject":{"type":"process","pid":0,"name":"System Idle Process"},"log_version":"v3.0.0"}`))
+	require.NoError(t, err)
+	assert.Nil(t, document.Get("process.pid"))
+	assert.Equal(t, "System Idle Process", document.Get("process.name"))
+	assert.Equal(t, "event", document.Get("event.kind"))
+}
+
+func TestMap_Unsupported(t *testing.T) {
+	_, err := Map(&thorlogv1.Event{})
+	assert.Error(t, err)
+}
+
+func TestDocument_SetGet(t *testing.T) {
+	document := Document{}
+	document.Set("file.hash.sha256", "abc")
+	document.Set("file.hash.md5", "")
+	document.Set("file.mtime", time.Time{})
+	document.Set("file.size", 0)
+
+	assert.Equal(t, Document{"file": Document{"hash": Document{"sha256": "abc"}, "size": 0}}, document)
+	assert.Equal(t, "abc", document.Get("file.hash.sha256"))
+	assert.Nil(t, document.Get("file.hash.md5"))
+	assert.Nil(t, document.Get("process.pid"))
+}
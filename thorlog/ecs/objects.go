@@ -0,0 +1,154 @@
+package ecs
+
+import (
+	"strings"
+
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+// MapObject maps the fields of a reportable object to ECS fields.
+//
+// Files, processes, registry values, Windows services, network connecting threads and process connections are supported.
+// For other objects, an empty document is returned.
+func MapObject(object thorlog.ObservedObject) Document {
+	document := Document{}
+	switch typedObject := object.(type) {
+	case *thorlog.File:
+		mapFile(document, "file", typedObject)
+	case *thorlog.Process:
+		mapProcess(document, typedObject)
+	case *thorlog.RegistryValue:
+		mapRegistryPath(document, typedObject.Key)
+		if typedObject.ParsedValue != "" {
+			document.Set("registry.data.strings", []string{typedObject.ParsedValue})
+		}
+	case *thorlog.WindowsService:
+		document.Set("service.name", typedObject.ServiceName)
+		document.Set("user.name", typedObject.User)
+		mapRegistryPath(document, typedObject.Key)
+		mapFile(document, "file", typedObject.Image)
+	case *thorlog.NetworkConnectingThread:
+		mapProcess(document, typedObject.Process)
+		if typedObject.ThreadId != 0 {
+			document.Set("process.thread.id", typedObject.ThreadId)
+		}
+	case *thorlog.ProcessConnectionObject:
+		mapConnection(document, typedObject.ProcessConnection)
+	}
+	return document
+}
+
+// mapFile maps a file to the ECS file fields below prefix.
+func mapFile(document Document, prefix string, file *thorlog.File) {
+	if file == nil {
+		return
+	}
+	document.Set(prefix+".path", file.Path)
+	if separator := strings.LastIndexAny(file.Path, `/\`); separator >= 0 {
+		document.Set(prefix+".directory", file.Path[:separator])
+		document.Set(prefix+".name", file.Path[separator+1:])
+	} else {
+		document.Set(prefix+".name", file.Path)
+	}
+	document.Set(prefix+".extension", strings.TrimPrefix(file.Extension, "."))
+	if file.Size > 0 {
+		document.Set(prefix+".size", file.Size)
+	}
+	switch file.FileMode {
+	case thorlog.ModeFile:
+		document.Set(prefix+".type", "file")
+	case thorlog.Directory:
+		document.Set(prefix+".type", "dir")
+	case thorlog.Symlink:
+		document.Set(prefix+".type", "symlink")
+	}
+	document.Set(prefix+".target_path", file.Target)
+	if file.Hashes != nil {
+		document.Set(prefix+".hash.md5", file.Hashes.Md5)
+		document.Set(prefix+".hash.sha1", file.Hashes.Sha1)
+		document.Set(prefix+".hash.sha256", file.Hashes.Sha256)
+	}
+	if file.Filetimes != nil {
+		document.Set(prefix+".mtime", file.Filetimes.Mtime)
+		if file.Filetimes.Atime != nil {
+			document.Set(prefix+".accessed", *file.Filetimes.Atime)
+		}
+		if file.Filetimes.Ctime != nil {
+			document.Set(prefix+".ctime", *file.Filetimes.Ctime)
+		}
+		if file.Filetimes.Btime != nil {
+			document.Set(prefix+".created", *file.Filetimes.Btime)
+		}
+	}
+	switch permissions := file.Permissions.(type) {
+	case *thorlog.UnixPermissions:
+		document.Set(prefix+".owner", permissions.Owner)
+		document.Set(prefix+".group", permissions.Group)
+	case *thorlog.WindowsPermissions:
+		document.Set(prefix+".owner", permissions.Owner)
+	}
+	if file.PeInfo != nil {
+		document.Set(prefix+".pe.company", file.PeInfo.Company)
+		document.Set(prefix+".pe.description", file.PeInfo.FileDescription)
+		document.Set(prefix+".pe.product", file.PeInfo.Product)
+		document.Set(prefix+".pe.original_file_name", file.PeInfo.OriginalName)
+		document.Set(prefix+".pe.imphash", file.PeInfo.Imphash)
+		document.Set(prefix+".code_signature.exists", file.PeInfo.Signed)
+		if len(file.PeInfo.Signatures) > 0 {
+			document.Set(prefix+".code_signature.subject_name", file.PeInfo.Signatures[0].CertificateName)
+			document.Set(prefix+".code_signature.valid", file.PeInfo.Signatures[0].SignatureValid)
+		}
+	}
+}
+
+// mapProcess maps a process to the ECS process fields.
+func mapProcess(document Document, process *thorlog.Process) {
+	if process == nil {
+		return
+	}
+	if process.Pid != 0 {
+		document.Set("process.pid", process.Pid)
+	}
+	if process.Dead {
+		return
+	}
+	document.Set("process.name", process.Name)
+	document.Set("process.command_line", process.Cmdline)
+	document.Set("process.user.name", process.User)
+	document.Set("process.start", process.Created)
+	if process.Image != nil {
+		document.Set("process.executable", process.Image.Path)
+		if process.Image.Hashes != nil {
+			document.Set("process.hash.md5", process.Image.Hashes.Md5)
+			document.Set("process.hash.sha1", process.Image.Hashes.Sha1)
+			document.Set("process.hash.sha256", process.Image.Hashes.Sha256)
+		}
+	}
+	if process.ParentInfo.Pid != 0 {
+		document.Set("process.parent.pid", process.ParentInfo.Pid)
+	}
+	document.Set("process.parent.executable", process.ParentInfo.Exe)
+	document.Set("process.parent.command_line", process.ParentInfo.CommandLine)
+}
+
+// mapConnection maps a network connection to the ECS source, destination and network fields.
+func mapConnection(document Document, connection thorlog.ProcessConnection) {
+	document.Set("source.ip", connection.Ip)
+	if connection.Port != 0 {
+		document.Set("source.port", connection.Port)
+	}
+	document.Set("destination.ip", connection.RemoteIp)
+	if connection.RemotePort != 0 {
+		document.Set("destination.port", connection.RemotePort)
+	}
+	document.Set("network.transport", strings.ToLower(connection.Protocol))
+}
+
+// mapRegistryPath maps a registry path to the ECS registry fields.
+func mapRegistryPath(document Document, path string) {
+	document.Set("registry.path", path)
+	if hive, key, found := strings.Cut(path, `\`); found {
+		document.Set("registry.hive", hive)
+		document.Set("registry.key", key)
+	}
+}
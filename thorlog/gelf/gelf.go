@@ -0,0 +1,107 @@
+// Package gelf formats THOR events as GELF 1.1 messages for Graylog and writes them to files or HTTP inputs.
+package gelf
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/jsonpointer"
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	"github.com/NextronSystems/jsonlog/thorlog/syslog"
+)
+
+// Version is the GELF version of the formatted messages.
+const Version = "1.1"
+
+// Message is a GELF message. Additional fields are stored with their leading underscore.
+type Message map[string]any
+
+// Formatter formats events as GELF messages.
+//
+// The zero value uses the event's hostname and takes the additional fields from the text log representation of the event.
+type Formatter struct {
+	// Hostname overrides the hostname of the events if it is not empty.
+	Hostname string
+	// Pointers selects the JSON representation of the event for the additional fields.
+	// If it is set, each scalar value in the JSON event becomes a field named after its JSON pointer,
+	// e.g. /subject/path becomes _subject.path. Otherwise, each text log key becomes a field,
+	// e.g. FILE becomes _file. Empty values are omitted in both cases.
+	Pointers bool
+	// Textlog is used to format the additional fields if Pointers is not set.
+	Textlog jsonlog.TextlogFormatter
+	// OnError is called for events that can't be converted to JSON if Pointers is set.
+	// The additional fields are omitted for such events. If OnError is nil, such errors are ignored.
+	OnError func(err error)
+}
+
+// Format formats an event as a GELF message.
+//
+// The level is the syslog severity of the event's log level, and the timestamp is the event time in seconds.
+func (f Formatter) Format(event common.Event) Message {
+	metadata := event.Metadata()
+	message := Message{
+		"version":       Version,
+		"host":          f.host(metadata),
+		"short_message": event.Message(),
+		"level":         int(syslog.SeverityForLevel(metadata.Lvl)),
+	}
+	if message["short_message"] == "" {
+		// short_message must not be empty
+		message["short_message"] = "-"
+	}
+	if !metadata.Time.IsZero() {
+		message["timestamp"] = json.Number(strconv.FormatFloat(float64(metadata.Time.UnixMilli())/1000, 'f', 3, 64))
+	}
+	if f.Pointers {
+		fields, err := jsonpointer.Flatten(event)
+		if err != nil && f.OnError != nil {
+			f.OnError(err)
+		}
+		for _, field := range fields {
+			value := field.Value
+			if value == "" {
+				continue
+			}
+			if boolean, isBool := value.(bool); isBool {
+				// Additional fields must be strings or numbers
+				value = strconv.FormatBool(boolean)
+			}
+			message[fieldName(strings.Join(field.Pointer, "."))] = value
+		}
+	} else {
+		for _, pair := range f.Textlog.Format(event) {
+			if pair.Value == "" {
+				continue
+			}
+			message[fieldName(strings.ToLower(pair.Key))] = pair.Value
+		}
+	}
+	return message
+}
+
+func (f Formatter) host(metadata *common.LogEventMetadata) string {
+	if f.Hostname != "" {
+		return f.Hostname
+	}
+	if metadata.Source != "" {
+		return metadata.Source
+	}
+	// host must not be empty
+	return "unknown"
+}
+
+var invalidFieldCharacters = regexp.MustCompile(`[^\w.\-]`)
+
+// fieldName returns the name of the additional field for key.
+// Characters that are not allowed in field names are replaced by underscores.
+func fieldName(key string) string {
+	name := "_" + invalidFieldCharacters.ReplaceAllString(key, "_")
+	if name == "_id" {
+		// _id is reserved
+		return "_thor_id"
+	}
+	return name
+}
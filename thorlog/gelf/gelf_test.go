@@ -0,0 +1,136 @@
+package gelf
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	"github.com/NextronSystems/jsonlog/thorlog/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The events of a scan of a Kubernetes node, as a Graylog input receives them.
+const (
+	minerFile    = `{"type":"THOR assessment","meta":{"time":"2024-11-05T02:17:33.418Z","level":"Alert","module":"Filescan","scan_id":"S-Wm3bK8sJ1f","event_id":"3fa9c07d1e52","hostname":"k8s-node-04"},"message":"Malicious file found","score":90,"subject":{"type":"file","path":"/dev/shm/.x/kdevtmpfsi","exists":"yes","size":2285568},"reasons":[{"summary":"Filename IOC /dev/shm/.x/","signature":{"score":90,"kind":"Filename IOC"}}],"log_version":"v3.0.0"}`
+	scanFinished = `{"type":"THOR message","meta":{"time":"2024-11-05T02:41:09Z","level":"Notice","module":"Report","scan_id":"S-Wm3bK8sJ1f","hostname":"k8s-node-04"},"message":"Scan finished","fields":{"alerts":1,"warnings":0},"log_version":"v3.0.0"}`
+	// debugMessage has neither a host nor a message, although GELF requires both.
+	debugMessage = `{"type":"THOR message","meta":{"level":"Debug"},"message":"","log_version":"v3.0.0"}`
+)
+
+func parseEvent(t *testing.T, event string) common.Event {
+	t.Helper()
+	parsed, err := parser.ParseEvent([]byte(event))
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestFormatter_Format(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		formatter Formatter
+		event     string
+		want      Message
+	}{
+		{
+			name:  "textlog",
+			event: minerFile,
+			want: Message{
+				"version":       "1.1",
+				"host":          "k8s-node-04",
+				"short_message": "Malicious file found",
+				"timestamp":     json.Number("1730773053.418"),
+				"level":         1,
+				"_module":       "Filescan",
+				"_scanid":       "S-Wm3bK8sJ1f",
+				"_uid":          "3fa9c07d1e52",
+				"_message":      "Malicious file found",
+				"_score":        "90",
+				"_file":         "/dev/shm/.x/kdevtmpfsi",
+				"_size":         "2285568",
+				"_reason_1":     "Filename IOC /dev/shm/.x/",
+				"_subscore_1":   "90",
+				"_sigclass_1":   "Filename IOC",
+				"_sigtype_1":    "internal",
+				"_matched_1":    "(none)",
+			},
+		},
+		{
+			name:      "empty message",
+			formatter: Formatter{Hostname: "collector"},
+			event:     debugMessage,
+			want: Message{
+				"version":       "1.1",
+				"host":          "collector",
+				"short_message": "-",
+				"level":         7,
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.formatter.Format(parseEvent(t, tt.event)))
+		})
+	}
+}
+
+func TestFormatter_FormatPointers(t *testing.T) {
+	message := Formatter{Pointers: true}.Format(parseEvent(t, minerFile))
+	assert.Equal(t, "/dev/shm/.x/kdevtmpfsi", message["_subject.path"])
+	assert.Equal(t, json.Number("2285568"), message["_subject.size"])
+	assert.Equal(t, json.Number("90"), message["_score"])
+	assert.Equal(t, "Filename IOC", message["_reasons.0.signature.kind"])
+	assert.Equal(t, "S-Wm3bK8sJ1f", message["_meta.scan_id"])
+	assert.Equal(t, "2024-11-05T02:17:33.418Z", message["_meta.time"])
+	assert.NotContains(t, message, "_subject.extension")
+	assert.NotContains(t, message, "_id")
+}
+
+func TestFieldName(t *testing.T) {
+	assert.Equal(t, "_reason_1", fieldName("reason_1"))
+	assert.Equal(t, "_user_name", fieldName("user name"))
+	assert.Equal(t, "_thor_id", fieldName("id"))
+}
+
+func TestFileWriter(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := NewFileWriter(&buffer, Formatter{})
+	require.NoError(t, writer.Write(parseEvent(t, minerFile)))
+	require.NoError(t, writer.Write(parseEvent(t, scanFinished)))
+	require.NoError(t, writer.Close())
+
+	lines := bytes.Split(bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), []byte("\n"))
+	require.Len(t, lines, 2)
+	var message map[string]any
+	require.NoError(t, json.Unmarshal(lines[1], &message))
+	assert.Equal(t, "Scan finished", message["short_message"])
+}
+
+func TestHTTPWriter(t *testing.T) {
+	var received []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/gelf" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var message map[string]any
+		require.NoError(t, json.Unmarshal(data, &message))
+		received = append(received, message)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	writer := NewHTTPWriter(server.URL+"/gelf", Formatter{})
+	require.NoError(t, writer.Write(parseEvent(t, minerFile)))
+	require.Len(t, received, 1)
+	assert.Equal(t, "Malicious file found", received[0]["short_message"])
+	assert.Equal(t, 1730773053.418, received[0]["timestamp"])
+
+	writer.URL = server.URL + "/wrong"
+	assert.Error(t, writer.Write(parseEvent(t, minerFile)))
+}
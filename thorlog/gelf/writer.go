@@ -0,0 +1,91 @@
+package gelf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+)
+
+// FileWriter writes events as GELF messages to a file or another stream, one JSON message per line.
+//
+// A FileWriter is safe for concurrent use.
+type FileWriter struct {
+	// Formatter is used to format the events.
+	Formatter Formatter
+
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewFileWriter creates a new FileWriter that writes to w.
+func NewFileWriter(w io.Writer, formatter Formatter) *FileWriter {
+	return &FileWriter{
+		Formatter: formatter,
+		w:         w,
+	}
+}
+
+// Write writes the event as a single line.
+func (w *FileWriter) Write(event common.Event) error {
+	data, err := json.Marshal(w.Formatter.Format(event))
+	if err != nil {
+		return err
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_, err = w.w.Write(append(data, '\n'))
+	return err
+}
+
+// Close closes the underlying writer if it implements io.Closer.
+func (w *FileWriter) Close() error {
+	if closer, ok := w.w.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// HTTPWriter sends events as GELF messages to a Graylog GELF HTTP input, one message per request.
+type HTTPWriter struct {
+	// URL is the URL of the input, e.g. http://graylog.example.com:12201/gelf.
+	URL string
+	// Client is used to send the requests. If it is nil, http.DefaultClient is used.
+	Client *http.Client
+	// Formatter is used to format the events.
+	Formatter Formatter
+}
+
+// NewHTTPWriter creates a new HTTPWriter that sends messages to url.
+func NewHTTPWriter(url string, formatter Formatter) *HTTPWriter {
+	return &HTTPWriter{
+		URL:       url,
+		Formatter: formatter,
+	}
+}
+
+// Write sends the event as a single message.
+func (w *HTTPWriter) Write(event common.Event) error {
+	data, err := json.Marshal(w.Formatter.Format(event))
+	if err != nil {
+		return err
+	}
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	response, err := client.Post(w.URL, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, response.Body)
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("GELF input returned status %s", response.Status)
+	}
+	return nil
+}
@@ -17,7 +17,8 @@ import (
 
 var objectType = reflect.TypeOf((*jsonlog.Object)(nil)).Elem()
 
-func makeObjectSchema() (mainEntry string, defs map[string]*jsonschema.Schema) {
+// makeObjectSchema generates a schema for all log object types in the registry.
+func makeObjectSchema(registry *thorlog.Registry) (mainEntry string, defs map[string]*jsonschema.Schema) {
 	var allLogObjects []*jsonschema.Schema
 	var logObjectTypes []any
 	var reflector jsonschema.Reflector
@@ -28,8 +29,9 @@ func makeObjectSchema() (mainEntry string, defs map[string]*jsonschema.Schema) {
 	}
 	defs = map[string]*jsonschema.Schema{}
 
+	var logObjects = registry.Types()
 	// Sort the object type names to have a stable output
-	var objectTypeNames = slices.Collect(maps.Keys(thorlog.LogObjectTypes))
+	var objectTypeNames = slices.Collect(maps.Keys(logObjects))
 	slices.Sort(objectTypeNames)
 
 	reflector.Mapper = func(r reflect.Type) *jsonschema.Schema {
@@ -41,7 +43,7 @@ func makeObjectSchema() (mainEntry string, defs map[string]*jsonschema.Schema) {
 				// and generate a oneOf schema for them.
 				var implementations = &jsonschema.Schema{}
 				for _, typename := range objectTypeNames {
-					t := thorlog.LogObjectTypes[typename]
+					t := logObjects[typename]
 					if reflect.TypeOf(t).Implements(r) {
 						structName := reflect.TypeOf(t).Elem().Name()
 						implementations.OneOf = append(implementations.OneOf, &jsonschema.Schema{
@@ -62,7 +64,7 @@ func makeObjectSchema() (mainEntry string, defs map[string]*jsonschema.Schema) {
 		return nil
 	}
 	for _, typename := range objectTypeNames {
-		schema := reflector.Reflect(thorlog.LogObjectTypes[typename])
+		schema := reflector.Reflect(logObjects[typename])
 		refName := strings.TrimPrefix(schema.Ref, "#/$defs/")
 		typeSchema := schema.Definitions[refName]
 		typenameDef, ok := typeSchema.Properties.Get("type")
@@ -104,7 +106,7 @@ func main() {
 			},
 		},
 	}
-	entry, defs := makeObjectSchema()
+	entry, defs := makeObjectSchema(thorlog.DefaultRegistry)
 	for key, value := range defs {
 		logEventSchema.Definitions[key] = value
 	}
@@ -0,0 +1,518 @@
+// Package markdown summarizes THOR assessments and scans as Markdown, e.g. for tickets or chat hand-offs.
+//
+// The summaries contain the subject, the most relevant reasons with their matched strings, and the context objects.
+// Lists and values that exceed the configured limits are truncated, and the summary states how much was omitted.
+package markdown
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+// Default limits that are used if no other limit is configured.
+const (
+	DefaultMaxReasons        = 5
+	DefaultMaxMatches        = 5
+	DefaultMaxContextObjects = 10
+	DefaultMaxAssessments    = 20
+	DefaultMaxValueLength    = 256
+)
+
+// Renderer renders assessments as Markdown.
+//
+// The zero value uses the default limits and no limit on the total output size.
+type Renderer struct {
+	// MaxReasons is the maximum number of reasons per assessment. If it is 0, DefaultMaxReasons is used.
+	MaxReasons int
+	// MaxMatches is the maximum number of matched strings per reason. If it is 0, DefaultMaxMatches is used.
+	MaxMatches int
+	// MaxContextObjects is the maximum number of context objects per assessment. If it is 0, DefaultMaxContextObjects is used.
+	MaxContextObjects int
+	// MaxAssessments is the maximum number of assessments in a scan summary. If it is 0, DefaultMaxAssessments is used.
+	MaxAssessments int
+	// MaxValueLength is the maximum number of characters of a single value. If it is 0, DefaultMaxValueLength is used.
+	MaxValueLength int
+	// MaxLength is the maximum size of the output in bytes. If the output is longer, it is cut off after the last
+	// complete line that fits, followed by a note on how much was omitted. If MaxLength is too small for the note,
+	// the output is cut off without a note. If it is 0, the output size is not limited.
+	MaxLength int
+	// Textlog formats the fields of objects that have no dedicated summary.
+	// If its FormatValue is nil, the values are formatted with thorlog.NewTextlogFormatter.
+	Textlog jsonlog.TextlogFormatter
+}
+
+// Assessment summarizes a single assessment.
+//
+// The number of omitted reasons includes reasons that THOR did not log, as indicated by the assessment's ReasonCount.
+// Issues of the assessment, e.g. about values that THOR truncated, are listed as well.
+func (r Renderer) Assessment(assessment *thorlog.Assessment) string {
+	var b strings.Builder
+	r.writeAssessment(&b, assessment, 1)
+	return r.truncate(b.String())
+}
+
+// Scan summarizes the assessments of a scan. The assessments with the highest scores are described in detail;
+// the remaining assessments are only counted.
+func (r Renderer) Scan(assessments []*thorlog.Assessment) string {
+	var b strings.Builder
+	title := "THOR scan"
+	if len(assessments) > 0 {
+		meta := assessments[0].Meta
+		if meta.ScanID != "" {
+			title += " " + meta.ScanID
+		}
+		if meta.Source != "" {
+			title += " on " + meta.Source
+		}
+	}
+	heading(&b, 1, escape(title))
+
+	var start, end time.Time
+	counts := map[common.LogLevel]int{}
+	for _, assessment := range assessments {
+		counts[assessment.Meta.Lvl]++
+		if t := assessment.Meta.Time; !t.IsZero() {
+			if start.IsZero() || t.Before(start) {
+				start = t
+			}
+			if t.After(end) {
+				end = t
+			}
+		}
+	}
+	item(&b, "", "Assessments", strconv.Itoa(len(assessments)))
+	for _, level := range []common.LogLevel{common.Alert, common.Warning, common.Notice, common.Info} {
+		if counts[level] > 0 {
+			item(&b, "", string(level)+"s", strconv.Itoa(counts[level]))
+		}
+	}
+	if !start.IsZero() {
+		item(&b, "", "Period", formatTime(start)+" – "+formatTime(end))
+	}
+
+	sorted := make([]*thorlog.Assessment, len(assessments))
+	copy(sorted, assessments)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Score > sorted[j].Score
+	})
+	shown := limit(len(sorted), r.MaxAssessments, DefaultMaxAssessments)
+	for _, assessment := range sorted[:shown] {
+		b.WriteString("\n")
+		r.writeAssessment(&b, assessment, 2)
+	}
+	omitted(&b, "", len(sorted)-shown, "assessment", "assessments")
+	return r.truncate(b.String())
+}
+
+func (r Renderer) writeAssessment(b *strings.Builder, assessment *thorlog.Assessment, level int) {
+	title := escape(assessment.Text)
+	if assessment.Meta.Lvl != "" {
+		title = escape(string(assessment.Meta.Lvl)) + ": " + title
+	}
+	heading(b, level, title)
+	item(b, "", "Score", strconv.FormatInt(assessment.Score, 10))
+	item(b, "", "Time", formatTime(assessment.Meta.Time))
+	item(b, "", "Host", escape(assessment.Meta.Source))
+	item(b, "", "Module", escape(assessment.Meta.Mod))
+	item(b, "", "Scan ID", r.code(assessment.Meta.ScanID))
+
+	if assessment.Subject != nil {
+		b.WriteString("\n")
+		heading(b, level+1, "Subject: "+escape(assessment.Subject.EmbeddedHeader().Type))
+		r.writeSubject(b, assessment.Subject)
+	}
+	if len(assessment.Reasons) > 0 || assessment.ReasonCount > 0 {
+		b.WriteString("\n")
+		heading(b, level+1, "Reasons")
+		r.writeReasons(b, assessment)
+	}
+	if len(assessment.EventContext) > 0 {
+		b.WriteString("\n")
+		heading(b, level+1, "Context")
+		r.writeContext(b, assessment.EventContext)
+	}
+	if len(assessment.Issues) > 0 {
+		b.WriteString("\n")
+		heading(b, level+1, "Issues")
+		for _, issue := range assessment.Issues {
+			var affected string
+			if issue.Affected != nil {
+				affected = " " + r.code(issue.Affected.ToJsonPointer().String())
+			}
+			fmt.Fprintf(b, "- **%s**%s: %s\n", escape(issue.Category), affected, r.text(issue.Description))
+		}
+	}
+}
+
+func (r Renderer) writeSubject(b *strings.Builder, subject thorlog.ObservedObject) {
+	switch subject := subject.(type) {
+	case *thorlog.File:
+		r.writeFile(b, "", subject)
+	case *thorlog.Process:
+		item(b, "", "PID", strconv.Itoa(int(subject.Pid)))
+		item(b, "", "Name", r.text(subject.Name))
+		item(b, "", "Command line", r.code(subject.Cmdline))
+		item(b, "", "Owner", r.text(subject.User))
+		if subject.Image != nil {
+			item(b, "", "Image", r.code(subject.Image.Path))
+			r.writeFile(b, "  ", &thorlog.File{Hashes: subject.Image.Hashes, PeInfo: subject.Image.PeInfo})
+		}
+		if subject.ParentInfo.Pid != 0 {
+			item(b, "", "Parent PID", strconv.Itoa(int(subject.ParentInfo.Pid)))
+		}
+		item(b, "", "Parent image", r.code(subject.ParentInfo.Exe))
+		item(b, "", "Parent command line", r.code(subject.ParentInfo.CommandLine))
+	default:
+		r.writeFields(b, "", subject)
+	}
+}
+
+// writeFile writes the path, hashes and PE information of a file.
+func (r Renderer) writeFile(b *strings.Builder, indent string, file *thorlog.File) {
+	item(b, indent, "Path", r.code(file.Path))
+	if file.Size > 0 {
+		item(b, indent, "Size", strconv.FormatUint(file.Size, 10)+" bytes")
+	}
+	item(b, indent, "Type", r.text(file.MagicHeader))
+	if file.Hashes != nil {
+		item(b, indent, "MD5", r.code(file.Hashes.Md5))
+		item(b, indent, "SHA1", r.code(file.Hashes.Sha1))
+		item(b, indent, "SHA256", r.code(file.Hashes.Sha256))
+	}
+	if pe := file.PeInfo; pe != nil {
+		item(b, indent, "Company", r.text(pe.Company))
+		item(b, indent, "Product", r.text(pe.Product))
+		item(b, indent, "Description", r.text(pe.FileDescription))
+		item(b, indent, "Original name", r.text(pe.OriginalName))
+		signed := "no"
+		if pe.Signed {
+			signed = "yes"
+			var certificates []string
+			for _, signature := range pe.Signatures {
+				if signature.CertificateName != "" {
+					certificates = append(certificates, r.text(signature.CertificateName))
+				}
+			}
+			if len(certificates) > 0 {
+				signed += " (" + strings.Join(certificates, ", ") + ")"
+			}
+		}
+		item(b, indent, "Signed", signed)
+		item(b, indent, "Imphash", r.code(pe.Imphash))
+	}
+}
+
+// writeFields writes the non-empty text log fields of an object.
+func (r Renderer) writeFields(b *strings.Builder, indent string, object jsonlog.Object) {
+	for _, pair := range r.textlog().Format(object) {
+		item(b, indent, escape(pair.Key), r.code(pair.Value))
+	}
+}
+
+func (r Renderer) writeReasons(b *strings.Builder, assessment *thorlog.Assessment) {
+	total := len(assessment.Reasons)
+	if assessment.ReasonCount > total {
+		total = assessment.ReasonCount
+	}
+	shown := limit(len(assessment.Reasons), r.MaxReasons, DefaultMaxReasons)
+	for i, reason := range assessment.Reasons[:shown] {
+		fmt.Fprintf(b, "%d. **%s**", i+1, r.text(reason.Summary))
+		if reason.Signature.Score != 0 {
+			fmt.Fprintf(b, " (score %d)", reason.Signature.Score)
+		}
+		b.WriteString("\n")
+		const indent = "   "
+		rule := r.code(reason.Rulename)
+		if rule == "" {
+			rule = escape(string(reason.Class))
+		} else if reason.Class != "" {
+			rule += " (" + escape(string(reason.Class)) + ")"
+		}
+		item(b, indent, "Rule", rule)
+		item(b, indent, "Author", r.text(reason.Author))
+		item(b, indent, "Description", r.text(reason.LongDescription))
+		var references []string
+		for _, reference := range reason.Ref {
+			references = append(references, r.link(reference))
+		}
+		item(b, indent, "References", strings.Join(references, ", "))
+		var tags []string
+		for _, tag := range reason.Tags {
+			tags = append(tags, escape(tag))
+		}
+		item(b, indent, "Tags", strings.Join(tags, ", "))
+		if len(reason.StringMatches) > 0 {
+			b.WriteString(indent + "- **Matches:**\n")
+			shownMatches := limit(len(reason.StringMatches), r.MaxMatches, DefaultMaxMatches)
+			for _, match := range reason.StringMatches[:shownMatches] {
+				b.WriteString(indent + "  - " + r.match(match) + "\n")
+			}
+			omitted(b, indent+"  ", len(reason.StringMatches)-shownMatches, "match", "matches")
+		}
+	}
+	omitted(b, "", total-shown, "reason", "reasons")
+}
+
+// match formats a matched string with its context and location as code spans.
+func (r Renderer) match(match thorlog.MatchString) string {
+	formatted := r.code(match.Match.String())
+	if match.Context != nil {
+		formatted += " in " + r.code(match.Context.String())
+	}
+	if match.Offset != nil && !match.HideOffset {
+		formatted += fmt.Sprintf(" at %#x", *match.Offset)
+	}
+	if match.Field != nil {
+		formatted += " in " + r.code(match.Field.String())
+	}
+	return formatted
+}
+
+// writeContext writes the context objects, grouped by the name of their first relation.
+func (r Renderer) writeContext(b *strings.Builder, context thorlog.Context) {
+	type group struct {
+		relation thorlog.Relation
+		objects  []thorlog.ObservedObject
+	}
+	var groups []*group
+	byRelation := map[thorlog.Relation]*group{}
+	shown := limit(len(context), r.MaxContextObjects, DefaultMaxContextObjects)
+	for _, element := range context[:shown] {
+		var relation thorlog.Relation
+		if len(element.Relations) > 0 {
+			relation = element.Relations[0]
+		}
+		g := byRelation[relation]
+		if g == nil {
+			g = &group{relation: relation}
+			byRelation[relation] = g
+			groups = append(groups, g)
+		}
+		g.objects = append(g.objects, element.Object)
+	}
+	for _, g := range groups {
+		name := g.relation.Name
+		if name == "" {
+			name = g.relation.Type
+		}
+		if name == "" {
+			name = "related"
+		}
+		b.WriteString("- **" + escape(name) + "**")
+		if g.relation.Name != "" && g.relation.Type != "" {
+			b.WriteString(" (" + escape(g.relation.Type) + ")")
+		}
+		b.WriteString("\n")
+		for _, object := range g.objects {
+			b.WriteString("  - " + r.summary(object) + "\n")
+		}
+	}
+	omitted(b, "", len(context)-shown, "context object", "context objects")
+}
+
+// summary returns a single line that identifies an object.
+func (r Renderer) summary(object thorlog.ObservedObject) string {
+	if object == nil {
+		return "_(none)_"
+	}
+	objectType := escape(object.EmbeddedHeader().Type)
+	switch object := object.(type) {
+	case *thorlog.File:
+		return objectType + " " + r.code(object.Path)
+	case *thorlog.Process:
+		summary := objectType + " " + strconv.Itoa(int(object.Pid))
+		if object.Name != "" {
+			summary += " " + r.text(object.Name)
+		}
+		if object.Cmdline != "" {
+			summary += ": " + r.code(object.Cmdline)
+		}
+		return summary
+	}
+	for _, pair := range r.textlog().Format(object) {
+		if pair.Value != "" {
+			return objectType + " " + escape(pair.Key) + " " + r.code(pair.Value)
+		}
+	}
+	return objectType
+}
+
+func (r Renderer) textlog() jsonlog.TextlogFormatter {
+	if r.Textlog.FormatValue == nil {
+		formatter := thorlog.NewTextlogFormatter()
+		formatter.Omit = r.Textlog.Omit
+		formatter.OnError = r.Textlog.OnError
+		formatter.MapOrder = r.Textlog.MapOrder
+		return formatter
+	}
+	return r.Textlog
+}
+
+// text escapes and shortens a value for use in Markdown text.
+func (r Renderer) text(value string) string {
+	value, cut := r.shorten(value)
+	return escape(value) + cutNote(cut)
+}
+
+// code formats a value as a code span, shortening it if necessary. Empty values result in an empty string.
+func (r Renderer) code(value string) string {
+	if value == "" {
+		return ""
+	}
+	value, cut := r.shorten(value)
+	value = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(value)
+	longestRun, run := 0, 0
+	for _, char := range value {
+		if char == '`' {
+			run++
+			if run > longestRun {
+				longestRun = run
+			}
+		} else {
+			run = 0
+		}
+	}
+	fence := strings.Repeat("`", longestRun+1)
+	if strings.HasPrefix(value, "`") || strings.HasSuffix(value, "`") {
+		value = " " + value + " "
+	}
+	return fence + value + fence + cutNote(cut)
+}
+
+// link formats a reference as an autolink if it is a web URL, or as text otherwise.
+func (r Renderer) link(reference string) string {
+	if (strings.HasPrefix(reference, "https://") || strings.HasPrefix(reference, "http://")) &&
+		!strings.ContainsAny(reference, " <>\r\n") && utf8.RuneCountInString(reference) <= r.maxValueLength() {
+		return "<" + reference + ">"
+	}
+	return r.text(reference)
+}
+
+// shorten cuts a value to MaxValueLength characters and returns the number of omitted characters.
+func (r Renderer) shorten(value string) (string, int) {
+	length := utf8.RuneCountInString(value)
+	maxLength := r.maxValueLength()
+	if length <= maxLength {
+		return value, 0
+	}
+	runes := []rune(value)
+	return string(runes[:maxLength]) + "…", length - maxLength
+}
+
+func (r Renderer) maxValueLength() int {
+	if r.MaxValueLength == 0 {
+		return DefaultMaxValueLength
+	}
+	return r.MaxValueLength
+}
+
+// truncate cuts the output to MaxLength bytes at a line boundary and notes how much was omitted.
+func (r Renderer) truncate(output string) string {
+	if r.MaxLength == 0 || len(output) <= r.MaxLength {
+		return output
+	}
+	// The note for the complete output is at least as long as the note for the actually omitted part
+	budget := r.MaxLength - len(truncationNote(output))
+	if budget <= 0 {
+		// There is no room for the note, so just cut off the output, preferably at a line boundary
+		cut := strings.LastIndex(output[:r.MaxLength], "\n") + 1
+		if cut == 0 {
+			cut = r.MaxLength
+			for cut > 0 && !utf8.RuneStart(output[cut]) {
+				cut--
+			}
+		}
+		return output[:cut]
+	}
+	cut := strings.LastIndex(output[:budget], "\n") + 1
+	return output[:cut] + truncationNote(output[cut:])
+}
+
+func truncationNote(omittedOutput string) string {
+	return fmt.Sprintf("\n_Output truncated: %d more lines (%d bytes) omitted._\n", strings.Count(omittedOutput, "\n"), len(omittedOutput))
+}
+
+var escaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`,
+	"<", `\<`, ">", `\>`, "#", `\#`, "|", `\|`, "~", `\~`, "!", `\!`,
+	"\r\n", " ", "\n", " ", "\r", " ",
+)
+
+// escape escapes Markdown syntax in text.
+// Since values may start a line, list markers at the start of the text are escaped as well.
+func escape(text string) string {
+	text = escaper.Replace(text)
+	start := len(text) - len(strings.TrimLeft(text, " \t"))
+	marker := start
+	for marker < len(text) && text[marker] >= '0' && text[marker] <= '9' {
+		marker++
+	}
+	if marker == len(text) {
+		return text
+	}
+	switch {
+	case marker == start && (text[marker] == '-' || text[marker] == '+'),
+		marker > start && (text[marker] == '.' || text[marker] == ')'):
+		return text[:marker] + `\` + text[marker:]
+	}
+	return text
+}
+
+func heading(b *strings.Builder, level int, title string) {
+	b.WriteString(strings.Repeat("#", level) + " " + title + "\n\n")
+}
+
+// item writes a list item with a bold label. Items without a value are skipped.
+func item(b *strings.Builder, indent string, label string, value string) {
+	if value == "" {
+		return
+	}
+	b.WriteString(indent + "- **" + label + ":** " + strings.TrimSpace(value) + "\n")
+}
+
+// omitted writes a note about omitted list elements, if there are any.
+func omitted(b *strings.Builder, indent string, count int, singular string, plural string) {
+	if count <= 0 {
+		return
+	}
+	noun := plural
+	if count == 1 {
+		noun = singular
+	}
+	fmt.Fprintf(b, "%s- _%d more %s omitted._\n", indent, count, noun)
+}
+
+func cutNote(cut int) string {
+	if cut == 0 {
+		return ""
+	}
+	if cut == 1 {
+		return " _(1 more character)_"
+	}
+	return fmt.Sprintf(" _(%d more characters)_", cut)
+}
+
+func limit(length int, configured int, defaultLimit int) int {
+	if configured == 0 {
+		configured = defaultLimit
+	}
+	if length < configured {
+		return length
+	}
+	return configured
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,125 @@
+package markdown
+
+import (
+	"flag"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/NextronSystems/jsonlog/thorlog/parser"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// loaderFile is a signed loader that was dropped from an installer archive. Its reasons, matches and
+// context objects exceed the limits that the tests use, and its values contain Markdown syntax.
+const loaderFile = `{"type":"THOR assessment","meta":{"time":"2024-08-19T10:22:07Z","level":"Alert","module":"Filescan","scan_id":"S-Rc3xF9uT6a","hostname":"ws-eng-22"},"message":"Malicious file found","score":85,"subject":{"type":"file","path":"C:\\Users\\a.novak\\AppData\\Roaming\\Zoom\\bin\\zoom_update.exe","exists":"yes","extension":".exe","hashes":{"md5":"6f1c0e2d9b8a7f3e4d5c6b7a8f9e0d1c","sha1":"2b7e151628aed2a6abf7158809cf4f3c762e7160","sha256":"c4a8e1f07b2d3956e0f1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f7"},"size":1847296,"pe_info":{"company":"Zoom Video Communications, Inc.","original_name":"ZoomUpdate.exe","signed":true,"signatures":[{"certificate_name":"Shenzhen Jiayi Technology Co., Ltd","signature_valid":true}],"imphash":"a3cf2e4b9d0817f6c5e4d3b2a1908f7e"}},"reasons":[{"summary":"YARA rule APT_Loader_PowerShell_Cradle","signature":{"score":75,"reference":["https://attack.mitre.org/techniques/T1059/001/","case #2024-117"],"kind":"YARA Rule","tags":["APT","T1059.001"],"rule_name":"APT_Loader_PowerShell_Cradle","author":"Nextron Threat Research"},"matched":[{"data":{"data":"I\u0060E\u0060X","encoding":"plain"},"context":{"data":"-c I\u0060E\u0060X(New-Object Net.WebClient)","encoding":"plain"},"offset":7248},{"data":{"data":"DownloadString","encoding":"plain"}},{"data":{"data":"-WindowStyle Hidden","encoding":"plain"}}]},{"summary":"Filename IOC zoom_update.exe","signature":{"score":40,"kind":"Filename IOC"}},{"summary":"Signed with a revoked certificate","signature":{"score":30,"kind":"Internal Heuristic"}}],"reason_count":5,"context":[{"object":{"type":"file","path":"C:\\Users\\a.novak\\Downloads\\ZoomInstallerFull.zip","exists":"yes"},"relations":[{"relation_type":"derives from","relation_name":"parent","unique":false}]},{"object":{"type":"process","pid":6128,"name":"explorer.exe","command":"C:\\Windows\\explorer.exe /factory,{75dff2b7-6936-4c06-a8bb-676a7b00b24b} -Embedding"},"relations":[{"relation_type":"related to","relation_name":"dropped by","unique":false}]},{"object":{"type":"file","path":"C:\\Users\\a.novak\\Downloads\\ZoomInstallerFull.zip\\bin.zip","exists":"yes"},"relations":[{"relation_type":"derives from","relation_name":"parent","unique":false}]}],"issues":[{"affected":"/reasons","category":"truncated","description":"Removed 2 reasons"}],"log_version":"v3.0.0"}`
+
+// masqueradingProcess is a process without reasons.
+const masqueradingProcess = `{"type":"THOR assessment","meta":{"time":"2024-08-19T10:31:55Z","level":"Warning","module":"ProcessCheck","scan_id":"S-Wd2kY7pN4s","hostname":"build-03"},"message":"Suspicious process found","score":65,"subject":{"type":"process","pid":2817,"name":"kthreadd","command":"/dev/shm/kthreadd --daemon","image":{"type":"file","path":"/dev/shm/kthreadd","hashes":{"sha256":"7d865e959b2466918c9863afca942d0fb89d7c9ac0c99bafc3749504ded97730"}},"parent_info":{"pid":1,"exe":"/sbin/init"}},"log_version":"v3.0.0"}`
+
+// scanLog contains the assessments of a scan with different scores and levels.
+const scanLog = `{"type":"THOR assessment","meta":{"time":"2024-08-19T10:24:40Z","level":"Notice","module":"Filescan","scan_id":"S-Rc3xF9uT6a","hostname":"ws-eng-22"},"message":"Suspicious file found","score":40,"subject":{"type":"file","path":"C:\\Users\\a.novak\\AppData\\Local\\Temp\\~DF3A1C.tmp","exists":"yes"},"reasons":[{"summary":"Filename IOC ~DF*.tmp","signature":{"score":40,"kind":"Filename IOC"}}],"log_version":"v3.0.0"}
+{"type":"THOR assessment","meta":{"time":"2024-08-19T10:22:07Z","level":"Alert","module":"Filescan","scan_id":"S-Rc3xF9uT6a","hostname":"ws-eng-22"},"message":"Malicious file found","score":85,"subject":{"type":"file","path":"C:\\Users\\a.novak\\AppData\\Roaming\\Zoom\\bin\\zoom_update.exe","exists":"yes","extension":".exe","hashes":{"md5":"6f1c0e2d9b8a7f3e4d5c6b7a8f9e0d1c","sha1":"2b7e151628aed2a6abf7158809cf4f3c762e7160","sha256":"c4a8e1f07b2d3956e0f1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f7"},"size":1847296,"pe_info":{"company":"Zoom Video Communications, Inc.","original_name":"ZoomUpdate.exe","signed":true,"signatures":[{"certificate_name":"Shenzhen Jiayi Technology Co., Ltd","signature_valid":true}],"imphash":"a3cf2e4b9d0817f6c5e4d3b2a1908f7e"}},"reasons":[{"summary":"YARA rule APT_Loader_PowerShell_Cradle","signature":{"score":75,"reference":["https://attack.mitre.org/techniques/T1059/001/","case #2024-117"],"kind":"YARA Rule","tags":["APT","T1059.001"],"rule_name":"APT_Loader_PowerShell_Cradle","author":"Nextron Threat Research"},"matched":[{"data":{"data":"I\u0060E\u0060X","encoding":"plain"},"context":{"data":"-c I\u0060E\u0060X(New-Object Net.WebClient)","encoding":"plain"},"offset":7248},{"data":{"data":"DownloadString","encoding":"plain"}},{"data":{"data":"-WindowStyle Hidden","encoding":"plain"}}]},{"summary":"Filename IOC zoom_update.exe","signature":{"score":40,"kind":"Filename IOC"}},{"summary":"Signed with a revoked certificate","signature":{"score":30,"kind":"Internal Heuristic"}}],"reason_count":5,"context":[{"object":{"type":"file","path":"C:\\Users\\a.novak\\Downloads\\ZoomInstallerFull.zip","exists":"yes"},"relations":[{"relation_type":"derives from","relation_name":"parent","unique":false}]},{"object":{"type":"process","pid":6128,"name":"explorer.exe","command":"C:\\Windows\\explorer.exe /factory,{75dff2b7-6936-4c06-a8bb-676a7b00b24b} -Embedding"},"relations":[{"relation_type":"related to","relation_name":"dropped by","unique":false}]},{"object":{"type":"file","path":"C:\\Users\\a.novak\\Downloads\\ZoomInstallerFull.zip\\bin.zip","exists":"yes"},"relations":[{"relation_type":"derives from","relation_name":"parent","unique":false}]}],"issues":[{"affected":"/reasons","category":"truncated","description":"Removed 2 reasons"}],"log_version":"v3.0.0"}
+{"type":"THOR assessment","meta":{"time":"2024-08-19T10:26:13Z","level":"Alert","module":"Filescan","scan_id":"S-Rc3xF9uT6a","hostname":"ws-eng-22"},"message":"Malicious file found","score":70,"subject":{"type":"file","path":"C:\\ProgramData\\Zoom\\zoom_svc.dll","exists":"yes"},"reasons":[{"summary":"Hash IOC of a known loader","signature":{"score":70,"kind":"Hash IOC"}}],"log_version":"v3.0.0"}
+`
+
+func parseAssessment(t *testing.T, event string) *thorlog.Assessment {
+	t.Helper()
+	parsed, err := parser.ParseEvent([]byte(event))
+	require.NoError(t, err)
+	require.IsType(t, &thorlog.Assessment{}, parsed)
+	return parsed.(*thorlog.Assessment)
+}
+
+func TestRenderer_Assessment(t *testing.T) {
+	actual := Renderer{MaxReasons: 2, MaxMatches: 2, MaxContextObjects: 2}.Assessment(parseAssessment(t, loaderFile))
+	goldenFile := "testdata/assessment.md"
+	if *update {
+		require.NoError(t, os.WriteFile(goldenFile, []byte(actual), 0644))
+	}
+	expected, err := os.ReadFile(goldenFile)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), actual)
+}
+
+func TestRenderer_AssessmentProcess(t *testing.T) {
+	summary := Renderer{}.Assessment(parseAssessment(t, masqueradingProcess))
+	assert.Contains(t, summary, "- **PID:** 2817\n- **Name:** kthreadd\n- **Command line:** `/dev/shm/kthreadd --daemon`\n")
+	assert.Contains(t, summary, "- **Image:** `/dev/shm/kthreadd`\n  - **SHA256:** `7d865e959b2466918c9863afca942d0fb89d7c9ac0c99bafc3749504ded97730`\n")
+	assert.Contains(t, summary, "- **Parent PID:** 1\n- **Parent image:** `/sbin/init`\n")
+	assert.NotContains(t, summary, "Reasons")
+}
+
+func TestRenderer_Scan(t *testing.T) {
+	var assessments []*thorlog.Assessment
+	reader := parser.NewReader(strings.NewReader(scanLog))
+	for reader.Next() {
+		assessments = append(assessments, reader.Event().(*thorlog.Assessment))
+	}
+	require.NoError(t, reader.Err())
+	summary := Renderer{MaxAssessments: 1}.Scan(assessments)
+	assert.True(t, strings.HasPrefix(summary, "# THOR scan S-Rc3xF9uT6a on ws-eng-22\n\n- **Assessments:** 3\n- **Alerts:** 2\n- **Notices:** 1\n"), summary)
+	assert.Contains(t, summary, "\n## Alert: Malicious file found\n\n- **Score:** 85\n")
+	assert.Contains(t, summary, "\n### Reasons\n")
+	assert.NotContains(t, summary, "DF3A1C")
+	assert.NotContains(t, summary, "zoom_svc.dll")
+	assert.True(t, strings.HasSuffix(summary, "- _2 more assessments omitted._\n"), summary)
+}
+
+func TestRenderer_MaxLength(t *testing.T) {
+	full := Renderer{}.Assessment(parseAssessment(t, loaderFile))
+	truncated := Renderer{MaxLength: 300}.Assessment(parseAssessment(t, loaderFile))
+	assert.LessOrEqual(t, len(truncated), 300)
+	cut := strings.Index(truncated, "\n_Output truncated: ")
+	require.Greater(t, cut, 0, truncated)
+	assert.True(t, strings.HasPrefix(full, truncated[:cut]))
+	omitted := full[cut:]
+	assert.Equal(t, truncated[cut:], truncationNote(omitted))
+
+	assert.Equal(t, full, Renderer{MaxLength: len(full)}.Assessment(parseAssessment(t, loaderFile)))
+
+	tiny := Renderer{MaxLength: 20}.Assessment(parseAssessment(t, loaderFile))
+	assert.LessOrEqual(t, len(tiny), 20)
+	assert.True(t, strings.HasPrefix(full, tiny), tiny)
+	assert.Equal(t, "ab", Renderer{MaxLength: 3}.truncate("ab€cd"))
+}
+
+func TestEscape(t *testing.T) {
+	for _, tt := range []struct {
+		text string
+		want string
+	}{
+		{"plain text", "plain text"},
+		{"- item", `\- item`},
+		{"+ item", `\+ item`},
+		{"  - item", `  \- item`},
+		{"1. item", `1\. item`},
+		{"12) item", `12\) item`},
+		{"![image](https://example.com)", `\!\[image\](https://example.com)`},
+		{"a-b + 1. c", "a-b + 1. c"},
+		{"2024", "2024"},
+		{"*bold*", `\*bold\*`},
+	} {
+		assert.Equal(t, tt.want, escape(tt.text), tt.text)
+	}
+}
+
+func TestRenderer_MaxValueLength(t *testing.T) {
+	r := Renderer{MaxValueLength: 5}
+	assert.Equal(t, "`abcde…` _(3 more characters)_", r.code("abcdefgh"))
+	assert.Equal(t, `a\*b\_c… _(1 more character)_`, r.text("a*b_cd"))
+	assert.Equal(t, `https://… _(11 more characters)_`, Renderer{MaxValueLength: 8}.link("https://example.com"))
+}
+
+func TestRenderer_Code(t *testing.T) {
+	r := Renderer{}
+	assert.Equal(t, "`plain`", r.code("plain"))
+	assert.Equal(t, "``a`b``", r.code("a`b"))
+	assert.Equal(t, "`` `a` ``", r.code("`a`"))
+	assert.Equal(t, "`a b`", r.code("a\nb"))
+	assert.Equal(t, "", r.code(""))
+}
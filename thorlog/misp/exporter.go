@@ -0,0 +1,366 @@
+package misp
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"github.com/google/uuid"
+)
+
+// DefaultMinScore is the minimum score of exported assessments that is used by NewExporter.
+const DefaultMinScore = 60
+
+// fileTemplateUUID and fileTemplateVersion identify the MISP object template for files.
+const (
+	fileTemplateUUID    = "688c46fb-5edb-40a3-8273-1af7923e2215"
+	fileTemplateVersion = "24"
+)
+
+// namespace is the namespace for the UUIDs of exported events.
+var namespace = uuid.MustParse("0f9b2c8e-7d3a-4c1e-9b6f-3a5d8e2c4b71")
+
+// Exporter collects MISP events for a stream of assessments, with one event per scan ID.
+type Exporter struct {
+	// MinScore is the minimum score of exported assessments. Assessments with a lower score are skipped.
+	MinScore int64
+	// Distribution is the distribution of the created events. If it is empty, DistributionOrganisation is used.
+	Distribution string
+
+	events map[string]*eventBuilder
+	order  []string
+}
+
+// NewExporter creates a new exporter that exports assessments with a score of at least DefaultMinScore.
+func NewExporter() *Exporter {
+	return &Exporter{
+		MinScore: DefaultMinScore,
+	}
+}
+
+// eventBuilder contains the state of an event while assessments are added.
+type eventBuilder struct {
+	event      *Event
+	first      time.Time
+	last       time.Time
+	maxScore   int64
+	attributes map[string]int
+	objects    map[string]bool
+	tags       map[string]bool
+}
+
+// Add adds an assessment to the event for its scan. Assessments with a score below MinScore are skipped.
+func (e *Exporter) Add(assessment *thorlog.Assessment) {
+	if assessment.Score < e.MinScore {
+		return
+	}
+	builder := e.builder(assessment.Meta)
+	builder.update(assessment)
+
+	tags := signatureTags(assessment)
+	for _, tag := range tags {
+		if !builder.tags[tag.Name] {
+			builder.tags[tag.Name] = true
+			builder.event.Tags = append(builder.event.Tags, tag)
+		}
+	}
+	timestamp := unixTimestamp(assessment.Meta.Time)
+	comment := ruleNames(assessment)
+
+	for _, file := range subjectFiles(assessment.Subject) {
+		builder.addFile(file, comment, timestamp, tags)
+	}
+	for _, c2 := range beaconC2Servers(assessment.Subject) {
+		builder.addNetworkAttribute(c2, "Cobalt Strike C2 server", timestamp, tags)
+	}
+	switch subject := assessment.Subject.(type) {
+	case *thorlog.DnsCacheEntry:
+		if subject.IP != "" {
+			builder.addAttribute(Attribute{Type: "domain|ip", Category: "Network activity", Value: subject.Host + "|" + subject.IP, ToIDS: true, Comment: comment, Timestamp: timestamp, Tags: tags})
+		} else {
+			builder.addNetworkAttribute(subject.Host, comment, timestamp, tags)
+		}
+	case *thorlog.ProcessConnectionObject:
+		builder.addNetworkAttribute(subject.RemoteIp, comment, timestamp, tags)
+	case *thorlog.NetworkConnectingThread:
+		for _, connection := range subject.Connections {
+			server := connection.Server
+			if host, _, err := net.SplitHostPort(server); err == nil {
+				server = host
+			}
+			builder.addNetworkAttribute(server, comment, timestamp, tags)
+		}
+	}
+}
+
+// Events returns the events for all scans, in the order in which the scans were first added.
+func (e *Exporter) Events() []*Event {
+	events := make([]*Event, 0, len(e.order))
+	for _, scanID := range e.order {
+		events = append(events, e.events[scanID].event)
+	}
+	return events
+}
+
+func (e *Exporter) builder(meta thorlog.LogEventMetadata) *eventBuilder {
+	if builder, ok := e.events[meta.ScanID]; ok {
+		return builder
+	}
+	distribution := e.Distribution
+	if distribution == "" {
+		distribution = DistributionOrganisation
+	}
+	info := "THOR scan"
+	if meta.ScanID != "" {
+		info += " " + meta.ScanID
+	}
+	if meta.Source != "" {
+		info += " on " + meta.Source
+	}
+	builder := &eventBuilder{
+		event: &Event{
+			UUID:          uuid.NewSHA1(namespace, []byte(meta.ScanID)).String(),
+			Info:          info,
+			ThreatLevelID: ThreatLevelUndefined,
+			Analysis:      AnalysisInitial,
+			Distribution:  distribution,
+		},
+		attributes: map[string]int{},
+		objects:    map[string]bool{},
+		tags:       map[string]bool{},
+	}
+	if e.events == nil {
+		e.events = map[string]*eventBuilder{}
+	}
+	e.events[meta.ScanID] = builder
+	e.order = append(e.order, meta.ScanID)
+	return builder
+}
+
+// update updates the date, timestamp and threat level of the event for a new assessment.
+func (b *eventBuilder) update(assessment *thorlog.Assessment) {
+	eventTime := assessment.Meta.Time
+	if eventTime.IsZero() {
+		eventTime = time.Unix(0, 0)
+	}
+	first := b.event.Date == ""
+	if first || eventTime.Before(b.first) {
+		b.first = eventTime
+		b.event.Date = eventTime.UTC().Format("2006-01-02")
+	}
+	if first || eventTime.After(b.last) {
+		b.last = eventTime
+		b.event.Timestamp = strconv.FormatInt(eventTime.Unix(), 10)
+	}
+	if first || assessment.Score > b.maxScore {
+		b.maxScore = assessment.Score
+		b.event.ThreatLevelID = ThreatLevel(b.maxScore)
+	}
+}
+
+// ThreatLevel returns the MISP threat level for a THOR score.
+func ThreatLevel(score int64) string {
+	switch {
+	case score >= 80:
+		return ThreatLevelHigh
+	case score >= 60:
+		return ThreatLevelMedium
+	case score >= 40:
+		return ThreatLevelLow
+	default:
+		return ThreatLevelUndefined
+	}
+}
+
+// addAttribute adds an attribute to the event. If the event already contains an attribute
+// with the same type and value, only the tags are merged.
+func (b *eventBuilder) addAttribute(attribute Attribute) {
+	if attribute.Value == "" {
+		return
+	}
+	key := attribute.Type + "\x00" + attribute.Value
+	if index, ok := b.attributes[key]; ok {
+		existing := &b.event.Attributes[index]
+		existing.Tags = mergeTags(existing.Tags, attribute.Tags)
+		return
+	}
+	attribute.UUID = uuid.NewSHA1(uuid.MustParse(b.event.UUID), []byte(key)).String()
+	// Copy the tags so that merging tags later does not modify the tags of other attributes
+	attribute.Tags = mergeTags(nil, attribute.Tags)
+	attribute.Distribution = DistributionInherit
+	b.attributes[key] = len(b.event.Attributes)
+	b.event.Attributes = append(b.event.Attributes, attribute)
+}
+
+// addNetworkAttribute adds an ip-dst or domain attribute, depending on whether value is an IP address.
+func (b *eventBuilder) addNetworkAttribute(value string, comment string, timestamp string, tags []Tag) {
+	value = strings.TrimSpace(value)
+	attributeType := "domain"
+	if net.ParseIP(value) != nil {
+		attributeType = "ip-dst"
+	}
+	b.addAttribute(Attribute{Type: attributeType, Category: "Network activity", Value: value, ToIDS: true, Comment: comment, Timestamp: timestamp, Tags: tags})
+}
+
+// addFile adds a file object for file, unless the event already contains an object for the same file.
+func (b *eventBuilder) addFile(file *thorlog.File, comment string, timestamp string, tags []Tag) {
+	var hashes thorlog.FileHashes
+	if file.Hashes != nil {
+		hashes = *file.Hashes
+	}
+	objectUUID := uuid.NewSHA1(uuid.MustParse(b.event.UUID), []byte(strings.Join([]string{"file", file.Path, hashes.Md5, hashes.Sha1, hashes.Sha256}, "\x00")))
+	if b.objects[objectUUID.String()] {
+		return
+	}
+	b.objects[objectUUID.String()] = true
+
+	object := Object{
+		UUID:            objectUUID.String(),
+		Name:            "file",
+		MetaCategory:    "file",
+		Description:     "File object describing a file with meta-information",
+		TemplateUUID:    fileTemplateUUID,
+		TemplateVersion: fileTemplateVersion,
+		Comment:         comment,
+		Timestamp:       timestamp,
+		Distribution:    DistributionInherit,
+	}
+	addAttribute := func(relation string, attributeType string, category string, value string, toIDS bool) {
+		if value == "" {
+			return
+		}
+		attribute := Attribute{
+			UUID:           uuid.NewSHA1(objectUUID, []byte(relation+"\x00"+value)).String(),
+			Type:           attributeType,
+			Category:       category,
+			Value:          value,
+			ToIDS:          toIDS,
+			ObjectRelation: relation,
+			Timestamp:      timestamp,
+			Distribution:   DistributionInherit,
+		}
+		if toIDS {
+			attribute.Tags = tags
+		}
+		object.Attributes = append(object.Attributes, attribute)
+	}
+	addAttribute("filename", "filename", "Payload delivery", fileName(file.Path), false)
+	addAttribute("fullpath", "text", "Payload delivery", file.Path, false)
+	addAttribute("md5", "md5", "Payload delivery", hashes.Md5, true)
+	addAttribute("sha1", "sha1", "Payload delivery", hashes.Sha1, true)
+	addAttribute("sha256", "sha256", "Payload delivery", hashes.Sha256, true)
+	if file.Size > 0 {
+		addAttribute("size-in-bytes", "size-in-bytes", "Other", strconv.FormatUint(file.Size, 10), false)
+	}
+	if len(object.Attributes) == 0 {
+		return
+	}
+	b.event.Objects = append(b.event.Objects, object)
+}
+
+// subjectFiles returns the files that are described by a subject, e.g. the image of a process.
+func subjectFiles(subject thorlog.ObservedObject) []*thorlog.File {
+	var file *thorlog.File
+	switch typedSubject := subject.(type) {
+	case *thorlog.File:
+		file = typedSubject
+	case *thorlog.Process:
+		file = typedSubject.Image
+	case *thorlog.NetworkConnectingThread:
+		if typedSubject.Process != nil {
+			file = typedSubject.Process.Image
+		}
+	case *thorlog.WindowsService:
+		file = typedSubject.Image
+	}
+	if file == nil {
+		return nil
+	}
+	return []*thorlog.File{file}
+}
+
+// beaconC2Servers returns the C2 servers from the Cobalt Strike beacon configuration of a subject.
+// The C2 setting contains a comma separated list of hosts and URL paths. Ports are removed from the hosts.
+func beaconC2Servers(subject thorlog.ObservedObject) []string {
+	var beacon *thorlog.BeaconConfig
+	switch typedSubject := subject.(type) {
+	case *thorlog.File:
+		beacon = typedSubject.BeaconConfig
+	case *thorlog.Process:
+		beacon = typedSubject.BeaconConfig
+	case *thorlog.NetworkConnectingThread:
+		if typedSubject.Process != nil {
+			beacon = typedSubject.Process.BeaconConfig
+		}
+	}
+	if beacon == nil {
+		return nil
+	}
+	var servers []string
+	for _, entry := range strings.Split(beacon.C2, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || strings.HasPrefix(entry, "/") {
+			continue
+		}
+		if host, _, err := net.SplitHostPort(entry); err == nil {
+			entry = host
+		}
+		servers = append(servers, entry)
+	}
+	return servers
+}
+
+// signatureTags returns the tags of all signatures of an assessment, without duplicates.
+func signatureTags(assessment *thorlog.Assessment) []Tag {
+	var tags []Tag
+	for _, reason := range assessment.Reasons {
+		for _, tag := range reason.Tags {
+			tags = mergeTags(tags, []Tag{{Name: tag}})
+		}
+	}
+	return tags
+}
+
+func mergeTags(tags []Tag, additional []Tag) []Tag {
+	for _, tag := range additional {
+		found := false
+		for _, existing := range tags {
+			if existing.Name == tag.Name {
+				found = true
+				break
+			}
+		}
+		if !found && tag.Name != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// ruleNames returns the names of the rules that matched in an assessment, falling back to the reason summaries.
+func ruleNames(assessment *thorlog.Assessment) string {
+	var names []string
+	for _, reason := range assessment.Reasons {
+		name := reason.Rulename
+		if name == "" {
+			name = reason.Summary
+		}
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func fileName(path string) string {
+	return path[strings.LastIndexAny(path, `/\`)+1:]
+}
+
+func unixTimestamp(t time.Time) string {
+	if t.IsZero() {
+		return "0"
+	}
+	return strconv.FormatInt(t.Unix(), 10)
+}
@@ -0,0 +1,100 @@
+// Package misp exports THOR scans as MISP events.
+//
+// Each scan, identified by its scan ID, becomes one MISP event. Assessments with a sufficient score
+// contribute attributes, e.g. C2 servers from beacon configurations, and objects, e.g. a file object for each file.
+// The signature tags of the assessments become tags on the event and the attributes.
+//
+// The events are written in the MISP JSON format that can be imported into a MISP instance as-is.
+// All UUIDs are deterministic, so importing the export of the same scan again updates the existing event.
+package misp
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// Distribution levels as defined by MISP.
+const (
+	DistributionOrganisation = "0"
+	DistributionCommunity    = "1"
+	DistributionConnected    = "2"
+	DistributionAll          = "3"
+	// DistributionInherit makes attributes and objects use the distribution of their event.
+	DistributionInherit = "5"
+)
+
+// Threat levels as defined by MISP.
+const (
+	ThreatLevelHigh      = "1"
+	ThreatLevelMedium    = "2"
+	ThreatLevelLow       = "3"
+	ThreatLevelUndefined = "4"
+)
+
+// Analysis states as defined by MISP.
+const (
+	AnalysisInitial  = "0"
+	AnalysisOngoing  = "1"
+	AnalysisComplete = "2"
+)
+
+// Document is the top level element of a MISP JSON event file.
+type Document struct {
+	Event *Event `json:"Event"`
+}
+
+// Event is a MISP event.
+type Event struct {
+	UUID          string      `json:"uuid"`
+	Info          string      `json:"info"`
+	Date          string      `json:"date"`
+	Timestamp     string      `json:"timestamp"`
+	ThreatLevelID string      `json:"threat_level_id"`
+	Analysis      string      `json:"analysis"`
+	Distribution  string      `json:"distribution"`
+	Published     bool        `json:"published"`
+	Attributes    []Attribute `json:"Attribute,omitempty"`
+	Objects       []Object    `json:"Object,omitempty"`
+	Tags          []Tag       `json:"Tag,omitempty"`
+}
+
+// Attribute is a MISP attribute, either directly in an event or in an object.
+type Attribute struct {
+	UUID           string `json:"uuid"`
+	Type           string `json:"type"`
+	Category       string `json:"category"`
+	Value          string `json:"value"`
+	ToIDS          bool   `json:"to_ids"`
+	ObjectRelation string `json:"object_relation,omitempty"`
+	Comment        string `json:"comment,omitempty"`
+	Timestamp      string `json:"timestamp"`
+	Distribution   string `json:"distribution"`
+	Tags           []Tag  `json:"Tag,omitempty"`
+}
+
+// Object is a MISP object, i.e. a group of attributes that is described by an object template.
+type Object struct {
+	UUID            string      `json:"uuid"`
+	Name            string      `json:"name"`
+	MetaCategory    string      `json:"meta-category"`
+	Description     string      `json:"description,omitempty"`
+	TemplateUUID    string      `json:"template_uuid"`
+	TemplateVersion string      `json:"template_version"`
+	Comment         string      `json:"comment,omitempty"`
+	Timestamp       string      `json:"timestamp"`
+	Distribution    string      `json:"distribution"`
+	Attributes      []Attribute `json:"Attribute"`
+}
+
+// Tag is a MISP tag.
+type Tag struct {
+	Name string `json:"name"`
+}
+
+// WriteEvent writes event as an indented MISP JSON document to w.
+func WriteEvent(w io.Writer, event *Event) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(Document{Event: event})
+}
@@ -0,0 +1,146 @@
+package misp
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NextronSystems/jsonlog/thorlog/parser"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// scanLog contains the assessments of two scans. The first scan found a Cobalt Strike beacon, its C2 traffic,
+// a resolved C2 domain and a process whose score is below the default minimum score.
+const scanLog = `{"type":"THOR assessment","meta":{"time":"2024-11-05T10:22:13Z","level":"Alert","module":"Filescan","scan_id":"S-Qe9tN3wX5c","hostname":"hr-laptop-17"},"message":"Malicious file found","score":100,"subject":{"type":"file","path":"C:\\Users\\m.weber\\AppData\\Roaming\\Zoom\\zoomupd.dll","size":289792,"hashes":{"md5":"6f1d8b3a0c5e7f9a2b4d6c8e0f1a3b5c","sha1":"9e2c4a6f8b0d1e3f5a7c9b1d3e5f7a9c1b3d5e7f","sha256":"3c8e1f6a4d9b2e7c0f5a8d3b6e1c4f9a2d7b0e5c8f3a6d1b4e9c2f7a0d5b8e3c"},"beacon_config":{"beacon_type":"HTTPS","c2":"img.cdn-static.example,/pixel.gif,192.0.2.150,/submit.php"}},"reasons":[{"summary":"Cobalt Strike beacon configuration","signature":{"score":100,"kind":"YARA Rule","rule_name":"HKTL_CobaltStrike_Beacon_Config","tags":["CobaltStrike","T1574.002"]}}],"log_version":"v3.0.0"}
+{"type":"THOR assessment","meta":{"time":"2024-11-05T10:24:51Z","level":"Warning","module":"ProcessConnections","scan_id":"S-Qe9tN3wX5c","hostname":"hr-laptop-17"},"message":"Connection to C2 server found","score":60,"subject":{"type":"process connection","status":"ESTABLISHED","ip":"10.4.1.17","port":62114,"remote_ip":"192.0.2.150","remote_port":443,"protocol":"TCP"},"reasons":[{"summary":"Domain IOC match","signature":{"score":60,"kind":"Domain IOC","rule_name":"C2 IOC CobaltStrike Cluster 7"}}],"log_version":"v3.0.0"}
+{"type":"THOR assessment","meta":{"time":"2024-11-05T10:25:30Z","level":"Notice","module":"ProcessCheck","scan_id":"S-Qe9tN3wX5c","hostname":"hr-laptop-17"},"message":"Suspicious process found","score":40,"subject":{"type":"process","pid":9132,"name":"setup.exe","image":{"type":"file","path":"C:\\Users\\m.weber\\Downloads\\setup.exe"}},"reasons":[{"summary":"Unsigned installer in Downloads","signature":{"score":40,"kind":"Internal Heuristic","tags":["PUA"]}}],"log_version":"v3.0.0"}
+{"type":"THOR assessment","meta":{"time":"2024-11-05T11:02:07Z","level":"Warning","module":"DNSCache","scan_id":"S-Qe9tN3wX5c","hostname":"hr-laptop-17"},"message":"Suspicious DNS cache entry found","score":70,"subject":{"type":"DNS cache entry","host":"update.cdn-static.example","ip":"192.0.2.151"},"reasons":[{"summary":"Domain IOC match","signature":{"score":70,"kind":"Domain IOC","rule_name":"C2 IOC CobaltStrike Cluster 7","tags":["T1071.001"]}}],"log_version":"v3.0.0"}
+{"type":"THOR assessment","meta":{"time":"2024-11-05T13:40:19Z","level":"Warning","module":"Filescan","scan_id":"S-Lr2mV6yH8k","hostname":"hr-laptop-23"},"message":"Suspicious file found","score":75,"subject":{"type":"file","path":"C:\\Users\\a.klein\\AppData\\Roaming\\Zoom\\zoomupd.dll","hashes":{"sha256":"3c8e1f6a4d9b2e7c0f5a8d3b6e1c4f9a2d7b0e5c8f3a6d1b4e9c2f7a0d5b8e3c"}},"reasons":[{"summary":"Hash IOC match","signature":{"score":75,"kind":"Hash IOC","tags":["CobaltStrike"]}}],"log_version":"v3.0.0"}
+`
+
+func parseAssessments(t *testing.T, log string) []*thorlog.Assessment {
+	t.Helper()
+	var assessments []*thorlog.Assessment
+	reader := parser.NewReader(strings.NewReader(log))
+	for reader.Next() {
+		require.IsType(t, &thorlog.Assessment{}, reader.Event())
+		assessments = append(assessments, reader.Event().(*thorlog.Assessment))
+	}
+	require.NoError(t, reader.Err())
+	return assessments
+}
+
+// beacon returns an assessment of the Cobalt Strike beacon from the first scan, with the given C2 setting and tags.
+func beacon(t *testing.T, c2 string, tags ...string) *thorlog.Assessment {
+	t.Helper()
+	assessment := parseAssessments(t, scanLog)[0]
+	assessment.Subject.(*thorlog.File).BeaconConfig.C2 = c2
+	assessment.Reasons[0].Tags = tags
+	return assessment
+}
+
+func TestExporter_Golden(t *testing.T) {
+	exporter := NewExporter()
+	for _, assessment := range parseAssessments(t, scanLog) {
+		exporter.Add(assessment)
+	}
+	events := exporter.Events()
+	require.Len(t, events, 2)
+
+	var actual bytes.Buffer
+	require.NoError(t, WriteEvent(&actual, events[0]))
+	goldenFile := "testdata/scan.json"
+	if *update {
+		require.NoError(t, os.WriteFile(goldenFile, actual.Bytes(), 0644))
+	}
+	expected, err := os.ReadFile(goldenFile)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), actual.String())
+}
+
+func TestExporter_Events(t *testing.T) {
+	exporter := NewExporter()
+	for _, assessment := range parseAssessments(t, scanLog) {
+		exporter.Add(assessment)
+	}
+	events := exporter.Events()
+	require.Len(t, events, 2)
+
+	scan := events[0]
+	assert.Equal(t, "THOR scan S-Qe9tN3wX5c on hr-laptop-17", scan.Info)
+	assert.Equal(t, "2024-11-05", scan.Date)
+	assert.Equal(t, strconv.FormatInt(time.Date(2024, 11, 5, 11, 2, 7, 0, time.UTC).Unix(), 10), scan.Timestamp)
+	assert.Equal(t, ThreatLevelHigh, scan.ThreatLevelID)
+	assert.Equal(t, []Tag{{"CobaltStrike"}, {"T1574.002"}, {"T1071.001"}}, scan.Tags)
+	require.Len(t, scan.Objects, 1)
+	assert.Equal(t, "file", scan.Objects[0].Name)
+	var values []string
+	for _, attribute := range scan.Attributes {
+		values = append(values, attribute.Type+"="+attribute.Value)
+	}
+	// The process connection to the C2 server is merged with the C2 server from the beacon configuration,
+	// and the process with a score below the minimum score is skipped
+	assert.Equal(t, []string{"domain=img.cdn-static.example", "ip-dst=192.0.2.150", "domain|ip=update.cdn-static.example|192.0.2.151"}, values)
+
+	other := events[1]
+	assert.Equal(t, "THOR scan S-Lr2mV6yH8k on hr-laptop-23", other.Info)
+	assert.Equal(t, ThreatLevelMedium, other.ThreatLevelID)
+	assert.NotEqual(t, scan.UUID, other.UUID)
+	// Objects in different events have different UUIDs
+	assert.NotEqual(t, scan.Objects[0].UUID, other.Objects[0].UUID)
+}
+
+func TestExporter_Deterministic(t *testing.T) {
+	export := func() []byte {
+		exporter := &Exporter{}
+		for _, assessment := range parseAssessments(t, scanLog) {
+			exporter.Add(assessment)
+		}
+		data, err := json.Marshal(exporter.Events())
+		require.NoError(t, err)
+		return data
+	}
+	assert.Equal(t, export(), export())
+}
+
+func TestExporter_C2WithPorts(t *testing.T) {
+	exporter := NewExporter()
+	exporter.Add(beacon(t, "img.cdn-static.example:443,/pixel.gif,192.0.2.150:8443,[2001:db8:17::5]:53,/submit.php"))
+	var values []string
+	for _, attribute := range exporter.Events()[0].Attributes {
+		values = append(values, attribute.Type+"="+attribute.Value)
+	}
+	assert.Equal(t, []string{"domain=img.cdn-static.example", "ip-dst=192.0.2.150", "ip-dst=2001:db8:17::5"}, values)
+}
+
+func TestExporter_MergesDuplicates(t *testing.T) {
+	exporter := NewExporter()
+	exporter.Add(beacon(t, "img.cdn-static.example,/pixel.gif,192.0.2.150,/submit.php", "CobaltStrike"))
+	exporter.Add(beacon(t, "img.cdn-static.example,/pixel.gif,192.0.2.150,/submit.php", "T1574.002"))
+	event := exporter.Events()[0]
+	assert.Len(t, event.Objects, 1)
+	require.Len(t, event.Attributes, 2)
+	assert.Equal(t, []Tag{{"CobaltStrike"}, {"T1574.002"}}, event.Attributes[0].Tags)
+}
+
+func TestThreatLevel(t *testing.T) {
+	for score, level := range map[int64]string{
+		0:   ThreatLevelUndefined,
+		40:  ThreatLevelLow,
+		60:  ThreatLevelMedium,
+		79:  ThreatLevelMedium,
+		80:  ThreatLevelHigh,
+		120: ThreatLevelHigh,
+	} {
+		assert.Equal(t, level, ThreatLevel(score), score)
+	}
+}
@@ -0,0 +1,141 @@
+package ocsf
+
+import (
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+// ObjectMapping defines how objects of one type are mapped to OCSF. All functions are optional.
+type ObjectMapping struct {
+	// Evidence returns the evidence artifacts for an assessment's subject, e.g. {"file": {...}}.
+	// The complete object is always added as the evidence's data, so fields without an OCSF equivalent are kept.
+	Evidence func(object thorlog.ObservedObject) Object
+	// Observables returns the observables for an assessment's subject.
+	// Their names are relative to the evidence, e.g. file.name.
+	Observables func(object thorlog.ObservedObject) []Observable
+	// Name returns a short, human readable name of the object, which is used for related resources.
+	Name func(object thorlog.ObservedObject) string
+}
+
+// Observable is a notable value in an event, e.g. a hash or an IP address.
+type Observable struct {
+	// Name is the path of the attribute that contains the value.
+	Name string
+	// TypeID is the observable type, e.g. ObservableHash.
+	TypeID int
+	// Value is the observed value.
+	Value string
+}
+
+// Observable type IDs as defined by OCSF.
+const (
+	ObservableHostname    = 1
+	ObservableIPAddress   = 2
+	ObservableUserName    = 4
+	ObservableFileName    = 7
+	ObservableHash        = 8
+	ObservableProcessName = 9
+	ObservableCommandLine = 13
+	ObservableProcessID   = 15
+)
+
+var observableTypeNames = map[int]string{
+	ObservableHostname:    "Hostname",
+	ObservableIPAddress:   "IP Address",
+	ObservableUserName:    "User Name",
+	ObservableFileName:    "File Name",
+	ObservableHash:        "Hash",
+	ObservableProcessName: "Process Name",
+	ObservableCommandLine: "Command Line",
+	ObservableProcessID:   "Process ID",
+}
+
+// Mapper maps assessments to OCSF events, using a table of object mappings that is keyed by the object type.
+type Mapper struct {
+	// ProductVersion is the version of THOR that created the events.
+	ProductVersion string
+
+	parent   *Mapper
+	mappings map[string]ObjectMapping
+}
+
+// NewMapper creates a new mapper that contains all object mappings of parent, if parent is not nil.
+func NewMapper(parent *Mapper) *Mapper {
+	mapper := &Mapper{
+		parent:   parent,
+		mappings: map[string]ObjectMapping{},
+	}
+	if parent != nil {
+		mapper.ProductVersion = parent.ProductVersion
+	}
+	return mapper
+}
+
+// Register sets the mapping for objects of the given type (as in their type field, e.g. "file"),
+// replacing any mapping for this type in this mapper or its parents.
+func (m *Mapper) Register(objectType string, mapping ObjectMapping) {
+	m.mappings[objectType] = mapping
+}
+
+func (m *Mapper) lookup(objectType string) (ObjectMapping, bool) {
+	for mapper := m; mapper != nil; mapper = mapper.parent {
+		if mapping, ok := mapper.mappings[objectType]; ok {
+			return mapping, true
+		}
+	}
+	return ObjectMapping{}, false
+}
+
+// evidence maps an assessment's subject to an evidence artifact.
+func (m *Mapper) evidence(object thorlog.ObservedObject) (Object, error) {
+	evidence := Object{}
+	if mapping, ok := m.lookup(object.EmbeddedHeader().Type); ok && mapping.Evidence != nil {
+		for key, value := range mapping.Evidence(object) {
+			evidence.set(key, value)
+		}
+	}
+	data, err := toObject(object)
+	if err != nil {
+		return nil, err
+	}
+	evidence.set("data", data)
+	return evidence, nil
+}
+
+// observables returns the observables of an assessment's subject, with names prefixed by the path of its evidence.
+func (m *Mapper) observables(object thorlog.ObservedObject, prefix string) []Object {
+	mapping, ok := m.lookup(object.EmbeddedHeader().Type)
+	if !ok || mapping.Observables == nil {
+		return nil
+	}
+	var observables []Object
+	for _, observable := range mapping.Observables(object) {
+		if observable.Value == "" {
+			continue
+		}
+		entry := Object{
+			"name":    prefix + "." + observable.Name,
+			"type_id": observable.TypeID,
+			"value":   observable.Value,
+		}
+		if typeName, ok := observableTypeNames[observable.TypeID]; ok {
+			entry["type"] = typeName
+		} else {
+			entry["type"] = "Other"
+		}
+		observables = append(observables, entry)
+	}
+	return observables
+}
+
+// typed adapts a function for a specific object type to the signature used in ObjectMapping.
+// Objects of other types are mapped to the zero value.
+func typed[T thorlog.ObservedObject, R any](f func(T) R) func(thorlog.ObservedObject) R {
+	return func(object thorlog.ObservedObject) R {
+		typedObject, ok := object.(T)
+		if !ok {
+			var zero R
+			return zero
+		}
+		return f(typedObject)
+	}
+}
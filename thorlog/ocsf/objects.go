@@ -0,0 +1,242 @@
+package ocsf
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+// DefaultMapper contains the mappings for the common reportable objects.
+// Use NewMapper(DefaultMapper) to add or replace mappings without affecting other users.
+var DefaultMapper = NewMapper(nil)
+
+func init() {
+	DefaultMapper.Register(thorlog.NewFile("").Type, ObjectMapping{
+		Evidence: typed(func(file *thorlog.File) Object {
+			return Object{"file": fileObject(file)}
+		}),
+		Observables: typed(func(file *thorlog.File) []Observable {
+			return fileObservables("file", file)
+		}),
+		Name: typed(func(file *thorlog.File) string { return file.Path }),
+	})
+	DefaultMapper.Register(thorlog.NewProcess(0).Type, ObjectMapping{
+		Evidence: typed(func(process *thorlog.Process) Object {
+			return Object{"process": processObject(process)}
+		}),
+		Observables: typed(func(process *thorlog.Process) []Observable {
+			return processObservables("process", process)
+		}),
+		Name: typed(func(process *thorlog.Process) string { return process.Name }),
+	})
+	DefaultMapper.Register(thorlog.TypeRegistryValue, ObjectMapping{
+		Evidence: typed(func(value *thorlog.RegistryValue) Object {
+			registryValue := Object{}
+			registryValue.set("path", value.Key)
+			registryValue.set("name", baseName(value.Key))
+			registryValue.set("data", value.ParsedValue)
+			setTime(registryValue, "modified_time", value.Modified)
+			return Object{"reg_value": registryValue}
+		}),
+		Name: typed(func(value *thorlog.RegistryValue) string { return value.Key }),
+	})
+	DefaultMapper.Register(thorlog.NewWindowsService().Type, ObjectMapping{
+		Evidence: typed(func(service *thorlog.WindowsService) Object {
+			evidence := Object{}
+			if service.Image != nil {
+				evidence["file"] = fileObject(service.Image)
+			}
+			return evidence
+		}),
+		Observables: typed(func(service *thorlog.WindowsService) []Observable {
+			observables := []Observable{{Name: "data.user", TypeID: ObservableUserName, Value: service.User}}
+			if service.Image != nil {
+				observables = append(observables, fileObservables("file", service.Image)...)
+			}
+			return observables
+		}),
+		Name: typed(func(service *thorlog.WindowsService) string { return service.ServiceName }),
+	})
+	DefaultMapper.Register(thorlog.NewNetworkConnectingThread(0, nil).Type, ObjectMapping{
+		Evidence: typed(func(thread *thorlog.NetworkConnectingThread) Object {
+			process := processObject(thread.Process)
+			process["tid"] = thread.ThreadId
+			return Object{"process": process}
+		}),
+		Observables: typed(func(thread *thorlog.NetworkConnectingThread) []Observable {
+			return processObservables("process", thread.Process)
+		}),
+		Name: typed(func(thread *thorlog.NetworkConnectingThread) string {
+			if thread.Process == nil {
+				return ""
+			}
+			return thread.Process.Name
+		}),
+	})
+	DefaultMapper.Register(thorlog.NewProcessConnection().Type, ObjectMapping{
+		Evidence: typed(func(connection *thorlog.ProcessConnectionObject) Object {
+			connectionInfo := Object{"direction_id": 0}
+			connectionInfo.set("protocol_name", strings.ToLower(connection.Protocol))
+			return Object{
+				"src_endpoint":    endpoint(connection.Ip, connection.Port),
+				"dst_endpoint":    endpoint(connection.RemoteIp, connection.RemotePort),
+				"connection_info": connectionInfo,
+			}
+		}),
+		Observables: typed(func(connection *thorlog.ProcessConnectionObject) []Observable {
+			return []Observable{
+				{Name: "src_endpoint.ip", TypeID: ObservableIPAddress, Value: connection.Ip},
+				{Name: "dst_endpoint.ip", TypeID: ObservableIPAddress, Value: connection.RemoteIp},
+			}
+		}),
+		Name: typed(func(connection *thorlog.ProcessConnectionObject) string {
+			name := connection.Ip + ":" + strconv.FormatUint(uint64(connection.Port), 10)
+			if connection.RemoteIp != "" {
+				name += " -> " + connection.RemoteIp + ":" + strconv.FormatUint(uint64(connection.RemotePort), 10)
+			}
+			return name
+		}),
+	})
+}
+
+// fileObject maps a file to an OCSF file object.
+func fileObject(file *thorlog.File) Object {
+	object := Object{
+		"name":    baseName(file.Path),
+		"type_id": fileTypeID(file.FileMode),
+	}
+	object.set("path", file.Path)
+	if file.Size > 0 {
+		object["size"] = file.Size
+	}
+	if file.Hashes != nil {
+		var hashes []Object
+		for _, hash := range []struct {
+			id    int
+			name  string
+			value string
+		}{
+			{1, "MD5", file.Hashes.Md5},
+			{2, "SHA-1", file.Hashes.Sha1},
+			{3, "SHA-256", file.Hashes.Sha256},
+		} {
+			if hash.value != "" {
+				hashes = append(hashes, Object{"algorithm_id": hash.id, "algorithm": hash.name, "value": hash.value})
+			}
+		}
+		object.set("hashes", hashes)
+	}
+	if file.Filetimes != nil {
+		setTime(object, "modified_time", file.Filetimes.Mtime)
+		if file.Filetimes.Atime != nil {
+			setTime(object, "accessed_time", *file.Filetimes.Atime)
+		}
+		if file.Filetimes.Btime != nil {
+			setTime(object, "created_time", *file.Filetimes.Btime)
+		}
+	}
+	if file.PeInfo != nil {
+		object.set("company_name", file.PeInfo.Company)
+		object.set("product", file.PeInfo.Product)
+		object.set("desc", file.PeInfo.FileDescription)
+	}
+	return object
+}
+
+// fileTypeID returns the OCSF file type ID for a file mode.
+func fileTypeID(mode thorlog.FileModeType) int {
+	switch mode {
+	case thorlog.ModeFile:
+		return 1
+	case thorlog.Directory:
+		return 2
+	case thorlog.Symlink:
+		return 7
+	case thorlog.Irregular:
+		return 99
+	default:
+		return 0
+	}
+}
+
+func fileObservables(prefix string, file *thorlog.File) []Observable {
+	observables := []Observable{{Name: prefix + ".name", TypeID: ObservableFileName, Value: baseName(file.Path)}}
+	if file.Hashes != nil {
+		// The indices match the hashes list in fileObject, which omits empty hashes as well
+		var index int
+		for _, hash := range []string{file.Hashes.Md5, file.Hashes.Sha1, file.Hashes.Sha256} {
+			if hash != "" {
+				observables = append(observables, Observable{Name: prefix + ".hashes[" + strconv.Itoa(index) + "].value", TypeID: ObservableHash, Value: hash})
+				index++
+			}
+		}
+	}
+	return observables
+}
+
+// processObject maps a process to an OCSF process object.
+func processObject(process *thorlog.Process) Object {
+	object := Object{}
+	if process == nil {
+		return object
+	}
+	object["pid"] = process.Pid
+	object.set("name", process.Name)
+	object.set("cmd_line", process.Cmdline)
+	if process.User != "" {
+		object["user"] = Object{"name": process.User}
+	}
+	setTime(object, "created_time", process.Created)
+	if process.Image != nil {
+		object["file"] = fileObject(process.Image)
+	}
+	parent := Object{}
+	if process.ParentInfo.Pid != 0 {
+		parent["pid"] = process.ParentInfo.Pid
+	}
+	parent.set("cmd_line", process.ParentInfo.CommandLine)
+	if process.ParentInfo.Exe != "" {
+		parent["file"] = Object{"name": baseName(process.ParentInfo.Exe), "path": process.ParentInfo.Exe, "type_id": 1}
+	}
+	object.set("parent_process", parent)
+	return object
+}
+
+func processObservables(prefix string, process *thorlog.Process) []Observable {
+	if process == nil {
+		return nil
+	}
+	observables := []Observable{
+		{Name: prefix + ".pid", TypeID: ObservableProcessID, Value: strconv.FormatInt(int64(process.Pid), 10)},
+		{Name: prefix + ".name", TypeID: ObservableProcessName, Value: process.Name},
+		{Name: prefix + ".cmd_line", TypeID: ObservableCommandLine, Value: process.Cmdline},
+		{Name: prefix + ".user.name", TypeID: ObservableUserName, Value: process.User},
+	}
+	if process.Image != nil {
+		observables = append(observables, fileObservables(prefix+".file", process.Image)...)
+	}
+	return observables
+}
+
+func endpoint(ip string, port uint32) Object {
+	object := Object{}
+	object.set("ip", ip)
+	if port != 0 {
+		object["port"] = port
+	}
+	return object
+}
+
+// setTime sets key to the timestamp in milliseconds since the epoch, unless it is zero.
+func setTime(object Object, key string, timestamp time.Time) {
+	if !timestamp.IsZero() {
+		object[key] = timestamp.UnixMilli()
+	}
+}
+
+// baseName returns the last element of a Windows or Unix path.
+func baseName(path string) string {
+	return path[strings.LastIndexAny(path, `/\`)+1:]
+}
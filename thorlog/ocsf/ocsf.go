@@ -0,0 +1,258 @@
+// Package ocsf maps THOR assessments to Detection Finding events of the Open Cybersecurity Schema Framework (OCSF).
+//
+// The subject of an assessment is mapped to evidence artifacts and observables, the reasons are mapped to analytics,
+// and the objects in the assessment's context are mapped to related resources.
+// How objects are mapped is defined per object type in a Mapper, which can be extended with mappings for further types.
+package ocsf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+)
+
+// SchemaVersion is the OCSF version that the mapping follows. It is declared in the metadata of the events.
+const SchemaVersion = "1.3.0"
+
+// namespace is the namespace for the UUIDs of findings whose assessment has no event ID.
+var namespace = uuid.MustParse("5d3e8b1a-2c4f-4a6e-8f0b-7c9d1e3a5b24")
+
+// Class, category and activity of the created events.
+const (
+	CategoryFindings      = 2
+	ClassDetectionFinding = 2004
+	ActivityCreate        = 1
+)
+
+// Severity IDs as defined by OCSF.
+const (
+	SeverityUnknown       = 0
+	SeverityInformational = 1
+	SeverityLow           = 2
+	SeverityMedium        = 3
+	SeverityHigh          = 4
+	SeverityCritical      = 5
+)
+
+var severityNames = map[int]string{
+	SeverityUnknown:       "Unknown",
+	SeverityInformational: "Informational",
+	SeverityLow:           "Low",
+	SeverityMedium:        "Medium",
+	SeverityHigh:          "High",
+	SeverityCritical:      "Critical",
+}
+
+// Object is an OCSF object, e.g. an event or a file.
+type Object map[string]any
+
+// set sets key to value, unless value is empty.
+func (o Object) set(key string, value any) {
+	switch v := value.(type) {
+	case nil:
+		return
+	case string:
+		if v == "" {
+			return
+		}
+	case Object:
+		if len(v) == 0 {
+			return
+		}
+	case []Object:
+		if len(v) == 0 {
+			return
+		}
+	case []string:
+		if len(v) == 0 {
+			return
+		}
+	}
+	o[key] = value
+}
+
+// Severity derives an OCSF severity ID from an assessment score, using THOR's default thresholds
+// of 40 for notices, 60 for warnings and 80 for alerts.
+func Severity(score int64) int {
+	switch {
+	case score >= 80:
+		return SeverityCritical
+	case score >= 60:
+		return SeverityHigh
+	case score >= 40:
+		return SeverityMedium
+	case score > 0:
+		return SeverityLow
+	default:
+		return SeverityInformational
+	}
+}
+
+// Map maps an assessment to a Detection Finding using DefaultMapper.
+func Map(event common.Event) (Object, error) {
+	return DefaultMapper.Map(event)
+}
+
+// Map maps an assessment to a Detection Finding.
+//
+// The finding's UID is the event ID of the assessment. For assessments without an event ID, a UUID is derived
+// from the assessment's content, so that exporting the same assessment again results in the same finding.
+// The finding's time is the time of the assessment; since OCSF requires a time, assessments without a time
+// use the Unix epoch instead.
+func (m *Mapper) Map(event common.Event) (Object, error) {
+	assessment, isAssessment := event.(*thorlog.Assessment)
+	if !isAssessment {
+		return nil, fmt.Errorf("unsupported event type %T", event)
+	}
+	meta := assessment.Meta
+	severity := Severity(assessment.Score)
+	eventTime := meta.Time
+	if eventTime.IsZero() {
+		eventTime = time.Unix(0, 0)
+	}
+
+	finding := Object{
+		"activity_id":   ActivityCreate,
+		"activity_name": "Create",
+		"category_uid":  CategoryFindings,
+		"category_name": "Findings",
+		"class_uid":     ClassDetectionFinding,
+		"class_name":    "Detection Finding",
+		"type_uid":      ClassDetectionFinding*100 + ActivityCreate,
+		"type_name":     "Detection Finding: Create",
+		"severity_id":   severity,
+		"severity":      severityNames[severity],
+		"time":          eventTime.UnixMilli(),
+		"risk_score":    assessment.Score,
+		"status_id":     1,
+		"status":        "New",
+		"is_alert":      len(assessment.Reasons) > 0,
+	}
+	finding.set("message", assessment.Text)
+
+	product := Object{"name": "THOR", "vendor_name": "Nextron Systems"}
+	product.set("version", m.ProductVersion)
+	finding["metadata"] = Object{
+		"product": product,
+		"version": SchemaVersion,
+	}
+	device := Object{"type_id": 0}
+	device.set("hostname", meta.Source)
+	finding["device"] = device
+
+	// The finding UID is required; events without an ID get one that is derived from their content
+	findingUID := meta.GenID
+	if findingUID == "" {
+		content, err := json.Marshal(assessment)
+		if err != nil {
+			return nil, err
+		}
+		findingUID = uuid.NewSHA1(namespace, content).String()
+	}
+	findingInfo := Object{"uid": findingUID}
+	findingInfo.set("title", assessment.Text)
+	var analytics []Object
+	var types []string
+	for _, reason := range assessment.Reasons {
+		analytics = append(analytics, analytic(reason))
+		if reason.Class != "" && !slices.Contains(types, string(reason.Class)) {
+			types = append(types, string(reason.Class))
+		}
+	}
+	if len(analytics) > 0 {
+		findingInfo["analytic"] = analytics[0]
+		findingInfo.set("related_analytics", analytics[1:])
+		findingInfo.set("desc", assessment.Reasons[0].Summary)
+	}
+	findingInfo.set("types", types)
+	finding["finding_info"] = findingInfo
+
+	if assessment.Subject != nil {
+		evidence, err := m.evidence(assessment.Subject)
+		if err != nil {
+			return nil, err
+		}
+		finding["evidences"] = []Object{evidence}
+		finding.set("observables", m.observables(assessment.Subject, "evidences[0]"))
+	}
+
+	var resources []Object
+	for _, contextObject := range assessment.EventContext {
+		resource, err := m.resource(contextObject)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, resource)
+	}
+	finding.set("resources", resources)
+
+	unmapped := Object{}
+	unmapped.set("scan_id", meta.ScanID)
+	unmapped.set("module", meta.Mod)
+	unmapped.set("log_version", string(assessment.LogVersion))
+	finding.set("unmapped", unmapped)
+	return finding, nil
+}
+
+// analytic maps a reason to an OCSF analytic.
+func analytic(reason thorlog.Reason) Object {
+	analytic := Object{"type_id": 1, "type": "Rule"}
+	analytic.set("uid", reason.RuleId)
+	if reason.Rulename != "" {
+		analytic["name"] = reason.Rulename
+	} else {
+		analytic.set("name", reason.Summary)
+	}
+	analytic.set("category", string(reason.Class))
+	analytic.set("desc", reason.LongDescription)
+	return analytic
+}
+
+// resource maps a context object to an OCSF resource. The complete object is kept in the resource's data.
+func (m *Mapper) resource(contextObject thorlog.ContextObject) (Object, error) {
+	resource := Object{}
+	var labels []string
+	for _, relation := range contextObject.Relations {
+		if relation.Name != "" {
+			labels = append(labels, relation.Type+": "+relation.Name)
+		} else {
+			labels = append(labels, relation.Type)
+		}
+	}
+	resource.set("labels", labels)
+	if contextObject.Object == nil {
+		return resource, nil
+	}
+	objectType := contextObject.Object.EmbeddedHeader().Type
+	resource.set("type", objectType)
+	if mapping, ok := m.lookup(objectType); ok && mapping.Name != nil {
+		resource.set("name", mapping.Name(contextObject.Object))
+	}
+	data, err := toObject(contextObject.Object)
+	if err != nil {
+		return nil, err
+	}
+	resource.set("data", data)
+	return resource, nil
+}
+
+// toObject converts the JSON representation of value to an object.
+func toObject(value any) (Object, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var object Object
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
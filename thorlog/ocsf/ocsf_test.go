@@ -0,0 +1,146 @@
+package ocsf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NextronSystems/jsonlog/thorlog/parser"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// minerProcess is an assessment of a cryptominer process with two reasons, its parent process and its configuration file.
+const minerProcess = `{"type":"THOR assessment","meta":{"time":"2024-05-02T03:17:26Z","level":"Alert","module":"ProcessCheck","scan_id":"S-7Hc2Lq0Zr4","event_id":"6b1f3e8a2c90","hostname":"build-runner-7"},"message":"Cryptominer process found","score":95,"subject":{"type":"process","pid":31337,"name":"kdevtmpfsi","command":"/tmp/.X11-unix/kdevtmpfsi -c /tmp/.X11-unix/config.json","owner":"jenkins","image":{"type":"file","path":"/tmp/.X11-unix/kdevtmpfsi","hashes":{"sha1":"4c1e2f8a0d3b5c7e9f1a2b3c4d5e6f7a8b9c0d1e","sha256":"9a3f6c1e8b2d4f7a0c5e9b1d3f6a8c2e4b7d9f1a3c5e7b9d1f3a5c7e9b1d3f5a"}},"parent_info":{"pid":1024,"exe":"/usr/sbin/cron","command":"/usr/sbin/cron -f"},"created":"2024-05-02T02:00:01Z"},"reasons":[{"summary":"YARA rule MAL_XMRig_Miner","signature":{"score":90,"kind":"YARA Rule","rule_name":"MAL_XMRig_Miner","description":"Detects the XMRig cryptocurrency miner","id":"0e5b7c2a-4f1d-4e8b-9c3a-6d2f1b0e7a95"}},{"summary":"Executable in hidden directory below /tmp","signature":{"score":50,"kind":"Filename IOC"}}],"context":[{"object":{"type":"process","pid":1024,"name":"cron","command":"/usr/sbin/cron -f","owner":"root"},"relations":[{"relation_type":"derives from","relation_name":"parent","unique":true}]},{"object":{"type":"file","path":"/tmp/.X11-unix/config.json"},"relations":[{"relation_type":"related to"}]}],"log_version":"v3.0.0"}`
+
+// schemaEvents contains an assessment for each object type that DefaultMapper maps, and one for a type without mapping.
+var schemaEvents = map[string]string{
+	"file":                      `{"type":"THOR assessment","meta":{"time":"2024-05-02T03:12:40Z","level":"Alert","module":"Filescan","scan_id":"S-7Hc2Lq0Zr4","event_id":"d07a51c3e9f2","hostname":"build-runner-7"},"message":"Malicious file found","score":90,"subject":{"type":"file","path":"/tmp/.X11-unix/kdevtmpfsi","size":2482176,"hashes":{"md5":"8c2f1b7e4a9d3c6f0e5b8a1d4c7f2e9b","sha256":"9a3f6c1e8b2d4f7a0c5e9b1d3f6a8c2e4b7d9f1a3c5e7b9d1f3a5c7e9b1d3f5a"},"file_times":{"modified":"2024-05-01T23:48:02Z"}},"reasons":[{"summary":"YARA rule MAL_XMRig_Miner","signature":{"score":90,"kind":"YARA Rule","rule_name":"MAL_XMRig_Miner"}}],"log_version":"v3.0.0"}`,
+	"process":                   minerProcess,
+	"registry value":            `{"type":"THOR assessment","meta":{"time":"2024-05-02T09:41:05Z","level":"Warning","module":"Autoruns","scan_id":"S-Jx0eR5bN2s","hostname":"lab-win-11"},"message":"Suspicious autorun entry found","score":70,"subject":{"type":"registry value","key":"HKLM\\SOFTWARE\\Microsoft\\Windows\\CurrentVersion\\RunOnce\\Setup","value":"powershell.exe -nop -w hidden -enc SQBFAFgA","modified":"2024-05-02T09:30:12Z"},"reasons":[{"summary":"Encoded PowerShell in RunOnce key","signature":{"score":70,"kind":"Sigma Rule","rule_name":"Encoded PowerShell Autorun"}}],"log_version":"v3.0.0"}`,
+	"windows service":           `{"type":"THOR assessment","meta":{"time":"2024-05-02T09:42:18Z","level":"Warning","module":"ServiceCheck","scan_id":"S-Jx0eR5bN2s","hostname":"lab-win-11"},"message":"Suspicious service found","score":60,"subject":{"type":"Windows service","key":"HKLM\\SYSTEM\\CurrentControlSet\\Services\\WinDefendHelper","service_name":"Windows Defender Helper","user":"LocalSystem","image":{"type":"file","path":"C:\\Users\\Public\\svchelper.exe","pe_info":{"company":"Microsoft Corporation","signed":false}}},"reasons":[{"summary":"Service image in public user directory","signature":{"score":60,"kind":"Internal Heuristic"}}],"log_version":"v3.0.0"}`,
+	"network connecting thread": `{"type":"THOR assessment","meta":{"time":"2024-05-02T03:19:51Z","level":"Alert","module":"BeaconCheck","scan_id":"S-7Hc2Lq0Zr4","hostname":"build-runner-7"},"message":"Beaconing thread found","score":80,"subject":{"type":"network connecting thread","thread_id":31341,"process":{"type":"process","pid":31337,"name":"kdevtmpfsi","owner":"jenkins"},"callback_interval":60000000000,"connections":[{"protocol":"tcp","server":"pool.minexmr.example:4444"}]},"reasons":[{"summary":"Thread connects to a mining pool","signature":{"score":80,"kind":"Internal Heuristic"}}],"log_version":"v3.0.0"}`,
+	"process connection":        `{"type":"THOR assessment","meta":{"time":"2024-05-02T03:18:09Z","level":"Warning","module":"ProcessConnections","scan_id":"S-7Hc2Lq0Zr4","hostname":"build-runner-7"},"message":"Connection to mining pool port found","score":65,"subject":{"type":"process connection","status":"ESTABLISHED","ip":"172.17.0.4","port":40212,"remote_ip":"198.51.100.77","remote_port":3333,"protocol":"TCP"},"reasons":[{"summary":"Connection to common Stratum port","signature":{"score":65,"kind":"Internal Heuristic"}}],"log_version":"v3.0.0"}`,
+	"unmapped type":             `{"type":"THOR assessment","meta":{"time":"2024-05-02T09:43:30Z","level":"Notice","module":"Registry","scan_id":"S-Jx0eR5bN2s","hostname":"lab-win-11"},"message":"Suspicious registry key found","score":45,"subject":{"type":"registry key","key":"HKCU\\Software\\Classes\\ms-settings\\Shell\\Open\\command","modified":"2024-05-02T09:29:58Z"},"reasons":[{"summary":"UAC bypass via ms-settings handler","signature":{"score":45,"kind":"Sigma Rule"}}],"log_version":"v3.0.0"}`,
+}
+
+func parseAssessment(t *testing.T, event string) *thorlog.Assessment {
+	t.Helper()
+	parsed, err := parser.ParseEvent([]byte(event))
+	require.NoError(t, err)
+	require.IsType(t, &thorlog.Assessment{}, parsed)
+	return parsed.(*thorlog.Assessment)
+}
+
+func TestMap(t *testing.T) {
+	finding, err := Map(parseAssessment(t, minerProcess))
+	require.NoError(t, err)
+
+	assert.Equal(t, 2004, finding["class_uid"])
+	assert.Equal(t, 200401, finding["type_uid"])
+	assert.Equal(t, SeverityCritical, finding["severity_id"])
+	assert.Equal(t, time.Date(2024, 5, 2, 3, 17, 26, 0, time.UTC).UnixMilli(), finding["time"])
+	assert.Equal(t, "Cryptominer process found", finding["message"])
+
+	findingInfo := finding["finding_info"].(Object)
+	assert.Equal(t, "6b1f3e8a2c90", findingInfo["uid"])
+	assert.Equal(t, "YARA rule MAL_XMRig_Miner", findingInfo["desc"])
+	assert.Equal(t, Object{"type_id": 1, "type": "Rule", "uid": "0e5b7c2a-4f1d-4e8b-9c3a-6d2f1b0e7a95", "name": "MAL_XMRig_Miner", "category": "YARA Rule", "desc": "Detects the XMRig cryptocurrency miner"}, findingInfo["analytic"])
+	assert.Equal(t, []Object{{"type_id": 1, "type": "Rule", "name": "Executable in hidden directory below /tmp", "category": "Filename IOC"}}, findingInfo["related_analytics"])
+	assert.Equal(t, []string{"YARA Rule", "Filename IOC"}, findingInfo["types"])
+
+	evidence := finding["evidences"].([]Object)[0]
+	process := evidence["process"].(Object)
+	assert.Equal(t, int32(31337), process["pid"])
+	assert.Equal(t, "/tmp/.X11-unix/kdevtmpfsi -c /tmp/.X11-unix/config.json", process["cmd_line"])
+	assert.Equal(t, "/tmp/.X11-unix/kdevtmpfsi", process["file"].(Object)["path"])
+	assert.Equal(t, "/usr/sbin/cron -f", process["parent_process"].(Object)["cmd_line"])
+	assert.Equal(t, "process", evidence["data"].(Object)["type"])
+
+	assert.Contains(t, finding["observables"], Object{"name": "evidences[0].process.file.hashes[1].value", "type_id": ObservableHash, "type": "Hash", "value": "9a3f6c1e8b2d4f7a0c5e9b1d3f6a8c2e4b7d9f1a3c5e7b9d1f3a5c7e9b1d3f5a"})
+	assert.Contains(t, finding["observables"], Object{"name": "evidences[0].process.name", "type_id": ObservableProcessName, "type": "Process Name", "value": "kdevtmpfsi"})
+	assert.Contains(t, finding["observables"], Object{"name": "evidences[0].process.user.name", "type_id": ObservableUserName, "type": "User Name", "value": "jenkins"})
+
+	resources := finding["resources"].([]Object)
+	require.Len(t, resources, 2)
+	assert.Equal(t, "cron", resources[0]["name"])
+	assert.Equal(t, "process", resources[0]["type"])
+	assert.Equal(t, []string{"derives from: parent"}, resources[0]["labels"])
+	assert.Equal(t, "/tmp/.X11-unix/config.json", resources[1]["name"])
+	assert.Equal(t, []string{"related to"}, resources[1]["labels"])
+
+	assert.Equal(t, Object{"scan_id": "S-7Hc2Lq0Zr4", "module": "ProcessCheck", "log_version": "v3.0.0"}, finding["unmapped"])
+}
+
+func TestMap_WithoutIDAndTime(t *testing.T) {
+	assessment := parseAssessment(t, `{"type":"THOR assessment","meta":{"time":"2024-05-02T03:12:40Z","level":"Warning","module":"Filescan","hostname":"build-runner-7"},"message":"Suspicious file found","score":60,"subject":{"type":"file","path":"/tmp/.X11-unix/config.json"},"log_version":"v3.0.0"}`)
+	first, err := Map(assessment)
+	require.NoError(t, err)
+	second, err := Map(assessment)
+	require.NoError(t, err)
+	uid := first["finding_info"].(Object)["uid"].(string)
+	assert.Equal(t, uid, second["finding_info"].(Object)["uid"])
+	_, err = uuid.Parse(uid)
+	assert.NoError(t, err)
+
+	assessment.Text = "Other finding"
+	other, err := Map(assessment)
+	require.NoError(t, err)
+	assert.NotEqual(t, uid, other["finding_info"].(Object)["uid"])
+
+	withoutTime := thorlog.NewAssessment(nil, "")
+	finding, err := Map(withoutTime)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), finding["time"])
+	again, err := Map(withoutTime)
+	require.NoError(t, err)
+	assert.Equal(t, finding, again)
+}
+
+func TestMapper_Register(t *testing.T) {
+	mapper := NewMapper(DefaultMapper)
+	mapper.ProductVersion = "11.0.0"
+	mapper.Register(thorlog.TypeRegistryKey, ObjectMapping{
+		Evidence: typed(func(key *thorlog.RegistryKey) Object {
+			return Object{"reg_key": Object{"path": key.Key}}
+		}),
+		Name: typed(func(key *thorlog.RegistryKey) string { return key.Key }),
+	})
+	registryKey := parseAssessment(t, schemaEvents["unmapped type"])
+
+	finding, err := mapper.Map(registryKey)
+	require.NoError(t, err)
+	assert.Equal(t, Object{"path": `HKCU\Software\Classes\ms-settings\Shell\Open\command`}, finding["evidences"].([]Object)[0]["reg_key"])
+	assert.Equal(t, "11.0.0", finding["metadata"].(Object)["product"].(Object)["version"])
+
+	// Files are still mapped by the parent
+	finding, err = mapper.Map(parseAssessment(t, schemaEvents["file"]))
+	require.NoError(t, err)
+	assert.Equal(t, "kdevtmpfsi", finding["evidences"].([]Object)[0]["file"].(Object)["name"])
+
+	// The default mapper is unaffected
+	finding, err = Map(registryKey)
+	require.NoError(t, err)
+	assert.NotContains(t, finding["evidences"].([]Object)[0], "reg_key")
+}
+
+func TestMap_Unsupported(t *testing.T) {
+	_, err := Map(thorlog.NewMessage(thorlog.LogEventMetadata{}, "test"))
+	assert.Error(t, err)
+}
+
+func TestSeverity(t *testing.T) {
+	for score, severity := range map[int64]int{
+		-10: SeverityInformational,
+		0:   SeverityInformational,
+		20:  SeverityLow,
+		40:  SeverityMedium,
+		60:  SeverityHigh,
+		79:  SeverityHigh,
+		80:  SeverityCritical,
+		100: SeverityCritical,
+	} {
+		assert.Equal(t, severity, Severity(score), score)
+	}
+}
@@ -0,0 +1,81 @@
+package ocsf
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sort"
+	"testing"
+
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// detectionFindingSchema is the Detection Finding schema that the findings are validated against.
+const detectionFindingSchema = "testdata/detection_finding.schema.json"
+
+// validate validates the JSON representation of a finding against the schema.
+func validate(schema *jsonschema.Schema, finding Object) error {
+	data, err := json.Marshal(finding)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return err
+	}
+	return schema.Validate(decoded)
+}
+
+// invalidLocations returns the instance locations of all violations in a validation error.
+func invalidLocations(err error) []string {
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+	var locations []string
+	for _, unit := range validationErr.BasicOutput().Errors {
+		if unit.Error != "" && unit.InstanceLocation != "" {
+			locations = append(locations, unit.InstanceLocation)
+		}
+	}
+	sort.Strings(locations)
+	return locations
+}
+
+func TestMap_MatchesSchema(t *testing.T) {
+	schema, err := jsonschema.Compile(detectionFindingSchema)
+	require.NoError(t, err)
+	for name, event := range schemaEvents {
+		event := event
+		t.Run(name, func(t *testing.T) {
+			finding, err := Map(parseAssessment(t, event))
+			require.NoError(t, err)
+			assert.NoError(t, validate(schema, finding))
+		})
+	}
+	t.Run("empty assessment", func(t *testing.T) {
+		finding, err := Map(thorlog.NewAssessment(nil, ""))
+		require.NoError(t, err)
+		assert.NoError(t, validate(schema, finding))
+	})
+}
+
+func TestMap_SchemaRejectsInvalidFindings(t *testing.T) {
+	schema, err := jsonschema.Compile(detectionFindingSchema)
+	require.NoError(t, err)
+	finding, err := Map(parseAssessment(t, schemaEvents["file"]))
+	require.NoError(t, err)
+
+	delete(finding, "class_uid")
+	finding["severity_id"] = 7
+	finding["finding_info"].(Object)["titel"] = "misspelled"
+	err = validate(schema, finding)
+	require.Error(t, err)
+	assert.Subset(t, invalidLocations(err), []string{"/finding_info", "/severity_id"})
+	assert.Contains(t, err.Error(), "class_uid")
+}
@@ -0,0 +1,214 @@
+// Package otlp converts THOR events to OpenTelemetry log records in the OTLP/JSON encoding
+// and writes them to files or OTLP/HTTP collectors.
+package otlp
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/jsonpointer"
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+)
+
+// ScopeName is the name of the instrumentation scope of all log records.
+const ScopeName = "thor"
+
+// SeverityNumber is an OpenTelemetry log severity.
+type SeverityNumber int
+
+// Severities as defined by the OpenTelemetry log data model.
+const (
+	SeverityUnspecified SeverityNumber = 0
+	SeverityDebug       SeverityNumber = 5
+	SeverityInfo        SeverityNumber = 9
+	SeverityInfo2       SeverityNumber = 10
+	SeverityWarn        SeverityNumber = 13
+	SeverityError       SeverityNumber = 17
+	SeverityError3      SeverityNumber = 19
+)
+
+// SeverityForLevel returns the OpenTelemetry severity for a THOR log level.
+// The mapping follows the recommended mapping of syslog severities; unknown levels are mapped to SeverityInfo.
+func SeverityForLevel(level common.LogLevel) SeverityNumber {
+	switch level {
+	case common.Alert:
+		return SeverityError3
+	case common.Error:
+		return SeverityError
+	case common.Warning:
+		return SeverityWarn
+	case common.Notice:
+		return SeverityInfo2
+	case common.Debug:
+		return SeverityDebug
+	default:
+		return SeverityInfo
+	}
+}
+
+// ExportLogsServiceRequest is the message that is sent to collectors.
+type ExportLogsServiceRequest struct {
+	ResourceLogs []ResourceLogs `json:"resourceLogs"`
+}
+
+// ResourceLogs contains the log records of a single resource, i.e. a scanned host.
+type ResourceLogs struct {
+	Resource  Resource    `json:"resource"`
+	ScopeLogs []ScopeLogs `json:"scopeLogs"`
+}
+
+// Resource describes the entity that produced the log records.
+type Resource struct {
+	Attributes []KeyValue `json:"attributes,omitempty"`
+}
+
+// ScopeLogs contains the log records of a single instrumentation scope.
+type ScopeLogs struct {
+	Scope      Scope       `json:"scope"`
+	LogRecords []LogRecord `json:"logRecords"`
+}
+
+// Scope is an instrumentation scope.
+type Scope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// LogRecord is an OpenTelemetry log record.
+type LogRecord struct {
+	TimeUnixNano   string         `json:"timeUnixNano,omitempty"`
+	SeverityNumber SeverityNumber `json:"severityNumber,omitempty"`
+	SeverityText   string         `json:"severityText,omitempty"`
+	Body           AnyValue       `json:"body"`
+	Attributes     []KeyValue     `json:"attributes,omitempty"`
+}
+
+// KeyValue is an attribute of a log record or resource.
+type KeyValue struct {
+	Key   string   `json:"key"`
+	Value AnyValue `json:"value"`
+}
+
+// AnyValue is an attribute value or log record body. Exactly one of the fields is set.
+type AnyValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+// StringValue returns an AnyValue that contains a string.
+func StringValue(value string) AnyValue {
+	return AnyValue{StringValue: &value}
+}
+
+// Formatter converts events to OpenTelemetry log records.
+//
+// The zero value takes the attributes from the text log representation of the events.
+type Formatter struct {
+	// ServiceName is the service.name attribute of the resources. If it is empty, "THOR" is used.
+	ServiceName string
+	// ScopeVersion is the version of the instrumentation scope, usually the THOR version.
+	ScopeVersion string
+	// Pointers selects the JSON representation of the event for the attributes.
+	// If it is set, each scalar value in the JSON event becomes an attribute with its JSON pointer as key,
+	// e.g. /subject/path. Otherwise, each text log key becomes an attribute with the lower case key,
+	// e.g. file. Empty values are omitted in both cases.
+	Pointers bool
+	// Textlog is used to format the attributes if Pointers is not set.
+	Textlog jsonlog.TextlogFormatter
+	// OnError is called for events that can't be converted to JSON if Pointers is set.
+	// The attributes are omitted for such events. If OnError is nil, such errors are ignored.
+	OnError func(err error)
+}
+
+// LogRecord converts an event to a log record.
+//
+// The body is the event's message, the time is the event time and the severity is derived from the log level.
+func (f Formatter) LogRecord(event common.Event) LogRecord {
+	metadata := event.Metadata()
+	record := LogRecord{
+		SeverityNumber: SeverityForLevel(metadata.Lvl),
+		SeverityText:   string(metadata.Lvl),
+		Body:           StringValue(event.Message()),
+	}
+	if !metadata.Time.IsZero() {
+		record.TimeUnixNano = strconv.FormatInt(metadata.Time.UnixNano(), 10)
+	}
+	if f.Pointers {
+		fields, err := jsonpointer.Flatten(event)
+		if err != nil && f.OnError != nil {
+			f.OnError(err)
+		}
+		for _, field := range fields {
+			if value, ok := fieldValue(field.Value); ok {
+				record.Attributes = append(record.Attributes, KeyValue{Key: field.Pointer.String(), Value: value})
+			}
+		}
+	} else {
+		for _, pair := range f.Textlog.Format(event) {
+			if pair.Value != "" {
+				record.Attributes = append(record.Attributes, KeyValue{Key: strings.ToLower(pair.Key), Value: StringValue(pair.Value)})
+			}
+		}
+	}
+	return record
+}
+
+// fieldValue converts a value from jsonpointer.Flatten to an attribute value.
+func fieldValue(value any) (AnyValue, bool) {
+	switch typedValue := value.(type) {
+	case string:
+		return StringValue(typedValue), typedValue != ""
+	case bool:
+		return AnyValue{BoolValue: &typedValue}, true
+	case json.Number:
+		if _, err := typedValue.Int64(); err == nil {
+			intValue := typedValue.String()
+			return AnyValue{IntValue: &intValue}, true
+		}
+		if doubleValue, err := typedValue.Float64(); err == nil {
+			return AnyValue{DoubleValue: &doubleValue}, true
+		}
+	}
+	return AnyValue{}, false
+}
+
+// Request converts events to an export request. The log records are grouped by the hostname of the events,
+// which becomes the host.name attribute of the resource.
+func (f Formatter) Request(events ...common.Event) ExportLogsServiceRequest {
+	request := ExportLogsServiceRequest{ResourceLogs: []ResourceLogs{}}
+	hosts := map[string]int{}
+	for _, event := range events {
+		host := event.Metadata().Source
+		index, ok := hosts[host]
+		if !ok {
+			index = len(request.ResourceLogs)
+			hosts[host] = index
+			request.ResourceLogs = append(request.ResourceLogs, f.resourceLogs(host))
+		}
+		scopeLogs := &request.ResourceLogs[index].ScopeLogs[0]
+		scopeLogs.LogRecords = append(scopeLogs.LogRecords, f.LogRecord(event))
+	}
+	return request
+}
+
+func (f Formatter) resourceLogs(host string) ResourceLogs {
+	serviceName := f.ServiceName
+	if serviceName == "" {
+		serviceName = "THOR"
+	}
+	attributes := []KeyValue{{Key: "service.name", Value: StringValue(serviceName)}}
+	if host != "" {
+		attributes = append(attributes, KeyValue{Key: "host.name", Value: StringValue(host)})
+	}
+	return ResourceLogs{
+		Resource: Resource{Attributes: attributes},
+		ScopeLogs: []ScopeLogs{{
+			Scope:      Scope{Name: ScopeName, Version: f.ScopeVersion},
+			LogRecords: []LogRecord{},
+		}},
+	}
+}
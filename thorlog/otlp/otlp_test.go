@@ -0,0 +1,163 @@
+package otlp
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	"github.com/NextronSystems/jsonlog/thorlog/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The events that are exported from the scans of two sales workstations.
+const (
+	runKey = `{"type":"THOR assessment","meta":{"time":"2025-02-03T09:05:27.861Z","level":"Warning","module":"Autoruns","scan_id":"S-Hx7cP2nV9q","event_id":"91d4c6a0b3e8","hostname":"ws-sales-11"},"message":"Suspicious autorun entry found","score":70,"subject":{"type":"registry value","key":"HKLM\\SOFTWARE\\Microsoft\\Windows\\CurrentVersion\\Run\\OneDriveSync","modified":"2025-02-01T17:44:02Z","value":"C:\\Users\\Public\\Libraries\\odsync.exe","size":76},"reasons":[{"summary":"Autorun executable in a user writable directory","signature":{"score":70,"kind":"Internal Heuristic"}}],"log_version":"v3.0.0"}`
+	// otherHostRunKey is the same finding on another workstation, which is a different OpenTelemetry resource.
+	otherHostRunKey = `{"type":"THOR assessment","meta":{"time":"2025-02-03T09:11:50.102Z","level":"Warning","module":"Autoruns","scan_id":"S-Tq4mZ8wK1d","event_id":"5c2e8f7a1d09","hostname":"ws-sales-14"},"message":"Suspicious autorun entry found","score":70,"subject":{"type":"registry value","key":"HKLM\\SOFTWARE\\Microsoft\\Windows\\CurrentVersion\\Run\\OneDriveSync","modified":"2025-02-01T17:51:36Z","value":"C:\\Users\\Public\\Libraries\\odsync.exe","size":76},"reasons":[{"summary":"Autorun executable in a user writable directory","signature":{"score":70,"kind":"Internal Heuristic"}}],"log_version":"v3.0.0"}`
+	// noMetadata is a message without time and level.
+	noMetadata = `{"type":"THOR message","message":"No metadata","log_version":"v3.0.0"}`
+)
+
+func parseEvent(t *testing.T, event string) common.Event {
+	t.Helper()
+	parsed, err := parser.ParseEvent([]byte(event))
+	require.NoError(t, err)
+	return parsed
+}
+
+func attribute(record LogRecord, key string) *AnyValue {
+	for _, attribute := range record.Attributes {
+		if attribute.Key == key {
+			return &attribute.Value
+		}
+	}
+	return nil
+}
+
+func TestFormatter_LogRecord(t *testing.T) {
+	record := Formatter{}.LogRecord(parseEvent(t, runKey))
+	assert.Equal(t, "1738573527861000000", record.TimeUnixNano)
+	assert.Equal(t, SeverityWarn, record.SeverityNumber)
+	assert.Equal(t, "Warning", record.SeverityText)
+	assert.Equal(t, StringValue("Suspicious autorun entry found"), record.Body)
+	assert.Equal(t, []KeyValue{
+		{Key: "module", Value: StringValue("Autoruns")},
+		{Key: "scanid", Value: StringValue("S-Hx7cP2nV9q")},
+		{Key: "uid", Value: StringValue("91d4c6a0b3e8")},
+		{Key: "message", Value: StringValue("Suspicious autorun entry found")},
+		{Key: "score", Value: StringValue("70")},
+		{Key: "key", Value: StringValue(`HKLM\SOFTWARE\Microsoft\Windows\CurrentVersion\Run\OneDriveSync`)},
+		{Key: "modified", Value: StringValue("2025-02-01 17:44:02 +0000 UTC")},
+		{Key: "value", Value: StringValue(`C:\Users\Public\Libraries\odsync.exe`)},
+		{Key: "size", Value: StringValue("76")},
+		{Key: "reason_1", Value: StringValue("Autorun executable in a user writable directory")},
+		{Key: "subscore_1", Value: StringValue("70")},
+		{Key: "sigtype_1", Value: StringValue("internal")},
+		{Key: "sigclass_1", Value: StringValue("Internal Heuristic")},
+		{Key: "matched_1", Value: StringValue("(none)")},
+	}, record.Attributes)
+
+	record = Formatter{}.LogRecord(parseEvent(t, noMetadata))
+	assert.Empty(t, record.TimeUnixNano)
+	assert.Equal(t, SeverityInfo, record.SeverityNumber)
+}
+
+func TestFormatter_LogRecordPointers(t *testing.T) {
+	record := Formatter{Pointers: true}.LogRecord(parseEvent(t, runKey))
+	size := "76"
+	assert.Equal(t, &AnyValue{IntValue: &size}, attribute(record, "/subject/size"))
+	score := "70"
+	assert.Equal(t, &AnyValue{IntValue: &score}, attribute(record, "/reasons/0/signature/score"))
+	assert.Equal(t, StringValue(`C:\Users\Public\Libraries\odsync.exe`), *attribute(record, "/subject/value"))
+	assert.Equal(t, StringValue("S-Hx7cP2nV9q"), *attribute(record, "/meta/scan_id"))
+	assert.Nil(t, attribute(record, "/reasons/0/signature/rule_name"))
+}
+
+func TestSeverityForLevel(t *testing.T) {
+	for level, severity := range map[common.LogLevel]SeverityNumber{
+		common.Alert:   SeverityError3,
+		common.Error:   SeverityError,
+		common.Warning: SeverityWarn,
+		common.Notice:  SeverityInfo2,
+		common.Info:    SeverityInfo,
+		common.Debug:   SeverityDebug,
+		"unknown":      SeverityInfo,
+	} {
+		assert.Equal(t, severity, SeverityForLevel(level), level)
+	}
+}
+
+func TestFormatter_Request(t *testing.T) {
+	request := Formatter{ScopeVersion: "11.0.0"}.Request(parseEvent(t, runKey), parseEvent(t, otherHostRunKey), parseEvent(t, runKey))
+
+	data, err := json.Marshal(request)
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	resourceLogs := decoded["resourceLogs"].([]any)
+	require.Len(t, resourceLogs, 2)
+	first := resourceLogs[0].(map[string]any)
+	assert.Equal(t, []any{
+		map[string]any{"key": "service.name", "value": map[string]any{"stringValue": "THOR"}},
+		map[string]any{"key": "host.name", "value": map[string]any{"stringValue": "ws-sales-11"}},
+	}, first["resource"].(map[string]any)["attributes"])
+	scopeLogs := first["scopeLogs"].([]any)[0].(map[string]any)
+	assert.Equal(t, map[string]any{"name": "thor", "version": "11.0.0"}, scopeLogs["scope"])
+	assert.Len(t, scopeLogs["logRecords"], 2)
+	record := scopeLogs["logRecords"].([]any)[0].(map[string]any)
+	assert.Equal(t, "1738573527861000000", record["timeUnixNano"])
+	assert.Equal(t, 13.0, record["severityNumber"])
+}
+
+func TestFileWriter(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := NewFileWriter(&buffer, Formatter{})
+	require.NoError(t, writer.Write(parseEvent(t, runKey)))
+	require.NoError(t, writer.Write(parseEvent(t, runKey), parseEvent(t, runKey)))
+	require.NoError(t, writer.Close())
+
+	lines := bytes.Split(bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), []byte("\n"))
+	require.Len(t, lines, 2)
+	var request ExportLogsServiceRequest
+	require.NoError(t, json.Unmarshal(lines[1], &request))
+	assert.Len(t, request.ResourceLogs[0].ScopeLogs[0].LogRecords, 2)
+}
+
+func TestHTTPWriter(t *testing.T) {
+	var received []ExportLogsServiceRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/logs" || r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var request ExportLogsServiceRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		received = append(received, request)
+		records := request.ResourceLogs[0].ScopeLogs[0].LogRecords
+		if len(records) > 1 {
+			_, _ = w.Write([]byte(`{"partialSuccess":{"rejectedLogRecords":"1","errorMessage":"too many records"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	writer := NewHTTPWriter(server.URL+"/v1/logs", Formatter{})
+	assert.Error(t, writer.Write(parseEvent(t, runKey)))
+
+	writer.Header = http.Header{"Authorization": {"Bearer secret"}}
+	require.NoError(t, writer.Write(parseEvent(t, runKey)))
+	require.Len(t, received, 1)
+	assert.Equal(t, StringValue("Suspicious autorun entry found"), received[0].ResourceLogs[0].ScopeLogs[0].LogRecords[0].Body)
+
+	err := writer.Write(parseEvent(t, runKey), parseEvent(t, runKey))
+	assert.EqualError(t, err, "collector rejected 1 log records: too many records")
+}
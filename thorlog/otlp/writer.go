@@ -0,0 +1,120 @@
+package otlp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+)
+
+// FileWriter writes events to a file or another stream in the format of the OpenTelemetry collector's file exporter,
+// i.e. one export request as JSON per line.
+//
+// A FileWriter is safe for concurrent use.
+type FileWriter struct {
+	// Formatter is used to convert the events.
+	Formatter Formatter
+
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewFileWriter creates a new FileWriter that writes to w.
+func NewFileWriter(w io.Writer, formatter Formatter) *FileWriter {
+	return &FileWriter{
+		Formatter: formatter,
+		w:         w,
+	}
+}
+
+// Write writes the events as a single export request.
+func (w *FileWriter) Write(events ...common.Event) error {
+	data, err := json.Marshal(w.Formatter.Request(events...))
+	if err != nil {
+		return err
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_, err = w.w.Write(append(data, '\n'))
+	return err
+}
+
+// Close closes the underlying writer if it implements io.Closer.
+func (w *FileWriter) Close() error {
+	if closer, ok := w.w.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// HTTPWriter sends events to an OTLP/HTTP collector using the JSON encoding.
+type HTTPWriter struct {
+	// URL is the URL of the logs endpoint, e.g. http://collector.example.com:4318/v1/logs.
+	URL string
+	// Header contains additional headers for the requests, e.g. for authentication.
+	Header http.Header
+	// Client is used to send the requests. If it is nil, http.DefaultClient is used.
+	Client *http.Client
+	// Formatter is used to convert the events.
+	Formatter Formatter
+}
+
+// NewHTTPWriter creates a new HTTPWriter that sends the events to url.
+func NewHTTPWriter(url string, formatter Formatter) *HTTPWriter {
+	return &HTTPWriter{
+		URL:       url,
+		Formatter: formatter,
+	}
+}
+
+// exportResponse is the response of a collector to an export request.
+type exportResponse struct {
+	PartialSuccess *struct {
+		RejectedLogRecords json.Number `json:"rejectedLogRecords"`
+		ErrorMessage       string      `json:"errorMessage"`
+	} `json:"partialSuccess"`
+}
+
+// Write sends the events in a single export request.
+// If the collector rejects some of the log records, an error is returned.
+func (w *HTTPWriter) Write(events ...common.Event) error {
+	data, err := json.Marshal(w.Formatter.Request(events...))
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	for key, values := range w.Header {
+		request.Header[key] = values
+	}
+	request.Header.Set("Content-Type", "application/json")
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("collector returned status %s: %s", response.Status, bytes.TrimSpace(body))
+	}
+	var parsed exportResponse
+	if len(body) > 0 && json.Unmarshal(body, &parsed) == nil && parsed.PartialSuccess != nil {
+		if rejected, _ := parsed.PartialSuccess.RejectedLogRecords.Int64(); rejected > 0 {
+			return fmt.Errorf("collector rejected %d log records: %s", rejected, parsed.PartialSuccess.ErrorMessage)
+		}
+	}
+	return nil
+}
@@ -4,13 +4,49 @@ import (
 	"encoding/json"
 	"errors"
 
+	"github.com/NextronSystems/jsonlog/jsonpointer"
 	"github.com/NextronSystems/jsonlog/thorlog/common"
 	thorlogv1 "github.com/NextronSystems/jsonlog/thorlog/v1"
 	thorlogv2 "github.com/NextronSystems/jsonlog/thorlog/v2"
 	thorlogv3 "github.com/NextronSystems/jsonlog/thorlog/v3"
 )
 
-func ParseEvent(data []byte) (common.Event, error) {
+// Option configures how events are parsed.
+type Option func(*options)
+
+type options struct {
+	decodeMode     thorlogv3.DecodeMode
+	onUnknownField func(fields thorlogv3.UnknownFields, pointers []jsonpointer.Pointer)
+	registry       *thorlogv3.Registry
+}
+
+// WithDecodeMode sets how fields that are unknown to this library are handled in version 3 events.
+// By default, such fields cause parsing to fail; see thorlog.DecodeMode for the alternatives.
+//
+// If onUnknown is not nil, it is called with the fields that were ignored in each event that contains any.
+// The pointers are only set if mode is thorlog.DecodeCollect.
+func WithDecodeMode(mode thorlogv3.DecodeMode, onUnknown func(fields thorlogv3.UnknownFields, pointers []jsonpointer.Pointer)) Option {
+	return func(o *options) {
+		o.decodeMode = mode
+		o.onUnknownField = onUnknown
+	}
+}
+
+// WithRegistry sets the registry that is used to look up object types in version 3 events.
+// By default, thorlog.DefaultRegistry is used.
+func WithRegistry(registry *thorlogv3.Registry) Option {
+	return func(o *options) {
+		o.registry = registry
+	}
+}
+
+// ParseEvent parses a single JSON encoded event of any version.
+func ParseEvent(data []byte, opts ...Option) (common.Event, error) {
+	var parseOptions options
+	for _, opt := range opts {
+		opt(&parseOptions)
+	}
+
 	var versionedEvent struct {
 		Version common.Version `json:"log_version"`
 		Type    string         `json:"type"`
@@ -32,10 +68,13 @@ func ParseEvent(data []byte) (common.Event, error) {
 		}
 		return &event, nil
 	case common.JsonV3:
-		var logObject thorlogv3.EmbeddedObject
+		var logObject = thorlogv3.EmbeddedObject{Mode: parseOptions.decodeMode, Registry: parseOptions.registry}
 		if err := json.Unmarshal(data, &logObject); err != nil {
 			return nil, err
 		}
+		if len(logObject.UnknownFields) > 0 && parseOptions.onUnknownField != nil {
+			parseOptions.onUnknownField(logObject.UnknownFields, logObject.UnknownPointers)
+		}
 		event, isEvent := logObject.Object.(common.Event)
 		if !isEvent {
 			return nil, errors.New("json v3 log object is not an event")
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/jsonpointer"
 	"github.com/NextronSystems/jsonlog/thorlog/common"
 	thorlogv1 "github.com/NextronSystems/jsonlog/thorlog/v1"
 	thorlogv2 "github.com/NextronSystems/jsonlog/thorlog/v2"
@@ -184,3 +185,31 @@ func TestParseEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestParseEvent_WithDecodeMode(t *testing.T) {
+	rawEvent := []byte(`{"message":"Starting module","type":"THOR message","meta":{"time":"2024-09-24T14:18:46+02:00","level":"Info","module":"Hosts","scan_id":"S-UBNfBD4xE8s","event_id":"","hostname":"host","new_field":"x"},"fields":{},"log_version":"v3.1.0"}`)
+
+	_, err := ParseEvent(rawEvent)
+	assert.Error(t, err)
+
+	var reportedPointers []jsonpointer.Pointer
+	event, err := ParseEvent(rawEvent, WithDecodeMode(thorlog.DecodeCollect, func(fields thorlog.UnknownFields, pointers []jsonpointer.Pointer) {
+		reportedPointers = pointers
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "Starting module", event.Message())
+	assert.Equal(t, []jsonpointer.Pointer{{"meta", "new_field"}}, reportedPointers)
+}
+
+func TestParseEvent_WithRegistry(t *testing.T) {
+	rawEvent := []byte(`{"message":"Starting module","type":"THOR message","meta":{"time":"2024-09-24T14:18:46+02:00","level":"Info","module":"Hosts","scan_id":"S-UBNfBD4xE8s","event_id":"","hostname":"host"},"fields":{},"log_version":"v3.0.0"}`)
+
+	registry := thorlog.NewRegistry(thorlog.DefaultRegistry)
+	registry.Override("THOR message", nil)
+	_, err := ParseEvent(rawEvent, WithRegistry(registry))
+	assert.Error(t, err)
+
+	event, err := ParseEvent(rawEvent, WithRegistry(thorlog.DefaultRegistry))
+	require.NoError(t, err)
+	assert.Equal(t, "Starting module", event.Message())
+}
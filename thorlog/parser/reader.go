@@ -0,0 +1,138 @@
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+)
+
+// ParseError describes a line of a JSONL stream that could not be parsed as an event.
+type ParseError struct {
+	// Line is the (one-based) line number of the invalid line.
+	Line int
+	// Offset is the byte offset of the start of the invalid line within the stream.
+	Offset int64
+	// Err is the error that occurred while parsing the line.
+	Err error
+}
+
+func (p *ParseError) Error() string {
+	return fmt.Sprintf("line %d (offset %d): %v", p.Line, p.Offset, p.Err)
+}
+
+func (p *ParseError) Unwrap() error {
+	return p.Err
+}
+
+// Reader reads THOR events from a stream of JSONL data, e.g. a THOR JSON log file.
+// Each line is parsed using ParseEvent, so a single stream may contain events of different versions.
+// Empty lines are ignored.
+//
+// There is no limit on the line length; findings of any size can be read.
+type Reader struct {
+	// OnError is called for each line that could not be parsed.
+	// If it returns true, the line is skipped and reading continues with the next line.
+	// If it returns false or OnError is nil, reading stops and the error is returned by Err.
+	OnError func(err *ParseError) bool
+
+	reader  *bufio.Reader
+	options []Option
+	line    int
+	offset  int64
+	event   common.Event
+	err     error
+}
+
+// NewReader creates a new Reader that reads events from r.
+// The options are used for parsing each event.
+func NewReader(r io.Reader, opts ...Option) *Reader {
+	return &Reader{
+		reader:  bufio.NewReader(r),
+		options: opts,
+	}
+}
+
+// Next advances the reader to the next event, which is then available through Event.
+// It returns false when the end of the stream is reached or an error occurred.
+// After Next returns false, Err returns the error that occurred, if any.
+func (r *Reader) Next() bool {
+	if r.err != nil {
+		return false
+	}
+	for {
+		line, err := r.reader.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			if !errors.Is(err, io.EOF) {
+				r.err = err
+			}
+			r.event = nil
+			return false
+		}
+		r.line++
+		lineOffset := r.offset
+		r.offset += int64(len(line))
+		if err != nil && !errors.Is(err, io.EOF) {
+			r.err = err
+			r.event = nil
+			return false
+		}
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		event, parseErr := ParseEvent(line, r.options...)
+		if parseErr != nil {
+			lineError := &ParseError{
+				Line:   r.line,
+				Offset: lineOffset,
+				Err:    parseErr,
+			}
+			if r.OnError != nil && r.OnError(lineError) {
+				continue
+			}
+			r.err = lineError
+			r.event = nil
+			return false
+		}
+		r.event = event
+		return true
+	}
+}
+
+// Event returns the event that was read by the last call to Next.
+func (r *Reader) Event() common.Event {
+	return r.event
+}
+
+// Err returns the first error that stopped the reader, if any.
+// Reaching the end of the stream is not considered an error.
+func (r *Reader) Err() error {
+	return r.err
+}
+
+// Events reads all remaining events in a separate goroutine and sends them to the returned channel.
+// The channel is closed when the end of the stream is reached, an error occurs, or ctx is cancelled.
+// Once the channel is closed, Err returns the error that stopped the reader, if any.
+//
+// The Reader must not be used otherwise while the channel is still open.
+func (r *Reader) Events(ctx context.Context) <-chan common.Event {
+	events := make(chan common.Event)
+	go func() {
+		defer close(events)
+		for r.Next() {
+			select {
+			case events <- r.event:
+			case <-ctx.Done():
+				r.err = ctx.Err()
+				return
+			}
+		}
+	}()
+	return events
+}
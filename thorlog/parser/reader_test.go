@@ -0,0 +1,59 @@
+package parser
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const readerTestLog = `{"time":"2024-09-24T12:35:41Z","hostname":"host","level":"Info","module":"Startup","message":"first","scanid":"S-NtvqbRLWbf8","log_version":"v1.0.0"}
+
+{"time":"2024-09-24T12:37:04Z","hostname":"host","level":"Info","module":"Hosts","message":"second","scanid":"S-kgKxYyJFQd0","log_version":"v2.0.0"}
+not a valid event
+{"message":"third","type":"THOR message","meta":{"time":"2024-09-24T14:18:46.190394329+02:00","level":"Info","module":"Hosts","scan_id":"S-UBNfBD4xE8s","event_id":"","hostname":"host"},"fields":{},"log_version":"v3.0.0"}`
+
+func TestReader_StopOnError(t *testing.T) {
+	reader := NewReader(strings.NewReader(readerTestLog))
+	var messages []string
+	for reader.Next() {
+		messages = append(messages, reader.Event().Message())
+	}
+	assert.Equal(t, []string{"first", "second"}, messages)
+
+	var parseErr *ParseError
+	require.True(t, errors.As(reader.Err(), &parseErr))
+	assert.Equal(t, 4, parseErr.Line)
+	assert.Equal(t, int64(strings.Index(readerTestLog, "not a valid event")), parseErr.Offset)
+}
+
+func TestReader_SkipOnError(t *testing.T) {
+	reader := NewReader(strings.NewReader(readerTestLog))
+	var skipped []int
+	reader.OnError = func(err *ParseError) bool {
+		skipped = append(skipped, err.Line)
+		return true
+	}
+	var versions []common.Version
+	for reader.Next() {
+		versions = append(versions, reader.Event().Version())
+	}
+	require.NoError(t, reader.Err())
+	assert.Equal(t, []common.Version{"v1.0.0", "v2.0.0", "v3.0.0"}, versions)
+	assert.Equal(t, []int{4}, skipped)
+}
+
+func TestReader_Events(t *testing.T) {
+	reader := NewReader(strings.NewReader(readerTestLog))
+	reader.OnError = func(err *ParseError) bool { return true }
+	var messages []string
+	for event := range reader.Events(context.Background()) {
+		messages = append(messages, event.Message())
+	}
+	require.NoError(t, reader.Err())
+	assert.Equal(t, []string{"first", "second", "third"}, messages)
+}
@@ -0,0 +1,123 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	thorlogv1 "github.com/NextronSystems/jsonlog/thorlog/v1"
+)
+
+// textlogTimeFormats contains the timestamp layouts that may start a THOR text log line,
+// together with the number of space separated fields that they span.
+var textlogTimeFormats = []struct {
+	Layout string
+	Fields int
+}{
+	{time.RFC3339Nano, 1},
+	{"2006-01-02 15:04:05Z07:00", 2},
+	{"2006-01-02 15:04:05", 2},
+	{"Jan 2 15:04:05", 3},
+}
+
+// ParseTextlogLine parses a line from a THOR text log.
+//
+// A text log line consists of a header with the timestamp, hostname, and level
+// (e.g. "2024-09-24T12:35:41Z host THOR: Info: "), followed by KEY: VALUE pairs that form the body.
+// The header fields are returned as metadata, together with the MODULE, SCANID and UID fields from the body.
+// All other fields are returned in the entry; see jsonlog.ParseTextlogEntry for details on how the body is parsed.
+func ParseTextlogLine(line string) (common.LogEventMetadata, jsonlog.TextlogEntry, error) {
+	var metadata common.LogEventMetadata
+	line = strings.TrimRight(line, "\r\n")
+
+	var timestampFound bool
+	for _, format := range textlogTimeFormats {
+		timestamp, rest, ok := cutFields(line, format.Fields)
+		if !ok {
+			continue
+		}
+		parsedTime, err := time.Parse(format.Layout, timestamp)
+		if err != nil {
+			continue
+		}
+		metadata.Time = parsedTime
+		line = rest
+		timestampFound = true
+		break
+	}
+	if !timestampFound {
+		return metadata, nil, errors.New("text log line does not start with a timestamp")
+	}
+
+	hostname, line, ok := cutFields(line, 1)
+	if !ok {
+		return metadata, nil, errors.New("text log line does not contain a hostname")
+	}
+	metadata.Source = hostname
+
+	// The hostname is followed by the application name (usually "THOR:"), then the level (e.g. "Info:")
+	application, line, ok := cutFields(line, 1)
+	if !ok || !strings.HasSuffix(application, ":") {
+		return metadata, nil, fmt.Errorf("invalid application name in text log line: %q", application)
+	}
+	level, line, ok := cutFields(line, 1)
+	if !ok || !strings.HasSuffix(level, ":") {
+		return metadata, nil, fmt.Errorf("invalid level in text log line: %q", level)
+	}
+	metadata.Lvl = common.LogLevel(strings.TrimSuffix(level, ":"))
+
+	var entry jsonlog.TextlogEntry
+	for _, pair := range jsonlog.ParseTextlogEntry(line) {
+		switch pair.Key {
+		case "MODULE":
+			metadata.Mod = pair.Value
+		case "SCANID":
+			metadata.ScanID = pair.Value
+		case "UID":
+			metadata.GenID = pair.Value
+		default:
+			entry = append(entry, pair)
+		}
+	}
+	return metadata, entry, nil
+}
+
+// ParseTextlogEvent parses a line from a THOR text log into an event.
+// Since text logs contain no type information, the event is always a v1 event,
+// with all values stored as strings and all keys in lower case.
+func ParseTextlogEvent(line string) (*thorlogv1.Event, error) {
+	metadata, entry, err := ParseTextlogLine(line)
+	if err != nil {
+		return nil, err
+	}
+	event := &thorlogv1.Event{
+		LogEventMetadata: thorlogv1.Metadata(metadata),
+	}
+	for _, pair := range entry {
+		event.Data = append(event.Data, thorlogv1.Field{
+			Key:   strings.ToLower(pair.Key),
+			Value: pair.Value,
+		})
+	}
+	return event, nil
+}
+
+// cutFields splits off the first n space separated fields of s.
+// It returns the fields (joined by a single space), the remainder of s and whether s contained enough fields.
+func cutFields(s string, n int) (fields string, rest string, ok bool) {
+	var parts []string
+	rest = s
+	for i := 0; i < n; i++ {
+		rest = strings.TrimLeft(rest, " ")
+		var field string
+		field, rest, _ = strings.Cut(rest, " ")
+		if field == "" {
+			return "", s, false
+		}
+		parts = append(parts, field)
+	}
+	return strings.Join(parts, " "), rest, true
+}
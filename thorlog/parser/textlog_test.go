@@ -0,0 +1,110 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	thorlogv1 "github.com/NextronSystems/jsonlog/thorlog/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTextlogLine(t *testing.T) {
+	for _, testcase := range []struct {
+		name     string
+		line     string
+		metadata common.LogEventMetadata
+		entry    jsonlog.TextlogEntry
+	}{
+		{
+			"RFC3339",
+			"2024-09-24T12:35:41Z host THOR: Info: MODULE: Startup MESSAGE: Sigma Database: r2024-07-17 SCANID: S-NtvqbRLWbf8",
+			common.LogEventMetadata{
+				Time:   mustTime("2024-09-24T12:35:41Z"),
+				Lvl:    common.Info,
+				Mod:    "Startup",
+				ScanID: "S-NtvqbRLWbf8",
+				Source: "host",
+			},
+			jsonlog.TextlogEntry{
+				{Key: "MESSAGE", Value: "Sigma Database: r2024-07-17"},
+			},
+		},
+		{
+			"SpaceSeparatedTimestamp",
+			"2024-09-24 12:35:41 host THOR: Alert: MODULE: Filescan MESSAGE: Malware found FILE: C:\\a b.exe FILE_2: C:\\c.exe\n",
+			common.LogEventMetadata{
+				Time:   mustTime("2024-09-24T12:35:41Z"),
+				Lvl:    common.Alert,
+				Mod:    "Filescan",
+				Source: "host",
+			},
+			jsonlog.TextlogEntry{
+				{Key: "MESSAGE", Value: "Malware found"},
+				{Key: "FILE", Value: "C:\\a b.exe"},
+				{Key: "FILE_2", Value: "C:\\c.exe"},
+			},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			metadata, entry, err := ParseTextlogLine(testcase.line)
+			require.NoError(t, err)
+			assert.Equal(t, testcase.metadata, metadata)
+			assert.Equal(t, testcase.entry, entry)
+		})
+	}
+}
+
+func TestParseTextlogLine_Invalid(t *testing.T) {
+	for _, line := range []string{
+		"",
+		"not a text log line",
+		"2024-09-24T12:35:41Z",
+		"2024-09-24T12:35:41Z host",
+		"2024-09-24T12:35:41Z host THOR Info MODULE: Startup",
+	} {
+		_, _, err := ParseTextlogLine(line)
+		assert.Error(t, err, line)
+	}
+}
+
+func TestParseTextlogEvent(t *testing.T) {
+	event, err := ParseTextlogEvent("2024-09-24T12:35:41Z host THOR: Info: MODULE: Startup MESSAGE: Sigma Database: r2024-07-17 UID: abc")
+	require.NoError(t, err)
+	assert.Equal(t, &thorlogv1.Event{
+		LogEventMetadata: thorlogv1.Metadata{
+			Time:   mustTime("2024-09-24T12:35:41Z"),
+			Lvl:    common.Info,
+			Mod:    "Startup",
+			GenID:  "abc",
+			Source: "host",
+		},
+		Data: thorlogv1.Fields{
+			{Key: "message", Value: "Sigma Database: r2024-07-17"},
+		},
+	}, event)
+	assert.Equal(t, "Sigma Database: r2024-07-17", event.Message())
+}
+
+func TestParseTextlogLine_RoundTrip(t *testing.T) {
+	for _, rawEvent := range []string{
+		`{"time":"2024-09-24T12:35:41Z","hostname":"host","level":"Info","module":"Startup","message":"Sigma Database: r2024-07-17-48-g5c4f599e3","scanid":"S-NtvqbRLWbf8","log_version":"v1.0.0"}`,
+		`{"time":"2024-09-24T12:37:04Z","hostname":"host","level":"Info","module":"Hosts","message":"something","tags":["abc","def","ghi"],"scanid":"S-kgKxYyJFQd0","log_version":"v2.0.0"}`,
+		`{"message":"Starting module","type":"THOR message","meta":{"time":"2024-09-24T14:18:46+02:00","level":"Info","module":"Hosts","scan_id":"S-UBNfBD4xE8s","event_id":"","hostname":"host"},"fields":{"count":5},"log_version":"v3.0.0"}`,
+	} {
+		event, err := ParseEvent([]byte(rawEvent))
+		require.NoError(t, err)
+
+		writer := common.NewTextlogWriter(nil)
+		line := writer.FormatLine(event)
+		t.Log(line)
+
+		metadata, entry, err := ParseTextlogLine(line)
+		require.NoError(t, err)
+		assert.True(t, event.Metadata().Time.Equal(metadata.Time))
+		metadata.Time = event.Metadata().Time
+		assert.Equal(t, *event.Metadata(), metadata)
+		assert.Equal(t, event.Message(), entry[0].Value)
+	}
+}
@@ -0,0 +1,99 @@
+// Package report renders a stream of THOR events as a self-contained HTML report.
+//
+// The report contains a header with the scan and host information from the scan's messages,
+// followed by all findings sorted by their score. The HTML file has no external assets and can be
+// viewed offline; all log content is escaped by html/template.
+package report
+
+import (
+	"io"
+	"sort"
+	"time"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	"github.com/NextronSystems/jsonlog/thorlog/scan"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+// DefaultTitle is the title of reports that is used if no other title is configured.
+const DefaultTitle = "THOR Scan Report"
+
+// Report collects events for an HTML report.
+//
+// Only version 3 events are considered; older events can be converted with convert.Upgrade.
+type Report struct {
+	// Title is the title of the report. If it is empty, DefaultTitle is used.
+	Title string
+	// MinScore is the minimum score of assessments that are included as findings.
+	MinScore int64
+	// Textlog formats the objects in findings into key value pairs.
+	Textlog jsonlog.TextlogFormatter
+
+	// ScanInfo contains the invocation information of the scan, if it was logged.
+	ScanInfo *thorlog.ScanInfo
+	// HostInfo contains the information about the scanned system, if it was logged.
+	HostInfo *thorlog.HostInfo
+
+	findings []*thorlog.Assessment
+	start    time.Time
+	end      time.Time
+}
+
+// NewReport creates a new report that formats values with THOR's default text log formatter.
+func NewReport() *Report {
+	return &Report{
+		Textlog: thorlog.NewTextlogFormatter(),
+	}
+}
+
+// Add adds an event to the report.
+//
+// Assessments with a score of at least MinScore become findings. Messages are searched for
+// the scan and host information, which may be contained in any of their fields.
+func (r *Report) Add(event common.Event) {
+	switch event := event.(type) {
+	case *thorlog.Assessment:
+		r.updateTimes(event.Meta.Time)
+		if event.Score >= r.MinScore {
+			r.findings = append(r.findings, event)
+		}
+	case *thorlog.Message:
+		r.updateTimes(event.Meta.Time)
+		scanInfo, hostInfo := scan.Info(event)
+		if scanInfo != nil {
+			r.ScanInfo = scanInfo
+		}
+		if hostInfo != nil {
+			r.HostInfo = hostInfo
+		}
+	}
+}
+
+func (r *Report) updateTimes(t time.Time) {
+	if t.IsZero() {
+		return
+	}
+	if r.start.IsZero() || t.Before(r.start) {
+		r.start = t
+	}
+	if t.After(r.end) {
+		r.end = t
+	}
+}
+
+// Findings returns the findings of the report, sorted by descending score.
+// Findings with the same score are in the order in which they were added.
+func (r *Report) Findings() []*thorlog.Assessment {
+	findings := make([]*thorlog.Assessment, len(r.findings))
+	copy(findings, r.findings)
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Score > findings[j].Score
+	})
+	return findings
+}
+
+// Write renders the report as an HTML document to w.
+func (r *Report) Write(w io.Writer) error {
+	return reportTemplate.Execute(w, r.page())
+}
@@ -0,0 +1,124 @@
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	"github.com/NextronSystems/jsonlog/thorlog/parser"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scanLog is the log of a scan of a web shop server. Its findings have different scores and levels,
+// and one of them has a file name that contains HTML.
+const scanLog = `{"type":"THOR message","meta":{"time":"2024-05-28T03:00:04Z","level":"Info","module":"Startup","scan_id":"S-Mn8qW4eL2x","hostname":"web-shop-03"},"message":"Scan started","fields":{"info":{"type":"THOR invocation information","versions":{"thor":"11.0.2","build":"9c1e4f2","signatures":"2024/05/27","sigma_rules":"r2024-05-21"},"arguments":["--lab","--path","/var/www"],"scan_id":"S-Mn8qW4eL2x","thor_dir":"/opt/thor","user":"root","elevated":true}},"log_version":"v3.0.0"}
+{"type":"THOR message","meta":{"time":"2024-05-28T03:00:05Z","level":"Info","module":"SystemInfo","scan_id":"S-Mn8qW4eL2x","hostname":"web-shop-03"},"message":"System information","fields":{"info":{"type":"system information","hostname":"web-shop-03","platform":{"type":"Linux platform information","name":"Ubuntu 22.04.4 LTS","kernel_name":"Linux","kernel_version":"5.15.0-107-generic","arch":"x86_64"},"cpu_count":4,"interfaces":[{"name":"ens160","ip_address":"10.20.4.13"}]}},"log_version":"v3.0.0"}
+{"type":"THOR message","meta":{"time":"2024-05-28T03:00:05Z","level":"Info","module":"Startup","scan_id":"S-Mn8qW4eL2x","hostname":"web-shop-03"},"message":"License loaded","fields":{"license":{"type":"license","owner":"Example Shop GmbH","expires":"2025-03-31"}},"log_version":"v3.0.0"}
+{"type":"THOR assessment","meta":{"time":"2024-05-28T03:04:41Z","level":"Warning","module":"Filescan","scan_id":"S-Mn8qW4eL2x","hostname":"web-shop-03"},"message":"Suspicious file found","score":60,"subject":{"type":"file","path":"/var/www/shop/cron.php","exists":"yes","extension":".php"},"reasons":[{"summary":"Keyword IOC base64_decode(gzinflate(","signature":{"score":60,"kind":"Keyword IOC"}}],"log_version":"v3.0.0"}
+{"type":"THOR assessment","meta":{"time":"2024-05-28T03:05:12Z","level":"Notice","module":"Filescan","scan_id":"S-Mn8qW4eL2x","hostname":"web-shop-03"},"message":"Suspicious file found","score":20,"subject":{"type":"file","path":"/var/www/shop/var/cache/mage--a/mage---1f3_CONFIG","exists":"yes"},"reasons":[{"summary":"Obfuscated content in cache file","signature":{"score":20,"kind":"Internal Heuristic"}}],"log_version":"v3.0.0"}
+{"type":"THOR assessment","meta":{"time":"2024-05-28T03:07:58Z","level":"Alert","module":"Filescan","scan_id":"S-Mn8qW4eL2x","hostname":"web-shop-03"},"message":"Malicious file found","score":90,"subject":{"type":"file","path":"/var/www/shop/pub/media/<script>alert(1)</script>.php","exists":"yes","extension":".php"},"reasons":[{"summary":"YARA rule WEBSHELL_PHP_Eval_Request","signature":{"score":90,"reference":["https://github.com/Neo23x0/signature-base","javascript:alert(1)"],"kind":"YARA Rule","rule_name":"WEBSHELL_PHP_Eval_Request"},"matched":[{"data":{"data":"eval(","encoding":"plain"},"context":{"data":"@eval(base64_decode($_REQUEST[c]));","encoding":"plain"},"offset":500}]}],"reason_count":3,"context":[{"object":{"type":"file","path":"/var/www/shop/pub/media/import.tar.gz","exists":"yes","extension":".gz"},"relations":[{"relation_type":"derives from","relation_name":"parent","unique":true}]}],"log_version":"v3.0.0"}
+{"type":"THOR assessment","meta":{"time":"2024-05-28T03:09:30Z","level":"Warning","module":"Filescan","scan_id":"S-Mn8qW4eL2x","hostname":"web-shop-03"},"message":"Suspicious file found","score":60,"subject":{"type":"file","path":"/var/www/shop/app/etc/env.php.bak","exists":"yes","extension":".bak"},"reasons":[{"summary":"Filename IOC backup of Magento credentials","signature":{"score":60,"kind":"Filename IOC"}}],"log_version":"v3.0.0"}
+`
+
+func parseLog(t *testing.T, log string) []common.Event {
+	t.Helper()
+	var events []common.Event
+	reader := parser.NewReader(strings.NewReader(log))
+	for reader.Next() {
+		events = append(events, reader.Event())
+	}
+	require.NoError(t, reader.Err())
+	return events
+}
+
+func TestReport_Add(t *testing.T) {
+	report := NewReport()
+	report.MinScore = 40
+	for _, event := range parseLog(t, scanLog) {
+		report.Add(event)
+	}
+
+	require.NotNil(t, report.ScanInfo)
+	assert.Equal(t, "11.0.2", report.ScanInfo.Versions.Thor)
+	assert.Equal(t, "9c1e4f2", report.ScanInfo.Versions.Build)
+	require.NotNil(t, report.HostInfo)
+	assert.Equal(t, "web-shop-03", report.HostInfo.Hostname)
+	assert.Equal(t, "Ubuntu 22.04.4 LTS", report.HostInfo.Platform.(*thorlog.PlatformInfoLinux).Name)
+
+	var paths []string
+	for _, finding := range report.Findings() {
+		paths = append(paths, finding.Subject.(*thorlog.File).Path)
+	}
+	assert.Equal(t, []string{
+		"/var/www/shop/pub/media/<script>alert(1)</script>.php",
+		"/var/www/shop/cron.php",
+		"/var/www/shop/app/etc/env.php.bak",
+	}, paths)
+}
+
+func TestReport_Write(t *testing.T) {
+	report := NewReport()
+	report.MinScore = 40
+	for _, event := range parseLog(t, scanLog) {
+		report.Add(event)
+	}
+
+	var buffer bytes.Buffer
+	require.NoError(t, report.Write(&buffer))
+	html := buffer.String()
+
+	assert.Contains(t, html, "<title>THOR Scan Report</title>")
+	assert.Contains(t, html, "<th>THOR version</th><td>11.0.2 build 9c1e4f2</td>")
+	assert.Contains(t, html, "<th>IP addresses</th><td>10.20.4.13</td>")
+	assert.Contains(t, html, "<th>Platform name</th><td>Ubuntu 22.04.4 LTS</td>")
+	assert.Contains(t, html, `<span class="badge alert">Alert</span> 1`)
+	assert.Contains(t, html, "@<mark>eval(</mark>base64_decode($_REQUEST[c])); <span class=\"location\">at 0x1f4</span>")
+	assert.Contains(t, html, `<a href="https://github.com/Neo23x0/signature-base">`)
+	assert.NotContains(t, html, `href="javascript:`)
+	assert.Contains(t, html, "2 more reasons were not logged")
+	assert.Contains(t, html, `<span class="label">derives from: parent</span>`)
+	assert.Contains(t, html, "/var/www/shop/pub/media/import.tar.gz")
+	assert.Contains(t, html, "&lt;script&gt;alert(1)&lt;/script&gt;")
+	assert.NotContains(t, html, "<script>")
+	assert.NotContains(t, html, "<link")
+	assert.NotContains(t, html, "mage---1f3_CONFIG", "findings below the minimum score are omitted")
+}
+
+func TestReport_WriteEmpty(t *testing.T) {
+	report := NewReport()
+	report.Title = "Empty <scan>"
+	var buffer bytes.Buffer
+	require.NoError(t, report.Write(&buffer))
+	assert.Contains(t, buffer.String(), "<h1>Empty &lt;scan&gt;</h1>")
+	assert.Contains(t, buffer.String(), "No findings.")
+}
+
+func TestNewMatch(t *testing.T) {
+	context := thorlog.Encode([]byte{0xff, 'e', 'v', 'i', 'l'})
+	m := newMatch(thorlog.MatchString{Match: thorlog.EncodeString("evil"), Context: &context})
+	assert.Equal(t, match{Data: "evil", After: ` in "\xffevil"`}, m, "invalid UTF-8 context is not split")
+
+	m = newMatch(thorlog.MatchString{Match: thorlog.EncodeString("other"), Context: &context})
+	assert.Empty(t, m.Before)
+	assert.Equal(t, "other", m.Data)
+}
+
+func TestReport_ContextTree(t *testing.T) {
+	report := NewReport()
+	tree := report.contextTree("file", thorlog.Context{
+		{Object: thorlog.NewFile("/tmp/archive.zip"), Relations: []thorlog.Relation{{Type: "derives from", Name: "parent"}}},
+		{Object: thorlog.NewProcess(42)},
+		{Object: thorlog.NewFile("/tmp/other.zip"), Relations: []thorlog.Relation{{Type: "derives from", Name: "parent"}}},
+		{Object: thorlog.NewFile("/tmp/unrelated")},
+	})
+	require.Len(t, tree.Children, 2)
+	assert.Equal(t, "derives from: parent", tree.Children[0].Label)
+	assert.Len(t, tree.Children[0].Children, 2)
+	assert.Equal(t, "related", tree.Children[1].Label)
+	require.Len(t, tree.Children[1].Children, 2)
+	assert.Equal(t, "process", tree.Children[1].Children[0].Label)
+	assert.Equal(t, "file", tree.Children[1].Children[1].Label)
+}
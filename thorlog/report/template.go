@@ -0,0 +1,11 @@
+package report
+
+import (
+	_ "embed"
+	"html/template"
+)
+
+//go:embed report.html
+var reportHTML string
+
+var reportTemplate = template.Must(template.New("report").Parse(reportHTML))
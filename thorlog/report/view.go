@@ -0,0 +1,307 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+// page is the data that the report template is executed with.
+type page struct {
+	Title    string
+	Start    string
+	End      string
+	Scan     []field
+	Host     []field
+	Levels   []levelCount
+	Findings []finding
+}
+
+type field struct {
+	Label string
+	Value string
+}
+
+type levelCount struct {
+	Level string
+	Class string
+	Count int
+}
+
+type finding struct {
+	ID         string
+	Level      string
+	Class      string
+	Time       string
+	Module     string
+	Message    string
+	Score      int64
+	Subject    jsonlog.TextlogEntry
+	Reasons    []reason
+	Omitted    int
+	Context    *node
+	ObjectType string
+}
+
+type reason struct {
+	Summary string
+	Rule    string
+	Kind    string
+	Score   int64
+	Tags    string
+	Refs    []string
+	Matches []match
+}
+
+// match is a match string, split into the matched data and the surrounding context.
+type match struct {
+	Before   string
+	Data     string
+	After    string
+	Location string
+}
+
+// node is a node in the context tree of a finding.
+type node struct {
+	Label    string
+	Fields   jsonlog.TextlogEntry
+	Children []*node
+}
+
+const timeLayout = "2006-01-02 15:04:05 MST"
+
+// defaultRelationLabel is the label of context objects without a relation.
+const defaultRelationLabel = "related"
+
+func (r *Report) page() page {
+	title := r.Title
+	if title == "" {
+		title = DefaultTitle
+	}
+	p := page{
+		Title: title,
+		Start: formatTime(r.start),
+		End:   formatTime(r.end),
+		Scan:  r.scanFields(),
+		Host:  r.hostFields(),
+	}
+	counts := map[common.LogLevel]int{}
+	for i, assessment := range r.Findings() {
+		counts[assessment.Meta.Lvl]++
+		p.Findings = append(p.Findings, r.finding(i, assessment))
+	}
+	for _, level := range []common.LogLevel{common.Alert, common.Warning, common.Notice, common.Info} {
+		if counts[level] > 0 {
+			p.Levels = append(p.Levels, levelCount{Level: string(level), Class: levelClass(level), Count: counts[level]})
+		}
+	}
+	return p
+}
+
+func (r *Report) scanFields() []field {
+	info := r.ScanInfo
+	if info == nil {
+		return nil
+	}
+	fields := []field{
+		{"THOR version", joinNonEmpty(" build ", info.Versions.Thor, info.Versions.Build)},
+		{"Signatures", info.Versions.Signatures},
+		{"Sigma rules", info.Versions.Sigma},
+		{"Scan ID", info.ScanID},
+		{"Arguments", info.Arguments.String()},
+		{"User", info.User},
+		{"Elevated", strconv.FormatBool(info.Elevated)},
+		{"Threads", strconv.Itoa(info.Threads)},
+		{"License owner", info.License.Owner},
+	}
+	return nonEmpty(fields)
+}
+
+func (r *Report) hostFields() []field {
+	info := r.HostInfo
+	if info == nil {
+		return nil
+	}
+	fields := []field{
+		{"Hostname", info.Hostname},
+		{"Domain", info.Domain},
+		{"System type", string(info.SystemType)},
+	}
+	if info.Platform != nil {
+		for _, pair := range r.Textlog.Format(info.Platform) {
+			fields = append(fields, field{"Platform " + strings.ToLower(pair.Key), pair.Value})
+		}
+	}
+	var addresses []string
+	for _, iface := range info.Interfaces {
+		addresses = append(addresses, joinNonEmpty(", ", iface.IpAddress, iface.Ipv6Address))
+	}
+	fields = append(fields,
+		field{"CPUs", strconv.Itoa(info.Cpus)},
+		field{"Memory", info.Memory.String()},
+		field{"Timezone", info.Timezone},
+		field{"Language", info.Language},
+		field{"IP addresses", joinNonEmpty(", ", addresses...)},
+	)
+	return nonEmpty(fields)
+}
+
+func (r *Report) finding(index int, assessment *thorlog.Assessment) finding {
+	f := finding{
+		ID:      "finding-" + strconv.Itoa(index+1),
+		Level:   string(assessment.Meta.Lvl),
+		Class:   levelClass(assessment.Meta.Lvl),
+		Time:    formatTime(assessment.Meta.Time),
+		Module:  assessment.Meta.Mod,
+		Message: assessment.Text,
+		Score:   assessment.Score,
+	}
+	if assessment.Subject != nil {
+		f.ObjectType = assessment.Subject.EmbeddedHeader().Type
+		f.Subject = r.fields(assessment.Subject)
+	}
+	for _, assessmentReason := range assessment.Reasons {
+		f.Reasons = append(f.Reasons, newReason(assessmentReason))
+	}
+	if assessment.ReasonCount > len(assessment.Reasons) {
+		f.Omitted = assessment.ReasonCount - len(assessment.Reasons)
+	}
+	if len(assessment.EventContext) > 0 {
+		f.Context = r.contextTree(f.ObjectType, assessment.EventContext)
+	}
+	return f
+}
+
+func newReason(r thorlog.Reason) reason {
+	converted := reason{
+		Summary: r.Summary,
+		Rule:    r.Rulename,
+		Kind:    string(r.Class),
+		Score:   r.Signature.Score,
+		Tags:    strings.Join(r.Tags, ", "),
+		Refs:    r.Ref,
+	}
+	for _, matchString := range r.StringMatches {
+		converted.Matches = append(converted.Matches, newMatch(matchString))
+	}
+	return converted
+}
+
+// newMatch splits a match string into the matched data and its context.
+//
+// If the match can't be located within the context, or either of them is not valid UTF-8,
+// their human-readable representations are used and the context is shown after the match.
+func newMatch(matchString thorlog.MatchString) match {
+	var m match
+	data := matchString.Match.Data()
+	if matchString.Context != nil {
+		context := matchString.Context.Data()
+		if index := bytes.Index(context, data); index >= 0 && utf8.Valid(context) && len(data) > 0 {
+			m.Before = string(context[:index])
+			m.Data = string(data)
+			m.After = string(context[index+len(data):])
+		} else {
+			m.Data = matchString.Match.String()
+			m.After = " in " + matchString.Context.String()
+		}
+	} else if utf8.Valid(data) {
+		m.Data = string(data)
+	} else {
+		m.Data = matchString.Match.String()
+	}
+	var location []string
+	if matchString.Offset != nil && !matchString.HideOffset {
+		location = append(location, fmt.Sprintf("at %#x", *matchString.Offset))
+	}
+	if matchString.Field != nil {
+		location = append(location, "in "+matchString.Field.String())
+	}
+	m.Location = strings.Join(location, " ")
+	return m
+}
+
+// contextTree creates a tree with the subject as root and the context objects below their relation.
+// Like in the text log, only the first relation of each object is used.
+// Objects without a relation are grouped below a node labelled defaultRelationLabel.
+func (r *Report) contextTree(subjectType string, context thorlog.Context) *node {
+	root := &node{Label: subjectType}
+	relations := map[thorlog.Relation]*node{}
+	for _, element := range context {
+		if element.Object == nil {
+			continue
+		}
+		var relation thorlog.Relation
+		if len(element.Relations) > 0 {
+			relation = element.Relations[0]
+		}
+		relationNode := relations[relation]
+		if relationNode == nil {
+			label := joinNonEmpty(": ", relation.Type, relation.Name)
+			if label == "" {
+				label = defaultRelationLabel
+			}
+			relationNode = &node{Label: label}
+			relations[relation] = relationNode
+			root.Children = append(root.Children, relationNode)
+		}
+		relationNode.Children = append(relationNode.Children, &node{
+			Label:  element.Object.EmbeddedHeader().Type,
+			Fields: r.fields(element.Object),
+		})
+	}
+	return root
+}
+
+// fields returns the non-empty text log fields of an object.
+func (r *Report) fields(object jsonlog.Object) jsonlog.TextlogEntry {
+	var entry jsonlog.TextlogEntry
+	for _, pair := range r.Textlog.Format(object) {
+		if pair.Value != "" {
+			entry = append(entry, pair)
+		}
+	}
+	return entry
+}
+
+func levelClass(level common.LogLevel) string {
+	switch level {
+	case common.Alert, common.Warning, common.Notice:
+		return strings.ToLower(string(level))
+	default:
+		return "info"
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(timeLayout)
+}
+
+func joinNonEmpty(separator string, values ...string) string {
+	var nonEmptyValues []string
+	for _, value := range values {
+		if value != "" {
+			nonEmptyValues = append(nonEmptyValues, value)
+		}
+	}
+	return strings.Join(nonEmptyValues, separator)
+}
+
+func nonEmpty(fields []field) []field {
+	var result []field
+	for _, f := range fields {
+		if f.Value != "" && f.Value != "0" && f.Value != "0B" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
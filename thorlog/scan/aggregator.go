@@ -0,0 +1,170 @@
+package scan
+
+import (
+	"encoding/json"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+// DefaultMaxErrors is the number of error messages per summary that is used if no other limit is configured.
+const DefaultMaxErrors = 100
+
+// Aggregator builds summaries for the events of one or more scans, with one summary per scan ID.
+//
+// Events of all versions are counted by their metadata; the statistics about findings and the scan and host information
+// are only collected from version 3 events. Older events can be converted with convert.Upgrade.
+//
+// The zero value is ready to use.
+type Aggregator struct {
+	// MaxErrors is the maximum number of error messages per summary. If it is 0, DefaultMaxErrors is used.
+	MaxErrors int
+
+	summaries map[string]*Summary
+	order     []string
+}
+
+// Add adds an event to the summary of its scan.
+func (a *Aggregator) Add(event common.Event) {
+	meta := event.Metadata()
+	summary := a.summary(meta.ScanID)
+	if summary.Hostname == "" {
+		summary.Hostname = meta.Source
+	}
+	if !meta.Time.IsZero() {
+		if summary.Start.IsZero() || meta.Time.Before(summary.Start) {
+			summary.Start = meta.Time
+		}
+		if meta.Time.After(summary.End) {
+			summary.End = meta.Time
+		}
+		summary.Duration = summary.End.Sub(summary.Start)
+	}
+
+	summary.Events++
+	switch meta.Lvl {
+	case common.Alert:
+		summary.Levels.Alerts++
+	case common.Warning:
+		summary.Levels.Warnings++
+	case common.Notice:
+		summary.Levels.Notices++
+	case common.Error:
+		summary.Levels.Errors++
+		if len(summary.ErrorMessages) < a.maxErrors() {
+			summary.ErrorMessages = append(summary.ErrorMessages, event.Message())
+		}
+	case common.Info:
+		summary.Levels.Info++
+	case common.Debug:
+		summary.Levels.Debug++
+	}
+
+	switch event := event.(type) {
+	case *thorlog.Assessment:
+		summary.addFinding(event)
+	case *thorlog.Message:
+		scanInfo, hostInfo := Info(event)
+		if scanInfo != nil {
+			summary.ScanInfo = scanInfo
+		}
+		if hostInfo != nil {
+			summary.HostInfo = hostInfo
+		}
+	}
+}
+
+func (s *Summary) addFinding(assessment *thorlog.Assessment) {
+	s.Findings++
+	s.Modules[assessment.Meta.Mod]++
+	s.Scores.Add(assessment.Score)
+	classes := map[string]bool{}
+	rules := map[string]bool{}
+	for _, reason := range assessment.Reasons {
+		if reason.Class != "" && !classes[string(reason.Class)] {
+			classes[string(reason.Class)] = true
+			s.Classes[string(reason.Class)]++
+		}
+		if reason.Rulename != "" && !rules[reason.Rulename] {
+			rules[reason.Rulename] = true
+			s.Rules[reason.Rulename]++
+		}
+	}
+}
+
+// Summary returns the summary of a scan, or nil if no events of the scan were added.
+func (a *Aggregator) Summary(scanID string) *Summary {
+	return a.summaries[scanID]
+}
+
+// Summaries returns the summaries of all scans, in the order in which the scans were first added.
+func (a *Aggregator) Summaries() []*Summary {
+	summaries := make([]*Summary, 0, len(a.order))
+	for _, scanID := range a.order {
+		summaries = append(summaries, a.summaries[scanID])
+	}
+	return summaries
+}
+
+func (a *Aggregator) summary(scanID string) *Summary {
+	if summary, ok := a.summaries[scanID]; ok {
+		return summary
+	}
+	if a.summaries == nil {
+		a.summaries = map[string]*Summary{}
+	}
+	summary := NewSummary(scanID)
+	a.summaries[scanID] = summary
+	a.order = append(a.order, scanID)
+	return summary
+}
+
+func (a *Aggregator) maxErrors() int {
+	if a.MaxErrors == 0 {
+		return DefaultMaxErrors
+	}
+	return a.MaxErrors
+}
+
+// Info returns the scan and host information that is contained in the fields of a message.
+//
+// Messages that were created in this process contain the objects directly. For parsed messages,
+// the objects are generic fields with a type, which are unmarshalled into the actual object type.
+func Info(message *thorlog.Message) (*thorlog.ScanInfo, *thorlog.HostInfo) {
+	var scanInfo *thorlog.ScanInfo
+	var hostInfo *thorlog.HostInfo
+	for _, field := range message.Fields {
+		switch object := infoObject(field.Value).(type) {
+		case *thorlog.ScanInfo:
+			scanInfo = object
+		case *thorlog.HostInfo:
+			hostInfo = object
+		}
+	}
+	return scanInfo, hostInfo
+}
+
+func infoObject(value any) any {
+	fields, isFields := value.(thorlog.MessageFields)
+	if !isFields {
+		return value
+	}
+	for _, field := range fields {
+		if field.Key != "type" {
+			continue
+		}
+		if field.Value != thorlog.NewScanInfo().Type && field.Value != thorlog.NewHostInfo().Type {
+			return nil
+		}
+		data, err := json.Marshal(fields)
+		if err != nil {
+			return nil
+		}
+		object := thorlog.EmbeddedObject{Mode: thorlog.DecodeLenient}
+		if err := json.Unmarshal(data, &object); err != nil {
+			return nil
+		}
+		return object.Object
+	}
+	return nil
+}
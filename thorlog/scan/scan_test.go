@@ -0,0 +1,123 @@
+package scan
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	"github.com/NextronSystems/jsonlog/thorlog/parser"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scanLog contains the events of a scan of a domain controller, interleaved with a finding from
+// the scan of a second domain controller.
+const scanLog = `{"type":"THOR message","meta":{"time":"2024-04-16T22:00:00Z","level":"Info","module":"Startup","scan_id":"S-Fp6hD1cX8v","hostname":"dc-01"},"message":"Scan started","fields":{"info":{"type":"THOR invocation information","versions":{"thor":"11.0.1","build":"e27b0d4","signatures":"2024/04/15","sigma_rules":"r2024-04-08"},"scan_id":"S-Fp6hD1cX8v","thor_dir":"C:\\thor","user":"CORP\\svc-thor","elevated":true}},"log_version":"v3.0.0"}
+{"type":"THOR message","meta":{"time":"2024-04-16T22:00:01Z","level":"Info","module":"SystemInfo","scan_id":"S-Fp6hD1cX8v","hostname":"dc-01"},"message":"System information","fields":{"info":{"type":"system information","hostname":"dc-01","domain":"corp.example","platform":{"type":"Windows platform information","name":"Windows Server 2019 Standard","os_type":"Server","version":"1809","build_number":"17763"}}},"log_version":"v3.0.0"}
+{"type":"THOR assessment","meta":{"time":"2024-04-16T22:01:12Z","level":"Alert","module":"Filescan","scan_id":"S-Fp6hD1cX8v","hostname":"dc-01"},"message":"Malicious file found","score":100,"subject":{"type":"file","path":"C:\\Windows\\Temp\\m64.exe","exists":"yes","extension":".exe"},"reasons":[{"summary":"YARA rule HKTL_Mimikatz_Strings","signature":{"score":75,"kind":"YARA Rule","rule_name":"HKTL_Mimikatz_Strings"}},{"summary":"YARA rule HKTL_Mimikatz_Keywords","signature":{"score":70,"kind":"YARA Rule","rule_name":"HKTL_Mimikatz_Keywords"}},{"summary":"Filename IOC m64.exe","signature":{"score":60,"kind":"Filename IOC"}}],"log_version":"v3.0.0"}
+{"type":"THOR assessment","meta":{"time":"2024-04-16T22:01:40Z","level":"Warning","module":"ProcessCheck","scan_id":"S-Fp6hD1cX8v","hostname":"dc-01"},"message":"Suspicious process found","score":65,"subject":{"type":"process","pid":3904,"name":"rundll32.exe","command":"rundll32.exe C:\\Windows\\Temp\\m64.dll,Start"},"reasons":[{"summary":"YARA rule HKTL_Mimikatz_Strings","signature":{"score":65,"kind":"YARA Rule","rule_name":"HKTL_Mimikatz_Strings"}}],"log_version":"v3.0.0"}
+{"type":"THOR assessment","meta":{"time":"2024-04-16T22:04:05Z","level":"Alert","module":"Filescan","scan_id":"S-Gq7iE2dY9w","hostname":"dc-02"},"message":"Malicious file found","score":80,"subject":{"type":"file","path":"C:\\Windows\\Temp\\m64.exe","exists":"yes","extension":".exe"},"reasons":[{"summary":"YARA rule HKTL_Mimikatz_Strings","signature":{"score":80,"kind":"YARA Rule","rule_name":"HKTL_Mimikatz_Strings"}}],"log_version":"v3.0.0"}
+{"type":"THOR message","meta":{"time":"2024-04-16T22:02:00Z","level":"Error","module":"Filescan","scan_id":"S-Fp6hD1cX8v","hostname":"dc-01"},"message":"Could not read file","fields":{"file":"C:\\pagefile.sys","error":"The process cannot access the file because it is being used by another process."},"log_version":"v3.0.0"}
+{"type":"THOR message","meta":{"time":"2024-04-16T22:03:00Z","level":"Error","module":"ProcessCheck","scan_id":"S-Fp6hD1cX8v","hostname":"dc-01"},"message":"Could not open process","fields":{"pid":4,"error":"Access is denied."},"log_version":"v3.0.0"}
+{"type":"THOR message","meta":{"time":"2024-04-16T23:00:00Z","level":"Info","module":"Report","scan_id":"S-Fp6hD1cX8v","hostname":"dc-01"},"message":"Scan finished","log_version":"v3.0.0"}
+`
+
+func parseLog(t *testing.T, log string) []common.Event {
+	t.Helper()
+	var events []common.Event
+	reader := parser.NewReader(strings.NewReader(log))
+	for reader.Next() {
+		events = append(events, reader.Event())
+	}
+	require.NoError(t, reader.Err())
+	return events
+}
+
+func TestAggregator(t *testing.T) {
+	aggregator := Aggregator{MaxErrors: 1}
+	for _, event := range parseLog(t, scanLog) {
+		aggregator.Add(event)
+	}
+	summaries := aggregator.Summaries()
+	require.Len(t, summaries, 2)
+	assert.Equal(t, "S-Fp6hD1cX8v", summaries[0].ScanID)
+	assert.Same(t, summaries[1], aggregator.Summary("S-Gq7iE2dY9w"))
+	assert.Nil(t, aggregator.Summary("S-xyz"))
+
+	summary := summaries[0]
+	assert.Equal(t, "dc-01", summary.Hostname)
+	assert.Equal(t, time.Date(2024, 4, 16, 22, 0, 0, 0, time.UTC), summary.Start)
+	assert.Equal(t, time.Date(2024, 4, 16, 23, 0, 0, 0, time.UTC), summary.End)
+	assert.Equal(t, time.Hour, summary.Duration)
+	assert.Equal(t, 7, summary.Events)
+	assert.Equal(t, LevelCounts{Alerts: 1, Warnings: 1, Errors: 2, Info: 3}, summary.Levels)
+	assert.Equal(t, 2, summary.Findings)
+	assert.Equal(t, Counter{"Filescan": 1, "ProcessCheck": 1}, summary.Modules)
+	assert.Equal(t, Counter{"YARA Rule": 2, "Filename IOC": 1}, summary.Classes)
+	assert.Equal(t, Counter{"HKTL_Mimikatz_Strings": 2, "HKTL_Mimikatz_Keywords": 1}, summary.Rules)
+	assert.Equal(t, "60-69:1, 90-100:1", summary.Scores.String())
+	assert.Equal(t, thorlog.StringList{"Could not read file"}, summary.ErrorMessages)
+	require.NotNil(t, summary.ScanInfo)
+	assert.Equal(t, "11.0.1", summary.ScanInfo.Versions.Thor)
+	require.NotNil(t, summary.HostInfo)
+	assert.Equal(t, "corp.example", summary.HostInfo.Domain)
+	assert.Equal(t, "Windows Server 2019 Standard", summary.HostInfo.Platform.(*thorlog.PlatformInfoWindows).Name)
+
+	assert.Equal(t, "dc-02", summaries[1].Hostname)
+	assert.Equal(t, 1, summaries[1].Findings)
+	assert.Nil(t, summaries[1].ScanInfo)
+}
+
+func TestInfo_Created(t *testing.T) {
+	scanInfo := thorlog.NewScanInfo()
+	scanInfo.Versions.Thor = "11.0.1"
+	hostInfo := thorlog.NewHostInfo()
+	hostInfo.Hostname = "dc-01"
+	parsedScanInfo, parsedHostInfo := Info(thorlog.NewMessage(thorlog.LogEventMetadata{}, "Scan started", "info", scanInfo, "host", hostInfo))
+	assert.Same(t, scanInfo, parsedScanInfo)
+	assert.Same(t, hostInfo, parsedHostInfo)
+}
+
+func TestSummary_JSON(t *testing.T) {
+	var aggregator Aggregator
+	for _, event := range parseLog(t, scanLog) {
+		aggregator.Add(event)
+	}
+	summary := aggregator.Summary("S-Fp6hD1cX8v")
+	data, err := json.Marshal(summary)
+	require.NoError(t, err)
+
+	registry := thorlog.NewRegistry(thorlog.DefaultRegistry)
+	Register(registry)
+	object := thorlog.EmbeddedObject{Registry: registry}
+	require.NoError(t, json.Unmarshal(data, &object))
+	require.IsType(t, &Summary{}, object.Object)
+	assert.Equal(t, summary, object.Object)
+
+	assert.Nil(t, thorlog.DefaultRegistry.Lookup(typeSummary))
+}
+
+func TestSummary_Textlog(t *testing.T) {
+	var aggregator Aggregator
+	for _, event := range parseLog(t, scanLog) {
+		aggregator.Add(event)
+	}
+	entry := thorlog.NewTextlogFormatter().Format(aggregator.Summary("S-Fp6hD1cX8v"))
+	assert.Equal(t, "SCAN_ID: S-Fp6hD1cX8v HOSTNAME: dc-01 START: 2024-04-16T22:00:00Z END: 2024-04-16T23:00:00Z DURATION: 1h0m0s "+
+		"EVENTS: 7 ALERTS: 1 WARNINGS: 1 NOTICES: 0 ERRORS: 2 INFO: 3 FINDINGS: 2 MODULES: Filescan:1, ProcessCheck:1 "+
+		"SIGCLASSES: YARA Rule:2, Filename IOC:1 RULES: HKTL_Mimikatz_Strings:2, HKTL_Mimikatz_Keywords:1 SCORES: 60-69:1, 90-100:1 "+
+		"ERROR_MESSAGES: Could not read file, Could not open process", entry.String())
+}
+
+func TestScoreHistogram(t *testing.T) {
+	histogram := NewScoreHistogram()
+	for _, score := range []int64{-10, 0, 9, 10, 99, 100, 120} {
+		histogram.Add(score)
+	}
+	assert.Equal(t, ScoreBucket{Min: 0, Max: 9, Count: 3}, histogram[0])
+	assert.Equal(t, ScoreBucket{Min: 90, Max: 100, Count: 3}, histogram[9])
+	assert.Equal(t, "0-9:3, 10-19:1, 90-100:3", histogram.String())
+}
@@ -0,0 +1,154 @@
+// Package scan aggregates the events of THOR scans into summaries with statistics.
+//
+// A Summary is a log object like the objects in thorlog/v3, so it can be marshalled to JSON and to a text log entry,
+// and it can be added to a thorlog.Registry with Register so that it can be unmarshalled e.g. as a thorlog.EmbeddedObject.
+package scan
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NextronSystems/jsonlog"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+// Summary summarizes the events of a single scan.
+type Summary struct {
+	jsonlog.ObjectHeader
+
+	ScanID   string `json:"scan_id" textlog:"scan_id"`
+	Hostname string `json:"hostname" textlog:"hostname"`
+
+	// Start and End are the times of the first and last event of the scan.
+	Start    time.Time     `json:"start" textlog:"start"`
+	End      time.Time     `json:"end" textlog:"end"`
+	Duration time.Duration `json:"duration" textlog:"duration"`
+
+	// Events is the number of events of the scan.
+	Events int `json:"events" textlog:"events"`
+	// Levels counts the events of the scan by their log level.
+	Levels LevelCounts `json:"levels" textlog:",expand"`
+
+	// Findings is the number of assessments of the scan.
+	Findings int `json:"findings" textlog:"findings"`
+	// Modules counts the findings by the module that created them.
+	Modules Counter `json:"modules" textlog:"modules"`
+	// Classes counts the findings by the classes of their signatures.
+	// Each class is counted once per finding, even if several signatures of the class matched.
+	Classes Counter `json:"signature_classes" textlog:"sigclasses"`
+	// Rules counts the findings by the names of their signatures, like Classes. Signatures without a name are not counted.
+	Rules Counter `json:"rules" textlog:"rules"`
+	// Scores is the distribution of the findings' scores.
+	Scores ScoreHistogram `json:"scores" textlog:"scores"`
+
+	// ErrorMessages contains the messages of the scan's errors, up to the aggregator's limit.
+	// Levels.Errors contains the total number of errors.
+	ErrorMessages thorlog.StringList `json:"error_messages" textlog:"error_messages,omitempty" jsonschema:"nullable"`
+
+	// ScanInfo and HostInfo contain the information about the scan and the scanned system, if it was logged.
+	// In the text log, this information is contained in separate messages, so it is omitted from the summary.
+	ScanInfo *thorlog.ScanInfo `json:"scan_info,omitempty" textlog:"-"`
+	HostInfo *thorlog.HostInfo `json:"host_info,omitempty" textlog:"-"`
+}
+
+const typeSummary = "THOR scan summary"
+
+// Register adds the Summary type to a registry. Summaries are not part of the THOR log itself,
+// so they are not contained in thorlog.DefaultRegistry or in the schema for the THOR log.
+func Register(registry *thorlog.Registry) {
+	registry.Add(typeSummary, &Summary{})
+}
+
+// NewSummary creates a new, empty summary for a scan.
+func NewSummary(scanID string) *Summary {
+	return &Summary{
+		ObjectHeader: jsonlog.ObjectHeader{
+			Type: typeSummary,
+		},
+		ScanID:  scanID,
+		Modules: Counter{},
+		Classes: Counter{},
+		Rules:   Counter{},
+		Scores:  NewScoreHistogram(),
+	}
+}
+
+// LevelCounts contains the number of events per log level.
+type LevelCounts struct {
+	Alerts   int `json:"alerts" textlog:"alerts"`
+	Warnings int `json:"warnings" textlog:"warnings"`
+	Notices  int `json:"notices" textlog:"notices"`
+	Errors   int `json:"errors" textlog:"errors"`
+	Info     int `json:"info" textlog:"info"`
+	Debug    int `json:"debug" textlog:"debug,omitempty"`
+}
+
+// Counter counts occurrences of names.
+type Counter map[string]int
+
+// Sorted returns the names in descending order of their count. Names with the same count are sorted alphabetically.
+func (c Counter) Sorted() []string {
+	names := make([]string, 0, len(c))
+	for name := range c {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if c[names[i]] != c[names[j]] {
+			return c[names[i]] > c[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// String returns the names and their counts in the order of Sorted, e.g. "Filescan:3, ProcessCheck:1".
+func (c Counter) String() string {
+	var entries []string
+	for _, name := range c.Sorted() {
+		entries = append(entries, fmt.Sprintf("%s:%d", name, c[name]))
+	}
+	return strings.Join(entries, ", ")
+}
+
+// ScoreBucket counts the scores in the range from Min to Max, both inclusive.
+type ScoreBucket struct {
+	Min   int64 `json:"min"`
+	Max   int64 `json:"max"`
+	Count int   `json:"count"`
+}
+
+// ScoreHistogram is the distribution of scores in buckets of ten, from 0-9 to 90-100.
+type ScoreHistogram []ScoreBucket
+
+// NewScoreHistogram creates a histogram with empty buckets.
+func NewScoreHistogram() ScoreHistogram {
+	histogram := make(ScoreHistogram, 10)
+	for i := range histogram {
+		histogram[i] = ScoreBucket{Min: int64(i * 10), Max: int64(i*10 + 9)}
+	}
+	histogram[len(histogram)-1].Max = 100
+	return histogram
+}
+
+// Add counts a score. Scores outside of the histogram's range are counted in the first or last bucket.
+func (h ScoreHistogram) Add(score int64) {
+	for i := range h {
+		if score <= h[i].Max || i == len(h)-1 {
+			h[i].Count++
+			return
+		}
+	}
+}
+
+// String returns the non-empty buckets, e.g. "60-69:2, 90-100:1".
+func (h ScoreHistogram) String() string {
+	var buckets []string
+	for _, bucket := range h {
+		if bucket.Count > 0 {
+			buckets = append(buckets, fmt.Sprintf("%d-%d:%d", bucket.Min, bucket.Max, bucket.Count))
+		}
+	}
+	return strings.Join(buckets, ", ")
+}
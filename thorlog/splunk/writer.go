@@ -0,0 +1,364 @@
+// Package splunk sends THOR events to a Splunk HTTP Event Collector (HEC).
+//
+// Events are wrapped in HEC JSON envelopes and sent in gzip compressed batches to the event endpoint.
+// Failed requests are retried, and if indexer acknowledgement is enabled, a batch is only considered
+// delivered once Splunk acknowledges that it was indexed.
+package splunk
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+// Default settings that are used for unset fields of a Writer.
+const (
+	DefaultAssessmentSourcetype = "thor:assessment"
+	DefaultMessageSourcetype    = "thor:message"
+	DefaultSourcetype           = "thor"
+	DefaultBatchSize            = 100
+	DefaultMaxRetries           = 3
+	DefaultRetryBackoff         = time.Second
+	DefaultAckPollInterval      = time.Second
+	DefaultAckTimeout           = time.Minute
+)
+
+const (
+	eventPath = "/services/collector/event"
+	ackPath   = "/services/collector/ack"
+)
+
+// Writer sends events in batches to a Splunk HTTP Event Collector.
+//
+// Events are buffered until BatchSize events were written, or until Flush or Close is called.
+// Sending a batch blocks the caller. If the collector is busy, the batch is retried with an increasing backoff,
+// so a slow collector slows down the writer instead of accumulating events in memory.
+// If a batch can't be sent after all retries, its events are dropped.
+//
+// A Writer is safe for concurrent use. Batches are sent one at a time; while a batch is sent,
+// other callers can add events to the next batch, but callers that need to send a batch wait.
+type Writer struct {
+	// URL is the base URL of the collector, e.g. https://splunk.example.com:8088.
+	URL string
+	// Token is the HEC token that is used for authentication.
+	Token string
+	// Client is used to send the requests. If it is nil, http.DefaultClient is used.
+	Client *http.Client
+
+	// Index is the Splunk index for the events. If it is empty, the default index of the token is used.
+	Index string
+	// Source is the source for the events. If it is empty, the default source of the token is used.
+	Source string
+	// AssessmentSourcetype is the sourcetype for assessments. If it is empty, DefaultAssessmentSourcetype is used.
+	AssessmentSourcetype string
+	// MessageSourcetype is the sourcetype for messages. If it is empty, DefaultMessageSourcetype is used.
+	MessageSourcetype string
+	// Sourcetype is the sourcetype for all other events, e.g. events in older log versions.
+	// If it is empty, DefaultSourcetype is used.
+	Sourcetype string
+
+	// BatchSize is the number of events that are sent in one request. If it is 0, DefaultBatchSize is used.
+	BatchSize int
+	// DisableCompression disables the gzip compression of requests.
+	DisableCompression bool
+
+	// MaxRetries is the number of times a batch is resent after a failed attempt.
+	// If it is 0, DefaultMaxRetries is used. If it is negative, batches are not resent.
+	MaxRetries int
+	// RetryBackoff is the time to wait before the first retry. It doubles for every further retry.
+	// If the collector sends a Retry-After header, that time is used instead.
+	// If it is 0, DefaultRetryBackoff is used.
+	RetryBackoff time.Duration
+
+	// Channel is the HEC channel ID, a GUID that identifies this client.
+	// If it is set, indexer acknowledgement is used: a batch is only considered delivered once the collector
+	// acknowledges that it was indexed. If the acknowledgement doesn't arrive within AckTimeout,
+	// the batch is resent. Indexer acknowledgement must be enabled for the token as well.
+	Channel string
+	// AckPollInterval is the time between queries for the acknowledgement status.
+	// If it is 0, DefaultAckPollInterval is used.
+	AckPollInterval time.Duration
+	// AckTimeout is the maximum time to wait for the acknowledgement of a batch.
+	// If it is 0, DefaultAckTimeout is used.
+	AckTimeout time.Duration
+
+	// mu guards the current batch, sendMu ensures that only one batch is sent at a time.
+	mu     sync.Mutex
+	sendMu sync.Mutex
+	batch  []byte
+	events int
+}
+
+// NewWriter creates a new Writer that sends events to the collector at url, using token for authentication.
+func NewWriter(url string, token string) *Writer {
+	return &Writer{
+		URL:   url,
+		Token: token,
+	}
+}
+
+// envelope is the HEC JSON envelope for a single event.
+type envelope struct {
+	Time       json.Number  `json:"time,omitempty"`
+	Host       string       `json:"host,omitempty"`
+	Source     string       `json:"source,omitempty"`
+	Sourcetype string       `json:"sourcetype,omitempty"`
+	Index      string       `json:"index,omitempty"`
+	Event      common.Event `json:"event"`
+}
+
+// Write adds an event to the current batch. If the batch is full, it is sent.
+// If sending the batch fails, the events of the batch are dropped and a *DroppedError is returned.
+func (w *Writer) Write(event common.Event) error {
+	meta := event.Metadata()
+	e := envelope{
+		Host:       meta.Source,
+		Source:     w.Source,
+		Sourcetype: w.sourcetype(event),
+		Index:      w.Index,
+		Event:      event,
+	}
+	if !meta.Time.IsZero() {
+		e.Time = json.Number(strconv.FormatFloat(float64(meta.Time.UnixMilli())/1000, 'f', 3, 64))
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	w.batch = append(w.batch, data...)
+	w.batch = append(w.batch, '\n')
+	w.events++
+	if w.events < orDefault(w.BatchSize, DefaultBatchSize) {
+		w.mu.Unlock()
+		return nil
+	}
+	batch, events := w.takeBatch()
+	w.mu.Unlock()
+	return w.flush(batch, events)
+}
+
+// Flush sends all buffered events.
+// If sending them fails, they are dropped and a *DroppedError is returned.
+func (w *Writer) Flush() error {
+	w.mu.Lock()
+	batch, events := w.takeBatch()
+	w.mu.Unlock()
+	return w.flush(batch, events)
+}
+
+// Close sends all buffered events. The writer must not be used afterwards.
+func (w *Writer) Close() error {
+	return w.Flush()
+}
+
+func (w *Writer) sourcetype(event common.Event) string {
+	switch event.(type) {
+	case *thorlog.Assessment:
+		return orDefault(w.AssessmentSourcetype, DefaultAssessmentSourcetype)
+	case *thorlog.Message:
+		return orDefault(w.MessageSourcetype, DefaultMessageSourcetype)
+	default:
+		return orDefault(w.Sourcetype, DefaultSourcetype)
+	}
+}
+
+// takeBatch removes the current batch from the writer, so that it can be sent without holding w.mu.
+func (w *Writer) takeBatch() ([]byte, int) {
+	batch, events := w.batch, w.events
+	w.batch = nil
+	w.events = 0
+	return batch, events
+}
+
+// flush sends a batch. The batch is discarded even if it could not be sent,
+// so that a permanently failing batch does not block further events.
+func (w *Writer) flush(batch []byte, events int) error {
+	if events == 0 {
+		return nil
+	}
+	body := batch
+	if !w.DisableCompression {
+		var compressed bytes.Buffer
+		gzipWriter := gzip.NewWriter(&compressed)
+		_, _ = gzipWriter.Write(body)
+		if err := gzipWriter.Close(); err != nil {
+			return &DroppedError{Events: events, Err: err}
+		}
+		body = compressed.Bytes()
+	}
+	w.sendMu.Lock()
+	defer w.sendMu.Unlock()
+	if err := w.send(body); err != nil {
+		return &DroppedError{Events: events, Err: err}
+	}
+	return nil
+}
+
+// DroppedError is returned if a batch could not be sent. The events of the batch are lost.
+type DroppedError struct {
+	// Events is the number of events in the batch.
+	Events int
+	// Err is the error that occurred while sending the batch.
+	Err error
+}
+
+func (e *DroppedError) Error() string {
+	return fmt.Sprintf("dropped %d events: %v", e.Events, e.Err)
+}
+
+func (e *DroppedError) Unwrap() error {
+	return e.Err
+}
+
+// send sends a batch and retries it if sending fails with a retryable error.
+func (w *Writer) send(body []byte) error {
+	maxRetries := orDefault(w.MaxRetries, DefaultMaxRetries)
+	backoff := orDefault(w.RetryBackoff, DefaultRetryBackoff)
+	for attempt := 0; ; attempt++ {
+		err := w.sendOnce(body)
+		if err == nil {
+			return nil
+		}
+		var hecErr *Error
+		if errors.As(err, &hecErr) && !hecErr.Retryable() {
+			return err
+		}
+		if attempt >= maxRetries {
+			return fmt.Errorf("sending batch failed after %d attempts: %w", attempt+1, err)
+		}
+		wait := backoff << attempt
+		if hecErr != nil && hecErr.RetryAfter > 0 {
+			wait = hecErr.RetryAfter
+		}
+		time.Sleep(wait)
+	}
+}
+
+// response is the response of the collector to all requests.
+type response struct {
+	Text  string          `json:"text"`
+	Code  int             `json:"code"`
+	AckID *int64          `json:"ackId"`
+	Acks  map[string]bool `json:"acks"`
+}
+
+// sendOnce sends a batch and, if indexer acknowledgement is used, waits for its acknowledgement.
+func (w *Writer) sendOnce(body []byte) error {
+	header := http.Header{}
+	if !w.DisableCompression {
+		header.Set("Content-Encoding", "gzip")
+	}
+	eventResponse, err := w.post(eventPath, header, body)
+	if err != nil {
+		return err
+	}
+	if w.Channel == "" {
+		return nil
+	}
+	if eventResponse.AckID == nil {
+		return errors.New("collector did not return an acknowledgement ID, indexer acknowledgement may be disabled for the token")
+	}
+	return w.waitForAck(*eventResponse.AckID)
+}
+
+// ErrAckTimeout is returned if a batch was not acknowledged within the acknowledgement timeout.
+var ErrAckTimeout = errors.New("batch was not acknowledged in time")
+
+// waitForAck polls the acknowledgement status of a batch until it is acknowledged.
+func (w *Writer) waitForAck(ackID int64) error {
+	request, err := json.Marshal(map[string][]int64{"acks": {ackID}})
+	if err != nil {
+		return err
+	}
+	deadline := time.Now().Add(orDefault(w.AckTimeout, DefaultAckTimeout))
+	for {
+		ackResponse, err := w.post(ackPath, http.Header{}, request)
+		if err != nil {
+			return err
+		}
+		if ackResponse.Acks[strconv.FormatInt(ackID, 10)] {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return ErrAckTimeout
+		}
+		time.Sleep(orDefault(w.AckPollInterval, DefaultAckPollInterval))
+	}
+}
+
+func (w *Writer) post(path string, header http.Header, body []byte) (*response, error) {
+	request, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(w.URL, "/")+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header = header
+	request.Header.Set("Authorization", "Splunk "+w.Token)
+	request.Header.Set("Content-Type", "application/json")
+	if w.Channel != "" {
+		request.Header.Set("X-Splunk-Request-Channel", w.Channel)
+	}
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	httpResponse, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	var parsed response
+	decodeErr := json.NewDecoder(httpResponse.Body).Decode(&parsed)
+	if httpResponse.StatusCode != http.StatusOK {
+		hecErr := &Error{StatusCode: httpResponse.StatusCode, Code: parsed.Code, Text: parsed.Text}
+		if seconds, err := strconv.Atoi(httpResponse.Header.Get("Retry-After")); err == nil {
+			hecErr.RetryAfter = time.Duration(seconds) * time.Second
+		}
+		return nil, hecErr
+	}
+	if decodeErr != nil {
+		return nil, fmt.Errorf("invalid collector response: %w", decodeErr)
+	}
+	return &parsed, nil
+}
+
+// Error is an error response from the collector.
+type Error struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Code and Text are the HEC status code and description from the response body.
+	Code int
+	Text string
+	// RetryAfter is the time the collector asked to wait before retrying, or 0 if it didn't specify a time.
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("collector returned status %d: %s (code %d)", e.StatusCode, e.Text, e.Code)
+}
+
+// Retryable returns whether the request may succeed if it is sent again,
+// i.e. if the collector is busy, unavailable or failed internally.
+func (e *Error) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+func orDefault[T comparable](value T, defaultValue T) T {
+	var zero T
+	if value == zero {
+		return defaultValue
+	}
+	return value
+}
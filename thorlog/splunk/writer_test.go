@@ -0,0 +1,246 @@
+package splunk
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	"github.com/NextronSystems/jsonlog/thorlog/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The events that the writer receives from a parser.Reader for a scan of a database server.
+// The collector only sees the envelope fields, so the events differ mainly in their metadata.
+const (
+	// dumpScript is an assessment with a sub-second timestamp, which is kept in the HEC time field.
+	dumpScript  = `{"type":"THOR assessment","meta":{"time":"2025-01-14T16:48:09.517Z","level":"Warning","module":"Filescan","scan_id":"S-d4Hn8Rt2Kp","event_id":"e07b5d21c4a9","hostname":"sql-prod-02"},"message":"Suspicious file found","score":65,"subject":{"type":"file","path":"D:\\MSSQL\\Backup\\dump.ps1","exists":"yes","extension":".ps1","size":4096},"reasons":[{"summary":"Keyword IOC matched: Invoke-Sqlcmd -Query 'BACKUP DATABASE'","signature":{"score":65,"kind":"Keyword IOC"}}],"log_version":"v3.0.0"}`
+	scanStarted = `{"type":"THOR message","meta":{"time":"2025-01-14T16:31:52.004Z","level":"Info","module":"Startup","scan_id":"S-d4Hn8Rt2Kp","hostname":"sql-prod-02"},"message":"Scan started","fields":{"version":"11.0.0"},"log_version":"v3.0.0"}`
+	// moduleStarted is written by THOR 10 with --jsonv2, which has no dedicated sourcetype.
+	moduleStarted = `{"time":"2025-01-14T16:32:10Z","hostname":"sql-prod-02","level":"Info","module":"Eventlog","message":"Starting module","scanid":"S-d4Hn8Rt2Kp","log_version":"v2.0.0"}`
+	// licenseWarning has no metadata, so neither time nor host are set in its envelope.
+	licenseWarning = `{"type":"THOR message","message":"License expires in 7 days","log_version":"v3.0.0"}`
+)
+
+func parseEvent(t *testing.T, event string) common.Event {
+	t.Helper()
+	parsed, err := parser.ParseEvent([]byte(event))
+	require.NoError(t, err)
+	return parsed
+}
+
+// collector is a stand-in for a Splunk HTTP Event Collector.
+type collector struct {
+	t *testing.T
+
+	mu sync.Mutex
+	// events contains the received envelopes.
+	events []map[string]any
+	// requests contains the number of received event requests.
+	requests int
+	// failures contains the status codes that are returned for the next event requests.
+	failures []int
+	// ackAfter is the number of ack queries before an ack is reported as indexed.
+	ackAfter int
+	ackPolls int
+	// acks contains the IDs of the pending acks.
+	acks []int64
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if r.Header.Get("Authorization") != "Splunk secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"text":"Invalid token","code":4}`))
+		return
+	}
+	switch r.URL.Path {
+	case eventPath:
+		c.requests++
+		if len(c.failures) > 0 {
+			status := c.failures[0]
+			c.failures = c.failures[1:]
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"text":"Server is busy","code":9}`))
+			return
+		}
+		var body io.Reader = r.Body
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			gzipReader, err := gzip.NewReader(r.Body)
+			require.NoError(c.t, err)
+			body = gzipReader
+		}
+		decoder := json.NewDecoder(body)
+		for decoder.More() {
+			var event map[string]any
+			require.NoError(c.t, decoder.Decode(&event))
+			c.events = append(c.events, event)
+		}
+		if r.Header.Get("X-Splunk-Request-Channel") == "" {
+			_, _ = w.Write([]byte(`{"text":"Success","code":0}`))
+			return
+		}
+		ackID := int64(len(c.acks))
+		c.acks = append(c.acks, ackID)
+		_, _ = w.Write([]byte(`{"text":"Success","code":0,"ackId":` + strconv.FormatInt(ackID, 10) + `}`))
+	case ackPath:
+		var request struct {
+			Acks []int64 `json:"acks"`
+		}
+		require.NoError(c.t, json.NewDecoder(r.Body).Decode(&request))
+		c.ackPolls++
+		acks := map[string]bool{}
+		for _, id := range request.Acks {
+			acks[strconv.FormatInt(id, 10)] = c.ackPolls > c.ackAfter
+		}
+		require.NoError(c.t, json.NewEncoder(w).Encode(map[string]any{"acks": acks}))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newTestWriter(t *testing.T, c *collector) *Writer {
+	c.t = t
+	server := httptest.NewServer(c)
+	t.Cleanup(server.Close)
+	writer := NewWriter(server.URL, "secret")
+	writer.RetryBackoff = time.Millisecond
+	writer.AckPollInterval = time.Millisecond
+	return writer
+}
+
+func TestWriter(t *testing.T) {
+	c := &collector{}
+	writer := newTestWriter(t, c)
+	writer.BatchSize = 2
+	writer.Index = "thor"
+
+	require.NoError(t, writer.Write(parseEvent(t, scanStarted)))
+	assert.Equal(t, 0, c.requests, "events are batched")
+	require.NoError(t, writer.Write(parseEvent(t, moduleStarted)))
+	assert.Equal(t, 1, c.requests, "full batches are sent")
+	require.NoError(t, writer.Write(parseEvent(t, dumpScript)))
+	require.NoError(t, writer.Write(parseEvent(t, licenseWarning)))
+	require.NoError(t, writer.Close())
+	assert.Equal(t, 2, c.requests)
+
+	require.Len(t, c.events, 4)
+	assert.Equal(t, "thor:message", c.events[0]["sourcetype"])
+	assert.Equal(t, "thor", c.events[1]["sourcetype"])
+	assert.Equal(t, 1736873289.517, c.events[2]["time"])
+	assert.Equal(t, "sql-prod-02", c.events[2]["host"])
+	assert.Equal(t, "thor", c.events[2]["index"])
+	assert.Equal(t, "thor:assessment", c.events[2]["sourcetype"])
+	assert.Equal(t, "Suspicious file found", c.events[2]["event"].(map[string]any)["message"])
+	assert.Equal(t, "thor:message", c.events[3]["sourcetype"])
+	assert.NotContains(t, c.events[3], "time")
+	assert.NotContains(t, c.events[3], "host")
+}
+
+func TestWriter_Uncompressed(t *testing.T) {
+	c := &collector{}
+	writer := newTestWriter(t, c)
+	writer.DisableCompression = true
+	writer.MessageSourcetype = "custom"
+	require.NoError(t, writer.Write(parseEvent(t, scanStarted)))
+	require.NoError(t, writer.Flush())
+	require.Len(t, c.events, 1)
+	assert.Equal(t, "custom", c.events[0]["sourcetype"])
+}
+
+func TestWriter_Retry(t *testing.T) {
+	c := &collector{failures: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}}
+	writer := newTestWriter(t, c)
+	require.NoError(t, writer.Write(parseEvent(t, dumpScript)))
+	require.NoError(t, writer.Flush())
+	assert.Equal(t, 3, c.requests)
+	assert.Len(t, c.events, 1)
+
+	c.failures = []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable}
+	writer.MaxRetries = 1
+	require.NoError(t, writer.Write(parseEvent(t, dumpScript)))
+	err := writer.Flush()
+	var hecErr *Error
+	require.ErrorAs(t, err, &hecErr)
+	assert.Equal(t, 9, hecErr.Code)
+	var droppedErr *DroppedError
+	require.ErrorAs(t, err, &droppedErr)
+	assert.Equal(t, 1, droppedErr.Events)
+	assert.Equal(t, 5, c.requests)
+
+	// The failed batch is discarded
+	require.NoError(t, writer.Flush())
+	assert.Equal(t, 5, c.requests)
+}
+
+func TestWriter_PermanentError(t *testing.T) {
+	c := &collector{}
+	writer := newTestWriter(t, c)
+	writer.Token = "wrong"
+	require.NoError(t, writer.Write(parseEvent(t, dumpScript)))
+	err := writer.Flush()
+	var hecErr *Error
+	require.ErrorAs(t, err, &hecErr)
+	assert.Equal(t, http.StatusUnauthorized, hecErr.StatusCode)
+	assert.False(t, hecErr.Retryable())
+}
+
+func TestWriter_Acknowledgement(t *testing.T) {
+	c := &collector{ackAfter: 2}
+	writer := newTestWriter(t, c)
+	writer.Channel = "0aeeac95-ac74-4aa9-b30d-6c4c0ac581ba"
+	require.NoError(t, writer.Write(parseEvent(t, dumpScript)))
+	require.NoError(t, writer.Flush())
+	assert.Equal(t, 3, c.ackPolls)
+	assert.Equal(t, 1, c.requests)
+}
+
+func TestWriter_AcknowledgementTimeout(t *testing.T) {
+	c := &collector{ackAfter: 1000000}
+	writer := newTestWriter(t, c)
+	writer.Channel = "0aeeac95-ac74-4aa9-b30d-6c4c0ac581ba"
+	writer.AckTimeout = 5 * time.Millisecond
+	writer.MaxRetries = 1
+	require.NoError(t, writer.Write(parseEvent(t, dumpScript)))
+	err := writer.Flush()
+	assert.True(t, errors.Is(err, ErrAckTimeout))
+	// Unacknowledged batches are resent
+	assert.Equal(t, 2, c.requests)
+}
+
+func TestWriter_WriteWhileSending(t *testing.T) {
+	c := &collector{ackAfter: 1000000}
+	writer := newTestWriter(t, c)
+	writer.Channel = "0aeeac95-ac74-4aa9-b30d-6c4c0ac581ba"
+	writer.AckTimeout = time.Second
+	writer.MaxRetries = -1
+	writer.BatchSize = 2
+
+	require.NoError(t, writer.Write(parseEvent(t, dumpScript)))
+	sent := make(chan error)
+	go func() { sent <- writer.Write(parseEvent(t, dumpScript)) }()
+	require.Eventually(t, func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return c.ackPolls > 0
+	}, time.Second, time.Millisecond)
+
+	// The batch is still waiting for its acknowledgement, but further events can be written
+	require.NoError(t, writer.Write(parseEvent(t, dumpScript)))
+	select {
+	case <-sent:
+		t.Fatal("batch was sent before the write returned")
+	default:
+	}
+	assert.ErrorIs(t, <-sent, ErrAckTimeout)
+}
@@ -0,0 +1,497 @@
+package stix
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"golang.org/x/exp/slices"
+)
+
+// observation contains the SCOs that were created for an object.
+type observation struct {
+	// primary is the ID of the SCO that represents the object itself, or empty if there is none.
+	primary string
+	// refs contains the IDs of all SCOs that were created for the object.
+	refs []string
+	// patterns contains STIX patterns that match the object, ordered by specificity.
+	patterns []string
+	// c2 contains the addresses of command and control servers that were found in the object.
+	c2 []address
+}
+
+type address struct {
+	objectType string
+	value      string
+}
+
+// assessmentExport contains the state while exporting a single assessment.
+type assessmentExport struct {
+	*Exporter
+	time       string
+	identityID string
+	hostID     string
+}
+
+// Add adds the objects for an assessment to the exporter.
+//
+// The creation times of objects that are created for this assessment are the assessment's time.
+func (e *Exporter) Add(assessment *thorlog.Assessment) {
+	eventTime := assessment.Meta.Time
+	if eventTime.IsZero() {
+		eventTime = time.Unix(0, 0)
+	}
+	export := &assessmentExport{
+		Exporter:   e,
+		time:       timestamp(eventTime),
+		identityID: e.add(e.identity()).ID(),
+	}
+	if assessment.Meta.Source != "" {
+		export.hostID = e.add(export.sdo("identity", objectID("identity", "host", assessment.Meta.Source), Object{
+			"name":           assessment.Meta.Source,
+			"identity_class": "system",
+		})).ID()
+	}
+
+	var subject observation
+	if assessment.Subject != nil {
+		subject = export.observe(assessment.Subject)
+	}
+	objectRefs := append([]string(nil), subject.refs...)
+	for _, contextObject := range assessment.EventContext {
+		if contextObject.Object == nil {
+			continue
+		}
+		context := export.observe(contextObject.Object)
+		objectRefs = append(objectRefs, context.refs...)
+		if subject.primary == "" || context.primary == "" {
+			continue
+		}
+		for _, relation := range contextObject.Relations {
+			export.relationship(subject.primary, context.primary, relation)
+		}
+	}
+	if len(objectRefs) == 0 {
+		return
+	}
+
+	observedDataID := objectID("observed-data", assessment.Meta.Source, export.time, assessment.Meta.GenID, subject.primary)
+	e.add(export.sdo("observed-data", observedDataID, Object{
+		"first_observed":  export.time,
+		"last_observed":   export.time,
+		"number_observed": 1,
+		"object_refs":     unique(objectRefs),
+	}))
+
+	if len(subject.patterns) > 0 {
+		for _, reason := range assessment.Reasons {
+			indicatorID := export.signatureIndicator(reason, subject.patterns[0])
+			export.sighting(indicatorID, observedDataID)
+		}
+	}
+	for _, c2 := range subject.c2 {
+		indicatorID := export.c2Indicator(c2)
+		export.sighting(indicatorID, observedDataID)
+	}
+}
+
+// sdo creates a STIX domain or relationship object with the common properties.
+func (a *assessmentExport) sdo(objectType string, id string, properties Object) Object {
+	object := Object{
+		"type":           objectType,
+		"spec_version":   SpecVersion,
+		"id":             id,
+		"created":        a.time,
+		"modified":       a.time,
+		"created_by_ref": a.identityID,
+	}
+	for key, value := range properties {
+		object.set(key, value)
+	}
+	return object
+}
+
+// sco creates a STIX cyber-observable object, adds it and returns its ID.
+func (a *assessmentExport) sco(objectType string, id string, properties Object) string {
+	object := Object{
+		"type":         objectType,
+		"spec_version": SpecVersion,
+		"id":           id,
+	}
+	for key, value := range properties {
+		object.set(key, value)
+	}
+	return a.add(object).ID()
+}
+
+// observe creates the SCOs for an object.
+func (a *assessmentExport) observe(object thorlog.ObservedObject) observation {
+	var o observation
+	switch typedObject := object.(type) {
+	case *thorlog.File:
+		a.observeFile(&o, typedObject)
+		o.primary = o.refs[0]
+		a.observeBeacon(&o, typedObject.BeaconConfig)
+	case *thorlog.Process:
+		o.primary = a.observeProcess(&o, typedObject)
+	case *thorlog.NetworkConnectingThread:
+		if typedObject.Process == nil {
+			break
+		}
+		o.primary = a.observeProcess(&o, typedObject.Process)
+		var connections []string
+		for _, connection := range typedObject.Connections {
+			host, port := splitPort(connection.Server)
+			destination := a.observeAddress(&o, host)
+			if destination == "" {
+				continue
+			}
+			protocols := []string{"tcp"}
+			if connection.Protocol != "" {
+				protocols = append(protocols, strings.ToLower(connection.Protocol))
+			}
+			connectionID := a.observeTraffic(&o, "", destination, 0, port, protocols)
+			connections = append(connections, connectionID)
+		}
+		if len(connections) > 0 {
+			process := a.objects[o.primary]
+			process["opened_connection_refs"] = unique(append(toStrings(process["opened_connection_refs"]), connections...))
+		}
+	case *thorlog.ProcessConnectionObject:
+		source := a.observeAddress(&o, typedObject.Ip)
+		destination := a.observeAddress(&o, typedObject.RemoteIp)
+		protocol := strings.ToLower(typedObject.Protocol)
+		if protocol == "" {
+			protocol = "tcp"
+		}
+		o.primary = a.observeTraffic(&o, source, destination, typedObject.Port, typedObject.RemotePort, []string{protocol})
+		if typedObject.RemoteIp != "" {
+			o.patterns = append(o.patterns, addressPattern(addressOf(typedObject.RemoteIp)))
+		}
+	case *thorlog.DnsCacheEntry:
+		domain := address{"domain-name", strings.ToLower(typedObject.Host)}
+		properties := Object{"value": domain.value}
+		resolved := addressOf(typedObject.IP)
+		if resolved.value != "" {
+			properties["resolves_to_refs"] = []string{a.sco(resolved.objectType, addressID(resolved), Object{"value": resolved.value})}
+		}
+		o.primary = a.sco(domain.objectType, addressID(domain), properties)
+		o.refs = append(o.refs, o.primary)
+		if len(properties) > 1 {
+			o.refs = append(o.refs, properties["resolves_to_refs"].([]string)...)
+		}
+		o.patterns = append(o.patterns, addressPattern(domain))
+	}
+	o.refs = unique(o.refs)
+	return o
+}
+
+func (a *assessmentExport) observeFile(o *observation, file *thorlog.File) string {
+	properties := Object{}
+	contributing := map[string]any{}
+	hashes := map[string]string{}
+	if file.Hashes != nil {
+		for algorithm, hash := range map[string]string{"MD5": file.Hashes.Md5, "SHA-1": file.Hashes.Sha1, "SHA-256": file.Hashes.Sha256} {
+			if hash != "" {
+				hashes[algorithm] = hash
+			}
+		}
+	}
+	if len(hashes) > 0 {
+		properties["hashes"] = hashes
+		contributing["hashes"] = hashes
+	}
+	directory, name := splitPath(file.Path)
+	if name != "" {
+		properties["name"] = name
+		contributing["name"] = name
+	}
+	if directory != "" {
+		directoryID := a.sco("directory", scoID("directory", map[string]any{"path": directory}), Object{"path": directory})
+		properties["parent_directory_ref"] = directoryID
+		contributing["parent_directory_ref"] = directoryID
+		defer func() { o.refs = append(o.refs, directoryID) }()
+	}
+	if file.Size > 0 {
+		properties["size"] = file.Size
+	}
+	id := a.sco("file", scoID("file", contributing), properties)
+	o.refs = append(o.refs, id)
+
+	for _, algorithm := range []string{"SHA-256", "SHA-1", "MD5"} {
+		if hash, ok := hashes[algorithm]; ok {
+			o.patterns = append(o.patterns, "[file:hashes.'"+algorithm+"' = '"+escapePattern(hash)+"']")
+		}
+	}
+	if name != "" {
+		o.patterns = append(o.patterns, "[file:name = '"+escapePattern(name)+"']")
+	}
+	return id
+}
+
+// observeProcess creates the SCOs for a process. Since processes have no ID contributing properties,
+// the ID is derived from the host, the PID and the creation time.
+func (a *assessmentExport) observeProcess(o *observation, process *thorlog.Process) string {
+	properties := Object{"pid": process.Pid}
+	created := ""
+	if !process.Created.IsZero() {
+		created = timestamp(process.Created)
+		properties["created_time"] = created
+	}
+	properties.set("command_line", process.Cmdline)
+	if process.Image != nil {
+		var image observation
+		properties["image_ref"] = a.observeFile(&image, process.Image)
+		o.refs = append(o.refs, image.refs...)
+		o.patterns = append(o.patterns, image.patterns...)
+	}
+	if process.ParentInfo.Pid != 0 {
+		parent := Object{"pid": process.ParentInfo.Pid}
+		parent.set("command_line", process.ParentInfo.CommandLine)
+		if process.ParentInfo.Exe != "" {
+			var image observation
+			parent["image_ref"] = a.observeFile(&image, thorlog.NewFile(process.ParentInfo.Exe))
+			o.refs = append(o.refs, image.refs...)
+		}
+		parentID := a.sco("process", objectID("process", a.hostID, strconv.Itoa(int(process.ParentInfo.Pid)), process.ParentInfo.Exe), parent)
+		properties["parent_ref"] = parentID
+		o.refs = append(o.refs, parentID)
+	}
+	id := a.sco("process", objectID("process", a.hostID, strconv.Itoa(int(process.Pid)), created), properties)
+	o.refs = append([]string{id}, o.refs...)
+	if process.Cmdline != "" {
+		o.patterns = append(o.patterns, "[process:command_line = '"+escapePattern(process.Cmdline)+"']")
+	}
+	a.observeBeacon(o, process.BeaconConfig)
+	return id
+}
+
+// observeBeacon collects the C2 servers from a Cobalt Strike beacon configuration.
+// The C2 setting contains a comma separated list of hosts and URL paths. Hosts may include a port,
+// which is kept as the destination port of a network-traffic SCO.
+func (a *assessmentExport) observeBeacon(o *observation, beacon *thorlog.BeaconConfig) {
+	if beacon == nil {
+		return
+	}
+	for _, entry := range strings.Split(beacon.C2, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || strings.HasPrefix(entry, "/") {
+			continue
+		}
+		host, port := splitPort(entry)
+		destination := a.observeAddress(o, host)
+		if destination == "" {
+			continue
+		}
+		o.c2 = append(o.c2, addressOf(host))
+		if port != 0 {
+			a.observeTraffic(o, "", destination, 0, port, []string{"tcp"})
+		}
+	}
+}
+
+// observeAddress creates the SCO for an IP address or domain name.
+func (a *assessmentExport) observeAddress(o *observation, value string) string {
+	addr := addressOf(value)
+	if addr.value == "" {
+		return ""
+	}
+	id := a.sco(addr.objectType, addressID(addr), Object{"value": addr.value})
+	o.refs = append(o.refs, id)
+	return id
+}
+
+func (a *assessmentExport) observeTraffic(o *observation, source, destination string, sourcePort, destinationPort uint32, protocols []string) string {
+	contributing := map[string]any{"protocols": protocols}
+	if source != "" {
+		contributing["src_ref"] = source
+	}
+	if destination != "" {
+		contributing["dst_ref"] = destination
+	}
+	if sourcePort != 0 {
+		contributing["src_port"] = sourcePort
+	}
+	if destinationPort != 0 {
+		contributing["dst_port"] = destinationPort
+	}
+	id := a.sco("network-traffic", scoID("network-traffic", contributing), contributing)
+	o.refs = append(o.refs, id)
+	return id
+}
+
+// signatureIndicator creates an indicator for a reason's signature that matches the given pattern.
+func (a *assessmentExport) signatureIndicator(reason thorlog.Reason, pattern string) string {
+	name := reason.Rulename
+	if name == "" {
+		name = reason.Summary
+	}
+	indicatorType := "anomalous-activity"
+	if reason.Score >= 80 {
+		indicatorType = "malicious-activity"
+	}
+	var references []Object
+	if reason.RuleId != "" {
+		references = append(references, Object{"source_name": "THOR", "external_id": reason.RuleId})
+	}
+	for _, reference := range reason.Ref {
+		if strings.HasPrefix(reference, "http://") || strings.HasPrefix(reference, "https://") {
+			references = append(references, Object{"source_name": "reference", "url": reference})
+		} else {
+			references = append(references, Object{"source_name": "reference", "description": reference})
+		}
+	}
+	return a.add(a.sdo("indicator", objectID("indicator", reason.RuleId, name, pattern), Object{
+		"name":                name,
+		"description":         reason.LongDescription,
+		"indicator_types":     []string{indicatorType},
+		"pattern":             pattern,
+		"pattern_type":        "stix",
+		"valid_from":          a.time,
+		"labels":              []string(reason.Tags),
+		"external_references": references,
+	})).ID()
+}
+
+// c2Indicator creates an indicator for a Cobalt Strike C2 server.
+func (a *assessmentExport) c2Indicator(c2 address) string {
+	pattern := addressPattern(c2)
+	return a.add(a.sdo("indicator", objectID("indicator", "Cobalt Strike C2", pattern), Object{
+		"name":            "Cobalt Strike C2 server " + c2.value,
+		"indicator_types": []string{"malicious-activity"},
+		"pattern":         pattern,
+		"pattern_type":    "stix",
+		"valid_from":      a.time,
+		"labels":          []string{"cobalt-strike"},
+	})).ID()
+}
+
+// sighting records that an indicator was sighted on the assessment's host.
+// Repeated sightings of the same indicator on the same host are merged; the count is the number of distinct
+// observed data objects, so adding the same assessment again does not increase it.
+func (a *assessmentExport) sighting(indicatorID string, observedDataID string) {
+	id := objectID("sighting", indicatorID, a.hostID)
+	if existing, ok := a.objects[id]; ok {
+		observedDataRefs := toStrings(existing["observed_data_refs"])
+		if slices.Contains(observedDataRefs, observedDataID) {
+			return
+		}
+		existing["count"] = existing["count"].(int) + 1
+		if a.time < existing["first_seen"].(string) {
+			existing["first_seen"] = a.time
+		}
+		if a.time > existing["last_seen"].(string) {
+			existing["last_seen"] = a.time
+			existing["modified"] = a.time
+		}
+		existing["observed_data_refs"] = append(observedDataRefs, observedDataID)
+		return
+	}
+	properties := Object{
+		"sighting_of_ref":    indicatorID,
+		"observed_data_refs": []string{observedDataID},
+		"first_seen":         a.time,
+		"last_seen":          a.time,
+		"count":              1,
+	}
+	if a.hostID != "" {
+		properties["where_sighted_refs"] = []string{a.hostID}
+	}
+	a.add(a.sdo("sighting", id, properties))
+}
+
+// relationship creates a relationship between the SCOs of the subject and a context object.
+func (a *assessmentExport) relationship(source, target string, relation thorlog.Relation) {
+	relationshipType := relationshipTypes[relation.Type]
+	if relationshipType == "" {
+		relationshipType = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(relation.Type)), " ", "-")
+	}
+	if relationshipType == "" {
+		relationshipType = "related-to"
+	}
+	a.add(a.sdo("relationship", objectID("relationship", relationshipType, source, target, relation.Name), Object{
+		"relationship_type": relationshipType,
+		"source_ref":        source,
+		"target_ref":        target,
+		"description":       relation.Name,
+	}))
+}
+
+// relationshipTypes maps THOR relation types to STIX relationship types.
+var relationshipTypes = map[string]string{
+	"derives from": "derived-from",
+	"related to":   "related-to",
+}
+
+// addressOf returns the SCO type and value for an IP address or domain name.
+func addressOf(value string) address {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return address{}
+	}
+	if ip := net.ParseIP(value); ip != nil {
+		if ip.To4() != nil {
+			return address{"ipv4-addr", ip.String()}
+		}
+		return address{"ipv6-addr", ip.String()}
+	}
+	return address{"domain-name", strings.ToLower(value)}
+}
+
+// splitPort splits the port from an address like "evil.example.com:443" or "[2001:db8::1]:443".
+// Addresses without a valid port are returned unchanged, with port 0.
+func splitPort(value string) (string, uint32) {
+	host, port, err := net.SplitHostPort(value)
+	if err != nil {
+		return value, 0
+	}
+	number, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return value, 0
+	}
+	return host, uint32(number)
+}
+
+func addressID(addr address) string {
+	return scoID(addr.objectType, map[string]any{"value": addr.value})
+}
+
+func addressPattern(addr address) string {
+	return "[" + addr.objectType + ":value = '" + escapePattern(addr.value) + "']"
+}
+
+// escapePattern escapes a string for use in a STIX pattern.
+func escapePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value)
+}
+
+// splitPath splits a Windows or Unix path into the directory and the file name.
+func splitPath(path string) (string, string) {
+	separator := strings.LastIndexAny(path, `/\`)
+	if separator < 0 {
+		return "", path
+	}
+	directory := path[:separator]
+	if directory == "" {
+		directory = path[:separator+1]
+	}
+	return directory, path[separator+1:]
+}
+
+// unique removes duplicates from a list while keeping the order.
+func unique(values []string) []string {
+	var result []string
+	for _, value := range values {
+		if value != "" && !slices.Contains(result, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+func toStrings(value any) []string {
+	values, _ := value.([]string)
+	return values
+}
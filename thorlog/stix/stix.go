@@ -0,0 +1,169 @@
+// Package stix exports THOR assessments as STIX 2.1 bundles.
+//
+// Subjects and context objects become STIX Cyber-observable Objects (SCOs), e.g. files, processes and network traffic.
+// Reasons become indicators that are sighted on the scanned host, and context relations become relationships.
+// Further intelligence, like Cobalt Strike C2 servers, DNS cache entries and beacon connections, is exported as
+// observables and indicators as well.
+//
+// All IDs are deterministic: SCO IDs are derived from their ID contributing properties as defined by STIX,
+// and the IDs of other objects are derived from the values that identify them. Exporting the same events
+// again therefore results in the same objects, which makes it possible to merge exports.
+package stix
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SpecVersion is the STIX version of the exported objects.
+const SpecVersion = "2.1"
+
+var (
+	// scoNamespace is the namespace for SCO IDs defined by STIX.
+	scoNamespace = uuid.MustParse("00abedb4-aa42-466c-9c01-fed23315a9b7")
+	// thorNamespace is the namespace for IDs of other objects created by this package.
+	thorNamespace = uuid.MustParse("8e4c2a0a-5b7d-4f4e-9a3c-1d6f0e2b7c59")
+)
+
+// Object is a STIX object.
+type Object map[string]any
+
+// ID returns the ID of the object.
+func (o Object) ID() string {
+	id, _ := o["id"].(string)
+	return id
+}
+
+// set sets key to value, unless value is empty.
+func (o Object) set(key string, value any) {
+	switch v := value.(type) {
+	case nil:
+		return
+	case string:
+		if v == "" {
+			return
+		}
+	case []string:
+		if len(v) == 0 {
+			return
+		}
+	case []Object:
+		if len(v) == 0 {
+			return
+		}
+	case map[string]string:
+		if len(v) == 0 {
+			return
+		}
+	}
+	o[key] = value
+}
+
+// Bundle is a STIX bundle.
+type Bundle struct {
+	Type    string   `json:"type"`
+	ID      string   `json:"id"`
+	Objects []Object `json:"objects"`
+}
+
+// scoID returns the ID of an SCO of the given type with the given ID contributing properties.
+func scoID(objectType string, contributingProperties map[string]any) string {
+	return objectType + "--" + uuid.NewSHA1(scoNamespace, canonicalJSON(contributingProperties)).String()
+}
+
+// objectID returns a deterministic ID for an object of the given type that is identified by the given values.
+func objectID(objectType string, values ...string) string {
+	return objectType + "--" + uuid.NewSHA1(thorNamespace, []byte(objectType+"\x00"+strings.Join(values, "\x00"))).String()
+}
+
+// canonicalJSON serializes value with sorted keys and without HTML escaping, as required for SCO IDs.
+func canonicalJSON(value any) []byte {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(value)
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n"))
+}
+
+// timestamp formats t as a STIX timestamp.
+func timestamp(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// Exporter collects the STIX objects for a stream of assessments.
+//
+// Objects with the same ID are only exported once. Sightings of the same indicator on the same host
+// are merged into a single sighting with a count.
+type Exporter struct {
+	// Identity is the identity that created the exported objects. If it is nil, an identity for THOR is used.
+	Identity Object
+
+	objects map[string]Object
+	order   []string
+}
+
+// NewExporter creates a new, empty exporter.
+func NewExporter() *Exporter {
+	return &Exporter{
+		objects: map[string]Object{},
+	}
+}
+
+func (e *Exporter) identity() Object {
+	if e.Identity != nil {
+		return e.Identity
+	}
+	return Object{
+		"type":           "identity",
+		"spec_version":   SpecVersion,
+		"id":             objectID("identity", "Nextron Systems", "THOR"),
+		"created":        timestamp(time.Unix(0, 0)),
+		"modified":       timestamp(time.Unix(0, 0)),
+		"name":           "THOR",
+		"description":    "THOR APT Forensic Scanner by Nextron Systems",
+		"identity_class": "system",
+	}
+}
+
+// add adds an object, unless an object with the same ID was already added. It returns the object that is stored.
+func (e *Exporter) add(object Object) Object {
+	id := object.ID()
+	if existing, ok := e.objects[id]; ok {
+		return existing
+	}
+	e.objects[id] = object
+	e.order = append(e.order, id)
+	return object
+}
+
+// Bundle returns a bundle with all objects that were collected so far, in the order they were first added.
+// The bundle ID is derived from the IDs of the contained objects.
+func (e *Exporter) Bundle() Bundle {
+	ids := append([]string(nil), e.order...)
+	sort.Strings(ids)
+	bundle := Bundle{
+		Type:    "bundle",
+		ID:      objectID("bundle", ids...),
+		Objects: []Object{},
+	}
+	for _, id := range e.order {
+		bundle.Objects = append(bundle.Objects, e.objects[id])
+	}
+	return bundle
+}
+
+// WriteTo writes the bundle as indented JSON to w.
+func (e *Exporter) WriteTo(w io.Writer) (int64, error) {
+	data, err := json.MarshalIndent(e.Bundle(), "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(append(data, '\n'))
+	return int64(n), err
+}
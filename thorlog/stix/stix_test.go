@@ -0,0 +1,275 @@
+package stix
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/NextronSystems/jsonlog/thorlog/parser"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// loaderFile is an assessment of a Cobalt Strike loader that a YARA rule with references detected.
+const loaderFile = `{"type":"THOR assessment","meta":{"time":"2024-07-19T14:03:27Z","level":"Alert","module":"Filescan","scan_id":"S-Vm3sK8dW1q","event_id":"f3a9c1d7e5b2","hostname":"fs-02"},"message":"Malicious file found","score":90,"subject":{"type":"file","path":"C:\\Users\\Public\\Music\\update.exe","size":307200,"hashes":{"md5":"2b7e4f9a1c3d5e7f9b0a2c4d6e8f0a1b","sha256":"c7d2e9f4a1b6c3d8e5f0a7b2c9d4e1f6a3b8c5d0e7f2a9b4c1d6e3f8a5b0c7d2"}},"reasons":[{"summary":"YARA rule HKTL_CobaltStrike_Loader","signature":{"score":90,"kind":"YARA Rule","rule_name":"HKTL_CobaltStrike_Loader","id":"7c4e2a90-1b3d-4f6e-8a5c-2d9b0e7f1a34","description":"Detects a Cobalt Strike shellcode loader","tags":["T1055"],"reference":["https://attack.mitre.org/software/S0154/","incident report IR-2024-031"]}}],"log_version":"v3.0.0"}`
+
+// loaderProcess is an assessment of the process that runs the loader.
+const loaderProcess = `{"type":"THOR assessment","meta":{"time":"2024-07-19T14:05:02Z","level":"Alert","module":"ProcessCheck","scan_id":"S-Vm3sK8dW1q","event_id":"0d8b6f2c4a1e","hostname":"fs-02"},"message":"Malicious process found","score":85,"subject":{"type":"process","pid":5128,"name":"update.exe","command":"C:\\Users\\Public\\Music\\update.exe /silent","image":{"type":"file","path":"C:\\Users\\Public\\Music\\update.exe","hashes":{"sha256":"c7d2e9f4a1b6c3d8e5f0a7b2c9d4e1f6a3b8c5d0e7f2a9b4c1d6e3f8a5b0c7d2"}},"parent_info":{"pid":2964,"exe":"C:\\Windows\\System32\\wbem\\WmiPrvSE.exe"},"created":"2024-07-19T13:58:44Z"},"reasons":[{"summary":"Process image matches YARA rule HKTL_CobaltStrike_Loader","signature":{"score":85,"kind":"YARA Rule","rule_name":"HKTL_CobaltStrike_Loader"}}],"log_version":"v3.0.0"}`
+
+func parseAssessment(t *testing.T, event string) *thorlog.Assessment {
+	t.Helper()
+	parsed, err := parser.ParseEvent([]byte(event))
+	require.NoError(t, err)
+	require.IsType(t, &thorlog.Assessment{}, parsed)
+	return parsed.(*thorlog.Assessment)
+}
+
+func objectsOfType(bundle Bundle, objectType string) []Object {
+	var objects []Object
+	for _, object := range bundle.Objects {
+		if object["type"] == objectType {
+			objects = append(objects, object)
+		}
+	}
+	return objects
+}
+
+func TestExporter_FileSCO(t *testing.T) {
+	exporter := NewExporter()
+	exporter.Add(parseAssessment(t, loaderFile))
+	bundle := exporter.Bundle()
+
+	directories := objectsOfType(bundle, "directory")
+	require.Len(t, directories, 1)
+	assert.Equal(t, `C:\Users\Public\Music`, directories[0]["path"])
+
+	files := objectsOfType(bundle, "file")
+	require.Len(t, files, 1)
+	assert.Equal(t, Object{
+		"type":         "file",
+		"spec_version": "2.1",
+		"id":           files[0].ID(),
+		"name":         "update.exe",
+		"size":         uint64(307200),
+		"hashes": map[string]string{
+			"MD5":     "2b7e4f9a1c3d5e7f9b0a2c4d6e8f0a1b",
+			"SHA-256": "c7d2e9f4a1b6c3d8e5f0a7b2c9d4e1f6a3b8c5d0e7f2a9b4c1d6e3f8a5b0c7d2",
+		},
+		"parent_directory_ref": directories[0].ID(),
+	}, files[0])
+
+	// SCO IDs are UUIDv5 values over the canonical JSON of the ID contributing properties
+	assert.Equal(t, "file--7af1312c-4402-5d2f-b169-b118d73b85c4", scoID("file", map[string]any{"name": "foo.exe"}))
+	assert.Equal(t, "ipv4-addr--28bb3599-77cd-5a82-a950-b5bc3caf07c4", addressID(addressOf("198.51.100.3")))
+}
+
+func TestExporter_Indicator(t *testing.T) {
+	exporter := NewExporter()
+	exporter.Add(parseAssessment(t, loaderFile))
+	bundle := exporter.Bundle()
+
+	indicators := objectsOfType(bundle, "indicator")
+	require.Len(t, indicators, 1)
+	indicator := indicators[0]
+	assert.Equal(t, "HKTL_CobaltStrike_Loader", indicator["name"])
+	assert.Equal(t, "Detects a Cobalt Strike shellcode loader", indicator["description"])
+	assert.Equal(t, "[file:hashes.'SHA-256' = 'c7d2e9f4a1b6c3d8e5f0a7b2c9d4e1f6a3b8c5d0e7f2a9b4c1d6e3f8a5b0c7d2']", indicator["pattern"])
+	assert.Equal(t, []string{"malicious-activity"}, indicator["indicator_types"])
+	assert.Equal(t, "2024-07-19T14:03:27.000Z", indicator["valid_from"])
+	assert.Equal(t, []string{"T1055"}, indicator["labels"])
+	assert.Equal(t, []Object{
+		{"source_name": "THOR", "external_id": "7c4e2a90-1b3d-4f6e-8a5c-2d9b0e7f1a34"},
+		{"source_name": "reference", "url": "https://attack.mitre.org/software/S0154/"},
+		{"source_name": "reference", "description": "incident report IR-2024-031"},
+	}, indicator["external_references"])
+
+	identities := objectsOfType(bundle, "identity")
+	require.Len(t, identities, 2)
+	assert.Equal(t, "THOR", identities[0]["name"])
+	assert.Equal(t, "fs-02", identities[1]["name"])
+	assert.Equal(t, identities[0].ID(), indicator["created_by_ref"])
+
+	observedData := objectsOfType(bundle, "observed-data")
+	require.Len(t, observedData, 1)
+	assert.Len(t, observedData[0]["object_refs"], 2)
+
+	sightings := objectsOfType(bundle, "sighting")
+	require.Len(t, sightings, 1)
+	assert.Equal(t, indicator.ID(), sightings[0]["sighting_of_ref"])
+	assert.Equal(t, []string{identities[1].ID()}, sightings[0]["where_sighted_refs"])
+	assert.Equal(t, []string{observedData[0].ID()}, sightings[0]["observed_data_refs"])
+}
+
+func TestExporter_Deterministic(t *testing.T) {
+	export := func() []byte {
+		exporter := NewExporter()
+		exporter.Add(parseAssessment(t, loaderFile))
+		exporter.Add(parseAssessment(t, loaderProcess))
+		var buffer bytes.Buffer
+		_, err := exporter.WriteTo(&buffer)
+		require.NoError(t, err)
+		return buffer.Bytes()
+	}
+	first := export()
+	assert.Equal(t, first, export())
+
+	var bundle map[string]any
+	require.NoError(t, json.Unmarshal(first, &bundle))
+	assert.Equal(t, "bundle", bundle["type"])
+}
+
+func TestExporter_MergesSightings(t *testing.T) {
+	exporter := NewExporter()
+	for _, rescan := range []struct {
+		eventID string
+		time    time.Time
+	}{
+		{"f3a9c1d7e5b2", time.Date(2024, 7, 19, 14, 3, 27, 0, time.UTC)},
+		{"8e1c5a3f7b90", time.Date(2024, 7, 20, 9, 12, 4, 0, time.UTC)},
+		{"4a7d0c2e6f18", time.Date(2024, 7, 18, 22, 47, 51, 0, time.UTC)},
+	} {
+		assessment := parseAssessment(t, loaderFile)
+		assessment.Meta.GenID = rescan.eventID
+		assessment.Meta.Time = rescan.time
+		exporter.Add(assessment)
+	}
+	bundle := exporter.Bundle()
+
+	assert.Len(t, objectsOfType(bundle, "file"), 1)
+	assert.Len(t, objectsOfType(bundle, "indicator"), 1)
+	assert.Len(t, objectsOfType(bundle, "observed-data"), 3)
+	sightings := objectsOfType(bundle, "sighting")
+	require.Len(t, sightings, 1)
+	assert.Equal(t, 3, sightings[0]["count"])
+	assert.Equal(t, "2024-07-18T22:47:51.000Z", sightings[0]["first_seen"])
+	assert.Equal(t, "2024-07-20T09:12:04.000Z", sightings[0]["last_seen"])
+	assert.Len(t, sightings[0]["observed_data_refs"], 3)
+}
+
+func TestExporter_SameAssessmentTwice(t *testing.T) {
+	exporter := NewExporter()
+	exporter.Add(parseAssessment(t, loaderFile))
+	exporter.Add(parseAssessment(t, loaderFile))
+	bundle := exporter.Bundle()
+
+	observedData := objectsOfType(bundle, "observed-data")
+	require.Len(t, observedData, 1)
+	sightings := objectsOfType(bundle, "sighting")
+	require.Len(t, sightings, 1)
+	assert.Equal(t, 1, sightings[0]["count"])
+	assert.Equal(t, []string{observedData[0].ID()}, sightings[0]["observed_data_refs"])
+}
+
+func TestExporter_Relationships(t *testing.T) {
+	exporter := NewExporter()
+	exporter.Add(parseAssessment(t, `{"type":"THOR assessment","meta":{"time":"2024-07-19T14:06:40Z","level":"Alert","module":"Filescan","scan_id":"S-Vm3sK8dW1q","event_id":"b5e2d8a0c6f4","hostname":"fs-02"},"message":"Malicious file found","score":80,"subject":{"type":"file","path":"C:\\Users\\Public\\Music\\beacon.bin","hashes":{"sha256":"e4b1c8f5a2d9e6b3c0f7a4d1e8b5c2f9a6d3e0b7c4f1a8d5e2b9c6f3a0d7e4b1"}},"reasons":[{"summary":"Encrypted Cobalt Strike payload","signature":{"score":80,"kind":"YARA Rule","rule_name":"HKTL_CobaltStrike_Payload_Encrypted"}}],"context":[{"object":{"type":"process","pid":5128,"name":"update.exe","parent_info":{"pid":2964,"exe":"C:\\Windows\\System32\\wbem\\WmiPrvSE.exe"},"created":"2024-07-19T13:58:44Z"},"relations":[{"relation_type":"derives from","relation_name":"image"}]},{"object":{"type":"file","path":"C:\\Users\\Public\\Music\\update.exe"},"relations":[{"relation_type":"related to"},{"relation_type":"Dropped By"}]}],"log_version":"v3.0.0"}`))
+	bundle := exporter.Bundle()
+
+	processes := objectsOfType(bundle, "process")
+	require.Len(t, processes, 2)
+	process := processes[1]
+	assert.Equal(t, int32(5128), process["pid"])
+	assert.Equal(t, "2024-07-19T13:58:44.000Z", process["created_time"])
+	assert.Equal(t, processes[0].ID(), process["parent_ref"])
+
+	relationships := objectsOfType(bundle, "relationship")
+	require.Len(t, relationships, 3)
+	subject := objectsOfType(bundle, "file")[0].ID()
+	var types []string
+	for _, relationship := range relationships {
+		assert.Equal(t, subject, relationship["source_ref"])
+		types = append(types, relationship["relationship_type"].(string))
+	}
+	assert.Equal(t, []string{"derived-from", "related-to", "dropped-by"}, types)
+	assert.Equal(t, process.ID(), relationships[0]["target_ref"])
+	assert.Equal(t, "image", relationships[0]["description"])
+}
+
+func TestExporter_NetworkObservables(t *testing.T) {
+	t.Run("beacon config", func(t *testing.T) {
+		exporter := NewExporter()
+		exporter.Add(parseAssessment(t, `{"type":"THOR assessment","meta":{"time":"2024-07-19T14:03:27Z","level":"Alert","module":"Filescan","scan_id":"S-Vm3sK8dW1q","hostname":"fs-02"},"message":"Cobalt Strike beacon found","score":100,"subject":{"type":"file","path":"C:\\Users\\Public\\Music\\beacon.dll","hashes":{"sha256":"1f8a5d2c9e6b3f0a7d4c1e8b5a2f9c6d3e0b7a4f1c8d5e2b9a6f3c0d7e4b1a8f"},"beacon_config":{"beacon_type":"HTTPS","c2":"cdn.azureedge-update.example,/ga.js,198.51.100.44,/submit.php"}},"reasons":[{"summary":"Cobalt Strike beacon configuration","signature":{"score":100,"kind":"YARA Rule","rule_name":"HKTL_CobaltStrike_Beacon_Config"}}],"log_version":"v3.0.0"}`))
+		bundle := exporter.Bundle()
+
+		assert.Equal(t, "cdn.azureedge-update.example", objectsOfType(bundle, "domain-name")[0]["value"])
+		assert.Equal(t, "198.51.100.44", objectsOfType(bundle, "ipv4-addr")[0]["value"])
+		var patterns []string
+		for _, indicator := range objectsOfType(bundle, "indicator") {
+			patterns = append(patterns, indicator["pattern"].(string))
+		}
+		assert.Equal(t, []string{
+			"[file:hashes.'SHA-256' = '1f8a5d2c9e6b3f0a7d4c1e8b5a2f9c6d3e0b7a4f1c8d5e2b9a6f3c0d7e4b1a8f']",
+			"[domain-name:value = 'cdn.azureedge-update.example']",
+			"[ipv4-addr:value = '198.51.100.44']",
+		}, patterns)
+		assert.Len(t, objectsOfType(bundle, "sighting"), 3)
+	})
+	t.Run("beacon config with ports", func(t *testing.T) {
+		exporter := NewExporter()
+		exporter.Add(parseAssessment(t, `{"type":"THOR assessment","meta":{"time":"2024-07-19T14:03:27Z","level":"Alert","module":"Filescan","scan_id":"S-Vm3sK8dW1q","hostname":"fs-02"},"message":"Cobalt Strike beacon found","score":100,"subject":{"type":"file","path":"C:\\Users\\Public\\Music\\beacon.dll","hashes":{"sha256":"1f8a5d2c9e6b3f0a7d4c1e8b5a2f9c6d3e0b7a4f1c8d5e2b9a6f3c0d7e4b1a8f"},"beacon_config":{"beacon_type":"HTTPS","c2":"cdn.azureedge-update.example:443,/ga.js,198.51.100.44:8080,[2001:db8:4::17]:53,/submit.php"}},"reasons":[{"summary":"Cobalt Strike beacon configuration","signature":{"score":100,"kind":"YARA Rule","rule_name":"HKTL_CobaltStrike_Beacon_Config"}}],"log_version":"v3.0.0"}`))
+		bundle := exporter.Bundle()
+
+		domain := objectsOfType(bundle, "domain-name")[0]
+		ipv4 := objectsOfType(bundle, "ipv4-addr")[0]
+		ipv6 := objectsOfType(bundle, "ipv6-addr")[0]
+		assert.Equal(t, "cdn.azureedge-update.example", domain["value"])
+		assert.Equal(t, "198.51.100.44", ipv4["value"])
+		assert.Equal(t, "2001:db8:4::17", ipv6["value"])
+		var ports = map[string]any{}
+		for _, traffic := range objectsOfType(bundle, "network-traffic") {
+			ports[traffic["dst_ref"].(string)] = traffic["dst_port"]
+		}
+		assert.Equal(t, map[string]any{domain.ID(): uint32(443), ipv4.ID(): uint32(8080), ipv6.ID(): uint32(53)}, ports)
+		var patterns []string
+		for _, indicator := range objectsOfType(bundle, "indicator") {
+			patterns = append(patterns, indicator["pattern"].(string))
+		}
+		assert.Equal(t, []string{
+			"[file:hashes.'SHA-256' = '1f8a5d2c9e6b3f0a7d4c1e8b5a2f9c6d3e0b7a4f1c8d5e2b9a6f3c0d7e4b1a8f']",
+			"[domain-name:value = 'cdn.azureedge-update.example']",
+			"[ipv4-addr:value = '198.51.100.44']",
+			"[ipv6-addr:value = '2001:db8:4::17']",
+		}, patterns)
+	})
+	t.Run("dns cache entry", func(t *testing.T) {
+		exporter := NewExporter()
+		exporter.Add(parseAssessment(t, `{"type":"THOR assessment","meta":{"time":"2024-07-19T14:01:15Z","level":"Warning","module":"DNSCache","scan_id":"S-Vm3sK8dW1q","hostname":"fs-02"},"message":"Suspicious DNS cache entry found","score":70,"subject":{"type":"DNS cache entry","host":"CDN.AzureEdge-Update.example","ip":"2001:db8:4::17"},"reasons":[{"summary":"Domain IOC match","signature":{"score":70,"kind":"Domain IOC"}}],"log_version":"v3.0.0"}`))
+		bundle := exporter.Bundle()
+
+		domain := objectsOfType(bundle, "domain-name")[0]
+		address := objectsOfType(bundle, "ipv6-addr")[0]
+		assert.Equal(t, "cdn.azureedge-update.example", domain["value"])
+		assert.Equal(t, []string{address.ID()}, domain["resolves_to_refs"])
+		assert.Equal(t, "[domain-name:value = 'cdn.azureedge-update.example']", objectsOfType(bundle, "indicator")[0]["pattern"])
+	})
+	t.Run("process connection", func(t *testing.T) {
+		exporter := NewExporter()
+		exporter.Add(parseAssessment(t, `{"type":"THOR assessment","meta":{"time":"2024-07-19T14:04:10Z","level":"Warning","module":"ProcessConnections","scan_id":"S-Vm3sK8dW1q","hostname":"fs-02"},"message":"Connection to C2 server found","score":75,"subject":{"type":"process connection","status":"ESTABLISHED","ip":"10.1.8.20","port":50712,"remote_ip":"198.51.100.44","remote_port":8080,"protocol":"TCP"},"reasons":[{"summary":"IP IOC match","signature":{"score":75,"kind":"Domain IOC"}}],"log_version":"v3.0.0"}`))
+		bundle := exporter.Bundle()
+
+		addresses := objectsOfType(bundle, "ipv4-addr")
+		require.Len(t, addresses, 2)
+		traffic := objectsOfType(bundle, "network-traffic")[0]
+		assert.Equal(t, addresses[0].ID(), traffic["src_ref"])
+		assert.Equal(t, addresses[1].ID(), traffic["dst_ref"])
+		assert.Equal(t, uint32(8080), traffic["dst_port"])
+		assert.Equal(t, []string{"tcp"}, traffic["protocols"])
+		assert.Equal(t, "[ipv4-addr:value = '198.51.100.44']", objectsOfType(bundle, "indicator")[0]["pattern"])
+	})
+	t.Run("network connecting thread", func(t *testing.T) {
+		exporter := NewExporter()
+		exporter.Add(parseAssessment(t, `{"type":"THOR assessment","meta":{"time":"2024-07-19T14:05:30Z","level":"Alert","module":"BeaconCheck","scan_id":"S-Vm3sK8dW1q","hostname":"fs-02"},"message":"Beaconing thread found","score":90,"subject":{"type":"network connecting thread","thread_id":5140,"process":{"type":"process","pid":5128,"name":"update.exe","parent_info":{"pid":2964,"exe":"C:\\Windows\\System32\\wbem\\WmiPrvSE.exe"},"created":"2024-07-19T13:58:44Z"},"callback_interval":45000000000,"connections":[{"protocol":"HTTPS","server":"cdn.azureedge-update.example:443"}]},"reasons":[{"summary":"Thread connects in regular intervals","signature":{"score":90,"kind":"Internal Heuristic"}}],"log_version":"v3.0.0"}`))
+		bundle := exporter.Bundle()
+
+		traffic := objectsOfType(bundle, "network-traffic")[0]
+		assert.Equal(t, objectsOfType(bundle, "domain-name")[0].ID(), traffic["dst_ref"])
+		assert.Equal(t, []string{"tcp", "https"}, traffic["protocols"])
+		assert.Equal(t, []string{traffic.ID()}, objectsOfType(bundle, "process")[1]["opened_connection_refs"])
+	})
+}
+
+func TestEscapePattern(t *testing.T) {
+	assert.Equal(t, `C:\\Users\\O\'Brien`, escapePattern(`C:\Users\O'Brien`))
+}
@@ -0,0 +1,285 @@
+// Package syslog formats THOR events as syslog messages and sends them to syslog collectors.
+//
+// Messages are formatted according to RFC 5424 or the legacy BSD format described in RFC 3164.
+// In both formats, the message text is the body of the THOR text log line for the event.
+package syslog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	thorlogv1 "github.com/NextronSystems/jsonlog/thorlog/v1"
+	thorlogv2 "github.com/NextronSystems/jsonlog/thorlog/v2"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+// Format is a syslog message format.
+type Format int
+
+const (
+	// RFC5424 is the syslog protocol format described in RFC 5424.
+	RFC5424 Format = iota
+	// RFC3164 is the legacy BSD syslog format described in RFC 3164.
+	RFC3164
+)
+
+// Facility is a syslog facility.
+type Facility int
+
+const (
+	FacilityKern Facility = iota
+	FacilityUser
+	FacilityMail
+	FacilityDaemon
+	FacilityAuth
+	FacilitySyslog
+	FacilityLpr
+	FacilityNews
+	FacilityUucp
+	FacilityCron
+	FacilityAuthPriv
+	FacilityFtp
+	FacilityNtp
+	FacilityAudit
+	FacilityLogAlert
+	FacilityClock
+	FacilityLocal0
+	FacilityLocal1
+	FacilityLocal2
+	FacilityLocal3
+	FacilityLocal4
+	FacilityLocal5
+	FacilityLocal6
+	FacilityLocal7
+)
+
+// Severity is a syslog severity.
+type Severity int
+
+const (
+	SeverityEmergency Severity = iota
+	SeverityAlert
+	SeverityCritical
+	SeverityError
+	SeverityWarning
+	SeverityNotice
+	SeverityInfo
+	SeverityDebug
+)
+
+// SeverityForLevel returns the syslog severity for a THOR log level.
+// Unknown levels are mapped to SeverityInfo.
+func SeverityForLevel(level common.LogLevel) Severity {
+	switch level {
+	case common.Alert:
+		return SeverityAlert
+	case common.Error:
+		return SeverityError
+	case common.Warning:
+		return SeverityWarning
+	case common.Notice:
+		return SeverityNotice
+	case common.Debug:
+		return SeverityDebug
+	default:
+		return SeverityInfo
+	}
+}
+
+// DefaultStructuredDataID is the SD-ID that is used for the event metadata if no other ID is configured.
+// 32473 is the private enterprise number reserved for documentation (RFC 5612); collectors that require
+// registered IDs should be configured with an ID that uses the operator's own enterprise number.
+const DefaultStructuredDataID = "thor@32473"
+
+// Formatter formats events as syslog messages.
+//
+// The zero value formats RFC 5424 messages with facility user and the app name THOR.
+type Formatter struct {
+	// Format is the message format.
+	Format Format
+	// Facility is the facility of all messages. Since the kernel facility can't be used by applications, zero selects FacilityUser.
+	Facility Facility
+	// AppName is the APP-NAME (RFC 5424) or TAG (RFC 3164) of the messages. If it is empty, "THOR" is used.
+	AppName string
+	// ProcID is the PROCID of the messages. For RFC 3164, it is appended to the tag in brackets.
+	// If it is empty, it is omitted for RFC 3164 and replaced with the nil value "-" for RFC 5424.
+	ProcID string
+	// Hostname overrides the hostname of the events if it is not empty.
+	// Otherwise, the hostname is taken from the event's metadata.
+	Hostname string
+	// StructuredDataID is the SD-ID of the structured data element that contains the event metadata (RFC 5424 only).
+	// If it is empty, DefaultStructuredDataID is used.
+	StructuredDataID string
+	// Location is the time zone that timestamps are converted to. If it is nil, the event's time zone is kept.
+	Location *time.Location
+	// Body is used to format the message text.
+	Body jsonlog.TextlogFormatter
+}
+
+// FormatEvent formats an event as a syslog message, without any framing.
+//
+// For RFC 5424, the scan ID, event ID, module and score (if the event has one) are included as structured data.
+// RFC 3164 has no structured data, but the text log body already contains these fields.
+func (f Formatter) FormatEvent(event common.Event) string {
+	metadata := event.Metadata()
+	var builder strings.Builder
+	builder.WriteString("<")
+	builder.WriteString(strconv.Itoa(int(f.facility())*8 + int(SeverityForLevel(metadata.Lvl))))
+	builder.WriteString(">")
+	if f.Format == RFC3164 {
+		f.writeRFC3164Header(&builder, metadata)
+	} else {
+		f.writeRFC5424Header(&builder, event)
+	}
+	if body := f.Body.Format(event); len(body) > 0 {
+		builder.WriteString(" ")
+		builder.WriteString(body.String())
+	}
+	return builder.String()
+}
+
+func (f Formatter) facility() Facility {
+	if f.Facility == FacilityKern {
+		return FacilityUser
+	}
+	return f.Facility
+}
+
+func (f Formatter) appName() string {
+	if f.AppName == "" {
+		return "THOR"
+	}
+	return f.AppName
+}
+
+func (f Formatter) hostname(metadata *common.LogEventMetadata) string {
+	if f.Hostname != "" {
+		return f.Hostname
+	}
+	return metadata.Source
+}
+
+func (f Formatter) timestamp(metadata *common.LogEventMetadata) time.Time {
+	if f.Location != nil {
+		return metadata.Time.In(f.Location)
+	}
+	return metadata.Time
+}
+
+// writeRFC5424Header writes VERSION SP TIMESTAMP SP HOSTNAME SP APP-NAME SP PROCID SP MSGID SP STRUCTURED-DATA.
+func (f Formatter) writeRFC5424Header(builder *strings.Builder, event common.Event) {
+	metadata := event.Metadata()
+	builder.WriteString("1 ")
+	if metadata.Time.IsZero() {
+		builder.WriteString("-")
+	} else {
+		builder.WriteString(f.timestamp(metadata).Format("2006-01-02T15:04:05.999999Z07:00"))
+	}
+	builder.WriteString(" ")
+	builder.WriteString(headerField(f.hostname(metadata), 255))
+	builder.WriteString(" ")
+	builder.WriteString(headerField(f.appName(), 48))
+	builder.WriteString(" ")
+	builder.WriteString(headerField(f.ProcID, 128))
+	builder.WriteString(" - ") // MSGID
+
+	var params []string
+	addParam := func(name string, value string) {
+		if value != "" {
+			params = append(params, name+`="`+sdParamEscaper.Replace(value)+`"`)
+		}
+	}
+	addParam("scan_id", metadata.ScanID)
+	addParam("event_id", metadata.GenID)
+	addParam("module", metadata.Mod)
+	if score, hasScore := eventScore(event); hasScore {
+		addParam("score", strconv.FormatInt(score, 10))
+	}
+	if len(params) == 0 {
+		builder.WriteString("-")
+		return
+	}
+	sdID := f.StructuredDataID
+	if sdID == "" {
+		sdID = DefaultStructuredDataID
+	}
+	builder.WriteString("[")
+	builder.WriteString(sdID)
+	for _, param := range params {
+		builder.WriteString(" ")
+		builder.WriteString(param)
+	}
+	builder.WriteString("]")
+}
+
+// writeRFC3164Header writes TIMESTAMP SP HOSTNAME SP TAG ":".
+func (f Formatter) writeRFC3164Header(builder *strings.Builder, metadata *common.LogEventMetadata) {
+	timestamp := f.timestamp(metadata)
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	builder.WriteString(timestamp.Format(time.Stamp))
+	builder.WriteString(" ")
+	hostname := headerField(f.hostname(metadata), 255)
+	if hostname == "-" {
+		// RFC 3164 has no nil value for the hostname
+		hostname = "localhost"
+	}
+	builder.WriteString(hostname)
+	builder.WriteString(" ")
+	builder.WriteString(headerField(f.appName(), 32))
+	if f.ProcID != "" {
+		builder.WriteString("[")
+		builder.WriteString(headerField(f.ProcID, 128))
+		builder.WriteString("]")
+	}
+	builder.WriteString(":")
+}
+
+// headerField converts value into a header field that consists of at most maxLength printable ASCII characters.
+// Other characters are replaced with underscores. Empty values are replaced with the nil value "-".
+func headerField(value string, maxLength int) string {
+	if value == "" {
+		return "-"
+	}
+	field := []byte(value)
+	for i, c := range field {
+		if c < 33 || c > 126 {
+			field[i] = '_'
+		}
+	}
+	if len(field) > maxLength {
+		field = field[:maxLength]
+	}
+	return string(field)
+}
+
+// sdParamEscaper escapes the characters that must be escaped in structured data parameter values.
+var sdParamEscaper = strings.NewReplacer(`"`, `\"`, `\`, `\\`, `]`, `\]`)
+
+// eventScore returns the score of an event, if it has one.
+func eventScore(event common.Event) (int64, bool) {
+	switch typedEvent := event.(type) {
+	case *thorlog.Assessment:
+		return typedEvent.Score, true
+	case *thorlogv1.Event:
+		for _, field := range typedEvent.Data {
+			if field.Key == "score" {
+				score, err := strconv.ParseInt(field.Value, 10, 64)
+				return score, err == nil
+			}
+		}
+	case *thorlogv2.Event:
+		for _, field := range typedEvent.Data {
+			if field.Key == "score" {
+				score, err := strconv.ParseInt(fmt.Sprint(field.Value), 10, 64)
+				return score, err == nil
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,122 @@
+package syslog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	"github.com/NextronSystems/jsonlog/thorlog/parser"
+	thorlogv1 "github.com/NextronSystems/jsonlog/thorlog/v1"
+	thorlogv2 "github.com/NextronSystems/jsonlog/thorlog/v2"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The events of a scan of a mail gateway. The timestamps have microseconds, which RFC 5424 keeps and RFC 3164 drops.
+const (
+	webshell = `{"type":"THOR assessment","meta":{"time":"2024-06-12T21:04:19.482031Z","level":"Alert","module":"Filescan","scan_id":"S-Vb6yJ0cR3n","event_id":"0d7a9e4c2b61","hostname":"mail-gw-01"},"message":"Malicious file found","score":95,"subject":{"type":"file","path":"/opt/zimbra/jetty/webapps/zimbra/public/.ui.jsp","exists":"yes","extension":".jsp","size":3417},"reasons":[{"summary":"YARA rule WEBSHELL_JSP_Cmd_Exec","signature":{"score":95,"kind":"YARA Rule"}}],"log_version":"v3.0.0"}`
+	// minerProcess is written by THOR 10, which has no event IDs.
+	minerProcess = `{"time":"2024-06-12T21:06:52.730115Z","hostname":"mail-gw-01","level":"Warning","module":"ProcessCheck","message":"Suspicious process found","score":70,"pid":3391,"name":"kworkerds","command":"/tmp/.X25-unix/kworkerds -c /tmp/.X25-unix/config","scanid":"S-Vb6yJ0cR3n","log_version":"v1.0.0"}`
+)
+
+func parseEvent(t *testing.T, event string) common.Event {
+	t.Helper()
+	parsed, err := parser.ParseEvent([]byte(event))
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestFormatter_FormatEvent(t *testing.T) {
+	tests := []struct {
+		name      string
+		formatter Formatter
+		event     string
+		want      string
+	}{
+		{
+			name:  "RFC 5424 assessment",
+			event: webshell,
+			want:  `<9>1 2024-06-12T21:04:19.482031Z mail-gw-01 THOR - - [thor@32473 scan_id="S-Vb6yJ0cR3n" event_id="0d7a9e4c2b61" module="Filescan" score="95"] MODULE: Filescan SCANID: S-Vb6yJ0cR3n UID: 0d7a9e4c2b61 MESSAGE: Malicious file found SCORE: 95 FILE: /opt/zimbra/jetty/webapps/zimbra/public/.ui.jsp EXTENSION: .jsp SIZE: 3417 REASON_1: YARA rule WEBSHELL_JSP_Cmd_Exec SUBSCORE_1: 95 REF_1:  SIGTYPE_1: internal SIGCLASS_1: YARA Rule ID_1:  MATCHED_1: (none)`,
+		},
+		{
+			name:  "RFC 5424 v1 event",
+			event: minerProcess,
+			want:  `<12>1 2024-06-12T21:06:52.730115Z mail-gw-01 THOR - - [thor@32473 scan_id="S-Vb6yJ0cR3n" module="ProcessCheck" score="70"] MODULE: ProcessCheck SCANID: S-Vb6yJ0cR3n MESSAGE: Suspicious process found SCORE: 70 PID: 3391 NAME: kworkerds COMMAND: /tmp/.X25-unix/kworkerds -c /tmp/.X25-unix/config`,
+		},
+		{
+			name:      "RFC 5424 options",
+			formatter: Formatter{Facility: FacilityLocal4, AppName: "thor scanner", ProcID: "1234", Hostname: "collector-name", StructuredDataID: "meta@12345", Location: time.FixedZone("CEST", 2*60*60)},
+			event:     minerProcess,
+			want:      `<164>1 2024-06-12T23:06:52.730115+02:00 collector-name thor_scanner 1234 - [meta@12345 scan_id="S-Vb6yJ0cR3n" module="ProcessCheck" score="70"] MODULE: ProcessCheck SCANID: S-Vb6yJ0cR3n MESSAGE: Suspicious process found SCORE: 70 PID: 3391 NAME: kworkerds COMMAND: /tmp/.X25-unix/kworkerds -c /tmp/.X25-unix/config`,
+		},
+		{
+			name:      "RFC 3164 assessment",
+			formatter: Formatter{Format: RFC3164},
+			event:     webshell,
+			want:      `<9>Jun 12 21:04:19 mail-gw-01 THOR: MODULE: Filescan SCANID: S-Vb6yJ0cR3n UID: 0d7a9e4c2b61 MESSAGE: Malicious file found SCORE: 95 FILE: /opt/zimbra/jetty/webapps/zimbra/public/.ui.jsp EXTENSION: .jsp SIZE: 3417 REASON_1: YARA rule WEBSHELL_JSP_Cmd_Exec SUBSCORE_1: 95 REF_1:  SIGTYPE_1: internal SIGCLASS_1: YARA Rule ID_1:  MATCHED_1: (none)`,
+		},
+		{
+			name:      "RFC 3164 process ID",
+			formatter: Formatter{Format: RFC3164, Facility: FacilityDaemon, ProcID: "1234"},
+			event:     minerProcess,
+			want:      `<28>Jun 12 21:06:52 mail-gw-01 THOR[1234]: MODULE: ProcessCheck SCANID: S-Vb6yJ0cR3n MESSAGE: Suspicious process found SCORE: 70 PID: 3391 NAME: kworkerds COMMAND: /tmp/.X25-unix/kworkerds -c /tmp/.X25-unix/config`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.formatter.FormatEvent(parseEvent(t, tt.event)))
+		})
+	}
+}
+
+func TestFormatter_FormatEvent_Empty(t *testing.T) {
+	event := &thorlogv1.Event{
+		Data: thorlogv1.Fields{{Key: "message", Value: "test"}},
+	}
+	assert.Equal(t, "<14>1 - - THOR - - - MODULE:  MESSAGE: test", Formatter{}.FormatEvent(event))
+}
+
+func TestFormatter_FormatEvent_Escaping(t *testing.T) {
+	event := parseEvent(t, minerProcess).(*thorlogv1.Event)
+	event.LogEventMetadata.Mod = `Module "x" [a\b]`
+	event.LogEventMetadata.Source = "my host\xff"
+	assert.Equal(t, `<12>1 2024-06-12T21:06:52.730115Z my_host_ THOR - - [thor@32473 scan_id="S-Vb6yJ0cR3n" module="Module \"x\" [a\\b\]" score="70"] MODULE: Module "x" [a\b] SCANID: S-Vb6yJ0cR3n MESSAGE: Suspicious process found SCORE: 70 PID: 3391 NAME: kworkerds COMMAND: /tmp/.X25-unix/kworkerds -c /tmp/.X25-unix/config`, Formatter{}.FormatEvent(event))
+}
+
+func TestEventScore(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    common.Event
+		score    int64
+		hasScore bool
+	}{
+		{"v3 assessment", parseEvent(t, webshell), 95, true},
+		{"v3 message", thorlog.NewMessage(thorlog.LogEventMetadata{}, "test"), 0, false},
+		{"v1 event", parseEvent(t, minerProcess), 70, true},
+		{"v1 invalid score", &thorlogv1.Event{Data: thorlogv1.Fields{{Key: "score", Value: "high"}}}, 0, false},
+		{"v2 event", &thorlogv2.Event{Data: thorlogv2.Fields{{Key: "score", Value: float64(75)}}}, 75, true},
+		{"v2 without score", &thorlogv2.Event{}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, hasScore := eventScore(tt.event)
+			assert.Equal(t, tt.hasScore, hasScore)
+			assert.Equal(t, tt.score, score)
+		})
+	}
+}
+
+func TestSeverityForLevel(t *testing.T) {
+	for level, severity := range map[common.LogLevel]Severity{
+		common.Alert:   SeverityAlert,
+		common.Error:   SeverityError,
+		common.Warning: SeverityWarning,
+		common.Notice:  SeverityNotice,
+		common.Info:    SeverityInfo,
+		common.Debug:   SeverityDebug,
+		"Unknown":      SeverityInfo,
+	} {
+		assert.Equal(t, severity, SeverityForLevel(level), level)
+	}
+}
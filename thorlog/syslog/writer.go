@@ -0,0 +1,73 @@
+package syslog
+
+import (
+	"crypto/tls"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+)
+
+// Writer sends events as syslog messages to a collector.
+//
+// On packet oriented connections (e.g. UDP), each message is sent as a single datagram.
+// On stream oriented connections (e.g. TCP or TLS), messages are framed using octet counting
+// as described in RFC 6587 and RFC 5425, i.e. each message is prefixed with its length in bytes and a space.
+//
+// A Writer is safe for concurrent use.
+type Writer struct {
+	// Formatter is used to format the events.
+	Formatter Formatter
+
+	mu     sync.Mutex
+	conn   net.Conn
+	packet bool
+}
+
+// NewWriter creates a new Writer that sends messages over conn.
+func NewWriter(conn net.Conn, formatter Formatter) *Writer {
+	_, packet := conn.(net.PacketConn)
+	return &Writer{
+		Formatter: formatter,
+		conn:      conn,
+		packet:    packet,
+	}
+}
+
+// Dial connects to the syslog collector at address using the given network (e.g. "udp" or "tcp")
+// and returns a Writer that sends messages over the connection.
+func Dial(network, address string, formatter Formatter) (*Writer, error) {
+	conn, err := net.Dial(network, address)
+	if err != nil {
+		return nil, err
+	}
+	return NewWriter(conn, formatter), nil
+}
+
+// DialTLS connects to the syslog collector at address using TLS
+// and returns a Writer that sends messages over the connection.
+func DialTLS(address string, config *tls.Config, formatter Formatter) (*Writer, error) {
+	conn, err := tls.Dial("tcp", address, config)
+	if err != nil {
+		return nil, err
+	}
+	return NewWriter(conn, formatter), nil
+}
+
+// Write sends the event as a single syslog message.
+func (w *Writer) Write(event common.Event) error {
+	message := w.Formatter.FormatEvent(event)
+	if !w.packet {
+		message = strconv.Itoa(len(message)) + " " + message
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_, err := w.conn.Write([]byte(message))
+	return err
+}
+
+// Close closes the underlying connection.
+func (w *Writer) Close() error {
+	return w.conn.Close()
+}
@@ -0,0 +1,146 @@
+package syslog
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEvents(t *testing.T) []common.Event {
+	t.Helper()
+	return []common.Event{parseEvent(t, webshell), parseEvent(t, minerProcess)}
+}
+
+func expectedMessages(t *testing.T, formatter Formatter) []string {
+	var messages []string
+	for _, event := range testEvents(t) {
+		messages = append(messages, formatter.FormatEvent(event))
+	}
+	return messages
+}
+
+// readOctetCounted reads count octet counted messages from r.
+func readOctetCounted(t *testing.T, r io.Reader, count int) []string {
+	reader := bufio.NewReader(r)
+	var messages []string
+	for i := 0; i < count; i++ {
+		lengthText, err := reader.ReadString(' ')
+		require.NoError(t, err)
+		length, err := strconv.Atoi(strings.TrimSuffix(lengthText, " "))
+		require.NoError(t, err)
+		message := make([]byte, length)
+		_, err = io.ReadFull(reader, message)
+		require.NoError(t, err)
+		messages = append(messages, string(message))
+	}
+	return messages
+}
+
+// serveStream accepts a single connection on listener and sends count octet counted messages that were received on it.
+func serveStream(t *testing.T, listener net.Listener, count int) <-chan []string {
+	received := make(chan []string, 1)
+	go func() {
+		defer close(received)
+		conn, err := listener.Accept()
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+		received <- readOctetCounted(t, conn, count)
+	}()
+	return received
+}
+
+func writeEvents(t *testing.T, writer *Writer) {
+	for _, event := range testEvents(t) {
+		require.NoError(t, writer.Write(event))
+	}
+	require.NoError(t, writer.Close())
+}
+
+func TestWriter_UDP(t *testing.T) {
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	formatter := Formatter{Format: RFC3164}
+	writer, err := Dial("udp", listener.LocalAddr().String(), formatter)
+	require.NoError(t, err)
+	writeEvents(t, writer)
+
+	require.NoError(t, listener.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var received []string
+	buffer := make([]byte, 65536)
+	for range testEvents(t) {
+		n, _, err := listener.ReadFrom(buffer)
+		require.NoError(t, err)
+		received = append(received, string(buffer[:n]))
+	}
+	assert.Equal(t, expectedMessages(t, formatter), received)
+}
+
+func TestWriter_TCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	received := serveStream(t, listener, len(testEvents(t)))
+
+	writer, err := Dial("tcp", listener.Addr().String(), Formatter{})
+	require.NoError(t, err)
+	writeEvents(t, writer)
+
+	assert.Equal(t, expectedMessages(t, Formatter{}), <-received)
+}
+
+func TestWriter_TLS(t *testing.T) {
+	certificate, pool := selfSignedCertificate(t)
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{certificate}})
+	require.NoError(t, err)
+	defer listener.Close()
+	received := serveStream(t, listener, len(testEvents(t)))
+
+	writer, err := DialTLS(listener.Addr().String(), &tls.Config{RootCAs: pool, ServerName: "localhost"}, Formatter{})
+	require.NoError(t, err)
+	writeEvents(t, writer)
+
+	assert.Equal(t, expectedMessages(t, Formatter{}), <-received)
+}
+
+func selfSignedCertificate(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	parsed, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	pool := x509.NewCertPool()
+	pool.AddCert(parsed)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, pool
+}
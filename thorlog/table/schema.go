@@ -0,0 +1,160 @@
+package table
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/jsonpointer"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+var (
+	observedObjectType = reflect.TypeOf((*thorlog.ObservedObject)(nil)).Elem()
+	objectType         = reflect.TypeOf((*jsonlog.Object)(nil)).Elem()
+	textMarshalerType  = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	jsonMarshalerType  = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	timeType           = reflect.TypeOf(time.Time{})
+)
+
+// Columns returns the fixed columns for assessments whose subject has the given log object type,
+// in the order of the fields in the Go types. If registry is nil, thorlog.DefaultRegistry is used.
+//
+// Indexed arrays have columns for the first MaxElements elements, followed by a column that counts the further elements.
+// Fields of other log objects, e.g. of context objects, are not known in advance; only their type is included.
+// The values of such objects can still be retrieved using Row.Cell.
+func (f Flattener) Columns(registry *thorlog.Registry, subjectType string) ([]string, error) {
+	if registry == nil {
+		registry = thorlog.DefaultRegistry
+	}
+	subject := registry.Lookup(subjectType)
+	if subject == nil {
+		return nil, fmt.Errorf("unknown log object type %q", subjectType)
+	}
+	walker := schemaWalker{
+		Flattener: f,
+		subject:   reflect.TypeOf(subject),
+		visiting:  map[reflect.Type]bool{},
+	}
+	walker.walk(reflect.TypeOf(thorlog.Assessment{}), jsonpointer.Pointer{}, jsonpointer.Pointer{})
+	return walker.columns, nil
+}
+
+type schemaWalker struct {
+	Flattener
+	subject  reflect.Type
+	visiting map[reflect.Type]bool
+	columns  []string
+}
+
+func (w *schemaWalker) walk(typ reflect.Type, column jsonpointer.Pointer, pattern jsonpointer.Pointer) {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if isLeafType(typ) {
+		w.columns = append(w.columns, column.String())
+		return
+	}
+	switch typ.Kind() {
+	case reflect.Interface:
+		switch {
+		case typ == observedObjectType && pattern.String() == "/subject":
+			w.walk(w.subject, column, pattern)
+		case typ.Implements(objectType):
+			w.columns = append(w.columns, appendToken(column, "type").String())
+		default:
+			w.columns = append(w.columns, column.String())
+		}
+	case reflect.Slice, reflect.Array:
+		element := typ.Elem()
+		for element.Kind() == reflect.Pointer {
+			element = element.Elem()
+		}
+		if isLeafType(element) {
+			w.columns = append(w.columns, column.String())
+			return
+		}
+		elementPattern := appendToken(pattern, Wildcard)
+		if w.Modes[pattern.String()] == Index {
+			for i := 0; i < w.maxElements(); i++ {
+				w.walk(element, appendToken(column, strconv.Itoa(i)), elementPattern)
+			}
+			w.columns = append(w.columns, appendToken(column, MoreElements).String())
+		} else {
+			w.walk(element, appendToken(column, Wildcard), elementPattern)
+		}
+	case reflect.Struct:
+		if w.visiting[typ] {
+			// Recursive types have no fixed columns below the recursion
+			return
+		}
+		w.visiting[typ] = true
+		w.walkFields(typ, column, pattern)
+		delete(w.visiting, typ)
+	default:
+		// Maps have no fixed keys, so their entries are contained in a single column
+		w.columns = append(w.columns, column.String())
+	}
+}
+
+func (w *schemaWalker) walkFields(typ reflect.Type, column jsonpointer.Pointer, pattern jsonpointer.Pointer) {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" && field.Anonymous {
+			fieldType := field.Type
+			for fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				w.walkFields(fieldType, column, pattern)
+				continue
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+		w.walk(field.Type, appendToken(column, name), appendToken(pattern, name))
+	}
+}
+
+// isLeafType returns whether values of typ are represented by a single JSON value.
+func isLeafType(typ reflect.Type) bool {
+	if typ == timeType || typ.Implements(textMarshalerType) || reflect.PointerTo(typ).Implements(textMarshalerType) {
+		return true
+	}
+	if typ.Kind() == reflect.Struct && typ.Implements(jsonMarshalerType) && !hasJSONFields(typ) {
+		// Structs with custom marshalling and without JSON tags, e.g. jsonlog.Reference, are marshalled as a single value
+		return true
+	}
+	switch typ.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// hasJSONFields returns whether any field of a struct has a JSON tag.
+func hasJSONFields(typ reflect.Type) bool {
+	for i := 0; i < typ.NumField(); i++ {
+		if _, ok := typ.Field(i).Tag.Lookup("json"); ok {
+			return true
+		}
+	}
+	return false
+}
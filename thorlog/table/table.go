@@ -0,0 +1,314 @@
+// Package table flattens THOR assessments into rows for tabular formats such as CSV and TSV.
+//
+// Each column is identified by the JSON pointer of a value in the assessment, e.g. /subject/hashes/sha256.
+// Arrays of objects, like the reasons or the context of an assessment, can be joined into a single cell,
+// exploded into multiple rows, or indexed into separate columns (e.g. /reasons/0/signature/rule_name).
+// For joined and exploded arrays, the array index in the column is replaced by the wildcard *, e.g. /reasons/*/summary.
+//
+// The columns can either be fixed, in which case they are derived from the Go types of the assessment and its subject,
+// or discovered from the data.
+package table
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/NextronSystems/jsonlog/jsonpointer"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+// Wildcard replaces the array index in columns for joined or exploded arrays.
+const Wildcard = "*"
+
+// MoreElements replaces the array index in the column that counts the elements of an indexed array
+// that have no fixed columns, e.g. /reasons/more.
+const MoreElements = "more"
+
+// Mode determines how an array of objects is mapped to rows and columns.
+// Arrays of scalar values are always joined into a single cell.
+//
+// Arrays within the elements of a joined array are joined with the list separator instead of the separator,
+// e.g. the tags of several reasons in /reasons/*/signature/tags are joined as "APT, T1055; ; T1036".
+type Mode int
+
+const (
+	// Join joins the values of all array elements into a single cell per column, separated by the separator.
+	// Elements that don't have a value for a column contribute an empty value, so that the values in different
+	// columns of the same element are at the same position. If no element has a value, the cell is empty.
+	Join Mode = iota
+	// Explode creates a separate row for each array element. The other columns are repeated in each row.
+	// If several arrays are exploded, a row is created for each combination of their elements.
+	Explode
+	// Index creates separate columns for each array element, using the element's index in the column.
+	Index
+)
+
+// DefaultSeparator is the separator for joined values that is used if no other separator is configured.
+const DefaultSeparator = "; "
+
+// DefaultListSeparator is the separator for values of arrays within joined arrays that is used
+// if no other list separator is configured.
+const DefaultListSeparator = ", "
+
+// DefaultMaxElements is the number of array elements with fixed columns that is used for indexed arrays
+// if no other number is configured.
+const DefaultMaxElements = 3
+
+// Flattener flattens assessments into rows.
+//
+// The zero value joins all arrays with DefaultSeparator, and arrays within joined arrays with DefaultListSeparator.
+type Flattener struct {
+	// Modes maps arrays to the mode that is used for them. The arrays are identified by their JSON pointer,
+	// with the indices of enclosing arrays replaced by Wildcard, e.g. /reasons or /context/*/relations.
+	// Arrays that are not contained in Modes are joined.
+	Modes map[string]Mode
+	// Separator separates joined values. If it is empty, DefaultSeparator is used.
+	Separator string
+	// ListSeparator separates the values of arrays within joined arrays. It should differ from Separator,
+	// so that these values can be told apart from the values of different elements.
+	// If it is empty, DefaultListSeparator is used.
+	ListSeparator string
+	// MaxElements is the number of array elements that fixed columns are created for in indexed arrays.
+	// If it is 0, DefaultMaxElements is used.
+	//
+	// Further elements have no fixed columns. Instead, the fixed columns contain a column for each indexed array
+	// with MoreElements as index, e.g. /reasons/more, which contains the number of further elements.
+	// Elements are only limited for fixed columns; if the columns are discovered, all elements have columns.
+	MaxElements int
+}
+
+// Row is a flattened assessment, mapping columns to cell values.
+type Row map[string]string
+
+// Cell returns the value of a column. If the row has no value for the column,
+// but for columns below it (e.g. for objects whose structure is not known in advance),
+// the non-empty values are joined with their relative pointers.
+func (r Row) Cell(column string, separator string) string {
+	if value, ok := r[column]; ok {
+		return value
+	}
+	prefix := column + "/"
+	var nested []string
+	for key := range r {
+		if strings.HasPrefix(key, prefix) && r[key] != "" {
+			nested = append(nested, key)
+		}
+	}
+	sort.Strings(nested)
+	for i, key := range nested {
+		nested[i] = strings.TrimPrefix(key, prefix) + "=" + r[key]
+	}
+	return strings.Join(nested, separator)
+}
+
+// Flatten flattens an assessment into one or more rows. More than one row is only created for exploded arrays.
+func (f Flattener) Flatten(assessment *thorlog.Assessment) ([]Row, error) {
+	data, err := json.Marshal(assessment)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+	return f.flatten(decoded, jsonpointer.Pointer{}, jsonpointer.Pointer{}, false), nil
+}
+
+// flatten returns the rows for value. column is the column of value, pattern is the same pointer
+// but with all array indices replaced by Wildcard. joined is true if value is contained in an element of a joined array.
+func (f Flattener) flatten(value any, column jsonpointer.Pointer, pattern jsonpointer.Pointer, joined bool) []Row {
+	switch typedValue := value.(type) {
+	case nil:
+		return []Row{{}}
+	case map[string]any:
+		rows := []Row{{}}
+		keys := make([]string, 0, len(typedValue))
+		for key := range typedValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			rows = crossProduct(rows, f.flatten(typedValue[key], appendToken(column, key), appendToken(pattern, key), joined))
+		}
+		return rows
+	case []any:
+		if isScalarArray(typedValue) {
+			var values []string
+			for _, element := range typedValue {
+				values = append(values, scalarString(element))
+			}
+			if len(values) == 0 {
+				return []Row{{}}
+			}
+			return []Row{{column.String(): strings.Join(values, f.separatorFor(joined))}}
+		}
+		elementPattern := appendToken(pattern, Wildcard)
+		switch f.Modes[pattern.String()] {
+		case Explode:
+			var rows []Row
+			for _, element := range typedValue {
+				rows = append(rows, f.flatten(element, appendToken(column, Wildcard), elementPattern, joined)...)
+			}
+			if len(rows) == 0 {
+				return []Row{{}}
+			}
+			return rows
+		case Index:
+			rows := []Row{{}}
+			for i, element := range typedValue {
+				rows = crossProduct(rows, f.flatten(element, appendToken(column, strconv.Itoa(i)), elementPattern, joined))
+			}
+			return rows
+		default:
+			var elements []Row
+			for _, element := range typedValue {
+				elements = append(elements, f.join(f.flatten(element, appendToken(column, Wildcard), elementPattern, true), f.listSeparator()))
+			}
+			return []Row{f.join(elements, f.separatorFor(joined))}
+		}
+	default:
+		return []Row{{column.String(): scalarString(typedValue)}}
+	}
+}
+
+// join joins rows into a single row. Rows without a value for a column contribute an empty value.
+func (f Flattener) join(rows []Row, separator string) Row {
+	if len(rows) == 1 {
+		return rows[0]
+	}
+	joined := Row{}
+	for _, row := range rows {
+		for column := range row {
+			joined[column] = ""
+		}
+	}
+	for column := range joined {
+		values := make([]string, len(rows))
+		empty := true
+		for i, row := range rows {
+			values[i] = row[column]
+			empty = empty && values[i] == ""
+		}
+		if !empty {
+			joined[column] = strings.Join(values, separator)
+		}
+	}
+	return joined
+}
+
+func (f Flattener) separator() string {
+	if f.Separator == "" {
+		return DefaultSeparator
+	}
+	return f.Separator
+}
+
+func (f Flattener) listSeparator() string {
+	if f.ListSeparator == "" {
+		return DefaultListSeparator
+	}
+	return f.ListSeparator
+}
+
+// separatorFor returns the separator for the values of an array. joined is true if the array is contained
+// in an element of a joined array.
+func (f Flattener) separatorFor(joined bool) string {
+	if joined {
+		return f.listSeparator()
+	}
+	return f.separator()
+}
+
+func (f Flattener) maxElements() int {
+	if f.MaxElements == 0 {
+		return DefaultMaxElements
+	}
+	return f.MaxElements
+}
+
+// crossProduct returns a row for each combination of a row in a and a row in b.
+func crossProduct(a []Row, b []Row) []Row {
+	if len(b) == 1 {
+		for _, row := range a {
+			for column, value := range b[0] {
+				row[column] = value
+			}
+		}
+		return a
+	}
+	result := make([]Row, 0, len(a)*len(b))
+	for _, rowA := range a {
+		for _, rowB := range b {
+			row := make(Row, len(rowA)+len(rowB))
+			for column, value := range rowA {
+				row[column] = value
+			}
+			for column, value := range rowB {
+				row[column] = value
+			}
+			result = append(result, row)
+		}
+	}
+	return result
+}
+
+// appendToken returns a new pointer with token appended, without modifying pointer.
+func appendToken(pointer jsonpointer.Pointer, token string) jsonpointer.Pointer {
+	return append(pointer[:len(pointer):len(pointer)], token)
+}
+
+func isScalarArray(values []any) bool {
+	for _, value := range values {
+		switch value.(type) {
+		case map[string]any, []any:
+			return false
+		}
+	}
+	return true
+}
+
+func scalarString(value any) string {
+	switch typedValue := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typedValue
+	case json.Number:
+		return typedValue.String()
+	case bool:
+		return strconv.FormatBool(typedValue)
+	default:
+		data, _ := json.Marshal(typedValue)
+		return string(data)
+	}
+}
+
+// SortColumns sorts columns by their pointer tokens. Array indices are compared numerically,
+// and the wildcard is sorted before all indices.
+func SortColumns(columns []string) {
+	sort.Slice(columns, func(i, j int) bool {
+		return lessColumn(columns[i], columns[j])
+	})
+}
+
+func lessColumn(a string, b string) bool {
+	tokensA := strings.Split(a, "/")
+	tokensB := strings.Split(b, "/")
+	for i := 0; i < len(tokensA) && i < len(tokensB); i++ {
+		if tokensA[i] == tokensB[i] {
+			continue
+		}
+		indexA, errA := strconv.Atoi(tokensA[i])
+		indexB, errB := strconv.Atoi(tokensB[i])
+		if errA == nil && errB == nil {
+			return indexA < indexB
+		}
+		return tokensA[i] < tokensB[i]
+	}
+	return len(tokensA) < len(tokensB)
+}
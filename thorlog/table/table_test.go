@@ -0,0 +1,198 @@
+package table
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/NextronSystems/jsonlog/thorlog/parser"
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// extractedTool is a tool that was extracted from an archive by a PowerShell process. It has several reasons,
+// some of them with tags, and context objects with several relations, so that all array modes can be tested on it.
+const extractedTool = `{"type":"THOR assessment","meta":{"time":"2024-10-08T07:52:16Z","level":"Alert","module":"Filescan","scan_id":"S-Jk5pR7tB0w","hostname":"ws-hr-09"},"message":"Suspicious file found","score":75,"subject":{"type":"file","path":"C:\\Users\\m.keller\\Downloads\\tools\\svchost.exe","exists":"yes","extension":".exe","hashes":{"sha256":"9f2c4e7a1b3d5f608192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8"}},"reasons":[{"summary":"YARA rule HKTL_Mimikatz_Strings","signature":{"score":75,"kind":"YARA Rule","tags":["HKTL","T1003.001"],"rule_name":"HKTL_Mimikatz_Strings"}},{"summary":"Filename IOC svchost.exe in user directory","signature":{"score":60,"kind":"Filename IOC"}}],"context":[{"object":{"type":"file","path":"C:\\Users\\m.keller\\Downloads\\tools.zip","exists":"yes"},"relations":[{"relation_type":"derives from","relation_name":"archive","unique":true}]},{"object":{"type":"process","pid":5124,"name":"powershell.exe"},"relations":[{"relation_type":"related to","relation_name":"extractor","unique":false},{"relation_type":"related to","relation_name":"launcher","unique":false}]}],"log_version":"v3.0.0"}`
+
+func parseAssessment(t *testing.T, event string) *thorlog.Assessment {
+	t.Helper()
+	parsed, err := parser.ParseEvent([]byte(event))
+	require.NoError(t, err)
+	require.IsType(t, &thorlog.Assessment{}, parsed)
+	return parsed.(*thorlog.Assessment)
+}
+
+func TestFlattener_Flatten(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		modes map[string]Mode
+		want  []Row
+	}{
+		{
+			name: "join",
+			want: []Row{{
+				"/reasons/*/summary":             "YARA rule HKTL_Mimikatz_Strings; Filename IOC svchost.exe in user directory",
+				"/reasons/*/signature/rule_name": "HKTL_Mimikatz_Strings; ",
+				"/reasons/*/signature/tags":      "HKTL, T1003.001; ",
+			}},
+		},
+		{
+			name:  "explode",
+			modes: map[string]Mode{"/reasons": Explode},
+			want: []Row{
+				{"/reasons/*/summary": "YARA rule HKTL_Mimikatz_Strings", "/reasons/*/signature/rule_name": "HKTL_Mimikatz_Strings", "/reasons/*/signature/tags": "HKTL; T1003.001"},
+				{"/reasons/*/summary": "Filename IOC svchost.exe in user directory"},
+			},
+		},
+		{
+			name:  "index",
+			modes: map[string]Mode{"/reasons": Index},
+			want: []Row{{
+				"/reasons/0/summary":             "YARA rule HKTL_Mimikatz_Strings",
+				"/reasons/0/signature/rule_name": "HKTL_Mimikatz_Strings",
+				"/reasons/0/signature/tags":      "HKTL; T1003.001",
+				"/reasons/1/summary":             "Filename IOC svchost.exe in user directory",
+			}},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := Flattener{Modes: tt.modes}.Flatten(parseAssessment(t, extractedTool))
+			require.NoError(t, err)
+			require.Len(t, rows, len(tt.want))
+			for i, row := range rows {
+				assert.Equal(t, `C:\Users\m.keller\Downloads\tools\svchost.exe`, row["/subject/path"])
+				assert.Equal(t, "9f2c4e7a1b3d5f608192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8", row["/subject/hashes/sha256"])
+				assert.Equal(t, "75", row["/score"])
+				assert.Equal(t, "2024-10-08T07:52:16Z", row["/meta/time"])
+				assert.Equal(t, "file; process", row["/context/*/object/type"])
+				for column, value := range tt.want[i] {
+					assert.Equal(t, value, row[column], column)
+				}
+			}
+		})
+	}
+}
+
+func TestFlattener_FlattenNestedExplode(t *testing.T) {
+	rows, err := Flattener{Modes: map[string]Mode{"/context": Explode, "/context/*/relations": Explode}}.Flatten(parseAssessment(t, extractedTool))
+	require.NoError(t, err)
+	var relations []string
+	for _, row := range rows {
+		relations = append(relations, row["/context/*/object/type"]+": "+row["/context/*/relations/*/relation_name"])
+	}
+	assert.Equal(t, []string{"file: archive", "process: extractor", "process: launcher"}, relations)
+}
+
+func TestFlattener_FlattenNestedJoin(t *testing.T) {
+	rows, err := Flattener{ListSeparator: "|"}.Flatten(parseAssessment(t, extractedTool))
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, "file; process", rows[0]["/context/*/object/type"])
+	assert.Equal(t, "archive; extractor|launcher", rows[0]["/context/*/relations/*/relation_name"])
+}
+
+func TestFlattener_Columns(t *testing.T) {
+	columns, err := Flattener{Modes: map[string]Mode{"/reasons": Index}, MaxElements: 2}.Columns(nil, "file")
+	require.NoError(t, err)
+	assert.Subset(t, columns, []string{
+		"/meta/time",
+		"/score",
+		"/subject/path",
+		"/subject/hashes/sha256",
+		"/subject/pe_info/signatures/*/certificate_name",
+		"/reasons/0/signature/rule_name",
+		"/reasons/1/summary",
+		"/reasons/0/matched/*/field",
+		"/context/*/object/type",
+		"/context/*/relations/*/relation_type",
+	})
+	assert.Contains(t, columns, "/reasons/"+MoreElements)
+	assert.NotContains(t, columns, "/reasons/2/summary")
+	assert.NotContains(t, columns, "/subject/pid")
+
+	processColumns, err := Flattener{}.Columns(nil, "process")
+	require.NoError(t, err)
+	assert.Contains(t, processColumns, "/subject/pid")
+	assert.Contains(t, processColumns, "/reasons/*/summary")
+
+	_, err = Flattener{}.Columns(nil, "nonexistent")
+	assert.Error(t, err)
+}
+
+func TestRow_Cell(t *testing.T) {
+	row := Row{"/a": "1", "/b/y": "3", "/b/x": "2", "/b/z": ""}
+	assert.Equal(t, "1", row.Cell("/a", ", "))
+	assert.Equal(t, "x=2, y=3", row.Cell("/b", ", "))
+	assert.Equal(t, "", row.Cell("/c", ", "))
+}
+
+func TestSortColumns(t *testing.T) {
+	columns := []string{"/reasons/10/summary", "/score", "/reasons/2/summary", "/reasons/*/summary", "/meta/time", "/reasons/2"}
+	SortColumns(columns)
+	assert.Equal(t, []string{"/meta/time", "/reasons/*/summary", "/reasons/2", "/reasons/2/summary", "/reasons/10/summary", "/score"}, columns)
+}
+
+func TestWriter_Fixed(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := NewWriter(&buffer, '\t')
+	writer.Modes = map[string]Mode{"/context": Explode}
+	writer.Columns = []string{"/meta/hostname", "/subject/path", "/reasons/*/summary", "/context/*/object"}
+	require.NoError(t, writer.Write(parseAssessment(t, extractedTool)))
+	require.NoError(t, writer.Flush())
+	assert.Equal(t, "/meta/hostname\t/subject/path\t/reasons/*/summary\t/context/*/object\n"+
+		"ws-hr-09\tC:\\Users\\m.keller\\Downloads\\tools\\svchost.exe\tYARA rule HKTL_Mimikatz_Strings; Filename IOC svchost.exe in user directory\t"+
+		"exists=yes; path=C:\\Users\\m.keller\\Downloads\\tools.zip; type=file\n"+
+		"ws-hr-09\tC:\\Users\\m.keller\\Downloads\\tools\\svchost.exe\tYARA rule HKTL_Mimikatz_Strings; Filename IOC svchost.exe in user directory\t"+
+		"created=0001-01-01T00:00:00Z; name=powershell.exe; parent_info/pid=0; pid=5124; type=process\n", buffer.String())
+}
+
+func TestWriter_FixedIndexOverflow(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		maxElements int
+		want        string
+	}{
+		{name: "all elements have columns", maxElements: 2, want: "/reasons/0/summary\t/reasons/1/summary\t/reasons/more\n" +
+			"YARA rule HKTL_Mimikatz_Strings\tFilename IOC svchost.exe in user directory\t\n"},
+		{name: "further elements are counted", maxElements: 1, want: "/reasons/0/summary\t/reasons/more\n" +
+			"YARA rule HKTL_Mimikatz_Strings\t1\n"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			flattener := Flattener{Modes: map[string]Mode{"/reasons": Index}, MaxElements: tt.maxElements}
+			columns, err := flattener.Columns(nil, "file")
+			require.NoError(t, err)
+			var buffer bytes.Buffer
+			writer := NewWriter(&buffer, '\t')
+			writer.Flattener = flattener
+			for _, column := range columns {
+				if column == "/reasons/"+MoreElements || strings.HasSuffix(column, "/summary") && strings.HasPrefix(column, "/reasons/") {
+					writer.Columns = append(writer.Columns, column)
+				}
+			}
+			require.NoError(t, writer.Write(parseAssessment(t, extractedTool)))
+			require.NoError(t, writer.Flush())
+			assert.Equal(t, tt.want, buffer.String())
+		})
+	}
+}
+
+func TestWriter_Dynamic(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := NewWriter(&buffer, ',')
+	writer.Modes = map[string]Mode{"/reasons": Explode}
+	writer.Separator = "|"
+	assessment := parseAssessment(t, extractedTool)
+	assessment.EventContext = nil
+	require.NoError(t, writer.Write(assessment))
+	assert.Empty(t, buffer.String(), "rows are buffered until the columns are known")
+	require.NoError(t, writer.Flush())
+	assert.Equal(t, "/log_version,/message,/meta/hostname,/meta/level,/meta/module,/meta/scan_id,/meta/time,"+
+		"/reasons/*/signature/kind,/reasons/*/signature/origin,/reasons/*/signature/rule_name,/reasons/*/signature/score,/reasons/*/signature/tags,"+
+		"/reasons/*/summary,/reasons/*/type,/score,/subject/exists,/subject/extension,/subject/hashes/md5,/subject/hashes/sha1,/subject/hashes/sha256,/subject/path,/subject/type,/type\n"+
+		"v3.0.0,Suspicious file found,ws-hr-09,Alert,Filescan,S-Jk5pR7tB0w,2024-10-08T07:52:16Z,YARA Rule,internal,HKTL_Mimikatz_Strings,75,HKTL|T1003.001,YARA rule HKTL_Mimikatz_Strings,,75,yes,.exe,,,"+
+		"9f2c4e7a1b3d5f608192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8,C:\\Users\\m.keller\\Downloads\\tools\\svchost.exe,file,THOR assessment\n"+
+		"v3.0.0,Suspicious file found,ws-hr-09,Alert,Filescan,S-Jk5pR7tB0w,2024-10-08T07:52:16Z,Filename IOC,internal,,60,,Filename IOC svchost.exe in user directory,,75,yes,.exe,,,"+
+		"9f2c4e7a1b3d5f608192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8,C:\\Users\\m.keller\\Downloads\\tools\\svchost.exe,file,THOR assessment\n",
+		buffer.String())
+}
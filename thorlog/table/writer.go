@@ -0,0 +1,144 @@
+package table
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+// Writer writes assessments as CSV or TSV rows, with a header row containing the columns.
+//
+// If Columns is set, rows are written immediately. Values that have no column are not written, except for the elements
+// of indexed arrays beyond Flattener.MaxElements, which are counted in the array's MoreElements column. Otherwise, the columns are discovered from the data:
+// all rows are kept in memory until Flush is called, and the header contains all columns of all rows, sorted with SortColumns.
+// The discovered columns are kept afterwards, so rows that are written after Flush are written immediately.
+//
+// A Writer must be created with NewWriter; the zero value has no output to write to.
+type Writer struct {
+	Flattener
+	// Columns contains the fixed columns, e.g. from Flattener.Columns.
+	// If it is empty, the columns are discovered from the data.
+	Columns []string
+
+	csv           *csv.Writer
+	headerWritten bool
+	rows          []Row
+	discovered    map[string]bool
+}
+
+// NewWriter creates a new Writer that writes to w, separating the cells with comma,
+// e.g. ',' for CSV or '\t' for TSV.
+func NewWriter(w io.Writer, comma rune) *Writer {
+	csvWriter := csv.NewWriter(w)
+	csvWriter.Comma = comma
+	return &Writer{
+		csv:        csvWriter,
+		discovered: map[string]bool{},
+	}
+}
+
+// Write adds the rows for an assessment.
+func (w *Writer) Write(assessment *thorlog.Assessment) error {
+	rows, err := w.Flatten(assessment)
+	if err != nil {
+		return err
+	}
+	if len(w.Columns) == 0 {
+		for _, row := range rows {
+			for column := range row {
+				w.discovered[column] = true
+			}
+		}
+		w.rows = append(w.rows, rows...)
+		return nil
+	}
+	for _, row := range rows {
+		if err := w.writeRow(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush writes the header and all buffered rows.
+func (w *Writer) Flush() error {
+	if len(w.Columns) == 0 {
+		for column := range w.discovered {
+			w.Columns = append(w.Columns, column)
+		}
+		SortColumns(w.Columns)
+		for _, row := range w.rows {
+			if err := w.writeRow(row); err != nil {
+				return err
+			}
+		}
+		w.rows = nil
+	}
+	if !w.headerWritten && len(w.Columns) > 0 {
+		if err := w.csv.Write(w.Columns); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+func (w *Writer) writeRow(row Row) error {
+	if !w.headerWritten {
+		if err := w.csv.Write(w.Columns); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+	record := make([]string, len(w.Columns))
+	for i, column := range w.Columns {
+		if count, ok := w.moreElements(row, column); ok {
+			record[i] = count
+			continue
+		}
+		record[i] = row.Cell(column, w.separator())
+	}
+	return w.csv.Write(record)
+}
+
+// moreElements returns the number of elements without fixed columns if column counts the further elements
+// of an indexed array, e.g. /reasons/more. The count is empty if the array has no further elements.
+func (w *Writer) moreElements(row Row, column string) (string, bool) {
+	if _, ok := row[column]; ok {
+		return "", false
+	}
+	array, found := strings.CutSuffix(column, "/"+MoreElements)
+	if !found || w.Modes[arrayPattern(array)] != Index {
+		return "", false
+	}
+	prefix := array + "/"
+	further := map[string]bool{}
+	for key := range row {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		index, _, _ := strings.Cut(strings.TrimPrefix(key, prefix), "/")
+		if i, err := strconv.Atoi(index); err == nil && i >= w.maxElements() {
+			further[index] = true
+		}
+	}
+	if len(further) == 0 {
+		return "", true
+	}
+	return strconv.Itoa(len(further)), true
+}
+
+// arrayPattern replaces the array indices in a column with Wildcard.
+func arrayPattern(column string) string {
+	tokens := strings.Split(column, "/")
+	for i, token := range tokens {
+		if _, err := strconv.Atoi(token); err == nil {
+			tokens[i] = Wildcard
+		}
+	}
+	return strings.Join(tokens, "/")
+}
@@ -95,9 +95,11 @@ func (e Event) MarshalJSON() ([]byte, error) {
 		panic("data was not marshalled correctly")
 	}
 	var combinedData = data[:len(data)-1]
-	combinedData = append(combinedData, ',')
-	combinedData = append(combinedData, data2[1:len(data2)-1]...)
-	combinedData = append(combinedData, fmt.Sprintf(`,"version":%q`, e.EventVersion)...)
+	if len(filteredData) > 0 {
+		combinedData = append(combinedData, ',')
+		combinedData = append(combinedData, data2[1:len(data2)-1]...)
+	}
+	combinedData = append(combinedData, fmt.Sprintf(`,"log_version":%q`, e.EventVersion)...)
 	combinedData = append(combinedData, '}')
 	return combinedData, nil
 }
@@ -0,0 +1,65 @@
+package v2
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/NextronSystems/jsonlog/thorlog/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvent_JSON(t *testing.T) {
+	meta := Metadata{
+		Time:   time.Date(2024, 9, 24, 12, 37, 4, 0, time.UTC),
+		Lvl:    common.Warning,
+		Mod:    "ProcessCheck",
+		ScanID: "S-kgKxYyJFQd0",
+		Source: "host",
+	}
+	for _, testcase := range []struct {
+		name     string
+		event    Event
+		expected string
+	}{
+		{
+			"WithData",
+			Event{
+				LogEventMetadata: meta,
+				Data: Fields{
+					{Key: "message", Value: "Suspicious process found"},
+					{Key: "process", Value: Fields{{Key: "name", Value: "evil"}}},
+					{Key: "module", Value: "duplicate"},
+				},
+				EventVersion: "v2.0.0",
+			},
+			`{"time":"2024-09-24T12:37:04Z","level":"Warning","module":"ProcessCheck","scanid":"S-kgKxYyJFQd0","uid":"","hostname":"host","message":"Suspicious process found","process":{"name":"evil"},"log_version":"v2.0.0"}`,
+		},
+		{
+			"WithoutData",
+			Event{
+				LogEventMetadata: meta,
+				EventVersion:     "v2.0.0",
+			},
+			`{"time":"2024-09-24T12:37:04Z","level":"Warning","module":"ProcessCheck","scanid":"S-kgKxYyJFQd0","uid":"","hostname":"host","log_version":"v2.0.0"}`,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			data, err := json.Marshal(testcase.event)
+			require.NoError(t, err)
+			assert.Equal(t, testcase.expected, string(data))
+
+			var unmarshalled Event
+			require.NoError(t, json.Unmarshal(data, &unmarshalled))
+			expected := testcase.event
+			expected.Data = nil
+			for _, field := range testcase.event.Data {
+				if field.Key != "module" {
+					expected.Data = append(expected.Data, field)
+				}
+			}
+			assert.Equal(t, expected, unmarshalled)
+		})
+	}
+}
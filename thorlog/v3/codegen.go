@@ -0,0 +1,119 @@
+package thorlog
+
+import (
+	"encoding/json"
+	"time"
+	"unicode/utf8"
+)
+
+// The log object types in this package have generated implementations of their JSON and text log encodings
+// and of the reference lookups that avoid reflection. They are generated from the json and textlog tags of the
+// types in LogObjectTypes and must be regenerated whenever these types change.
+//
+// The generated methods are unexported and registered with jsonlog.RegisterGenerated, so that they are only used
+// for exactly these types. Exported methods would be promoted to types that embed a log object type.
+
+//go:generate go run -tags jsonloggen ./internal/jsonloggen -output marshal_gen.go -test-output marshal_gen_test.go
+
+// The functions below are used by the generated code.
+
+// appendJSONString appends s to buf as a JSON string, escaped in the same way as by json.Marshal.
+// Rare characters whose encoding differs between Go versions are left to encoding/json.
+func appendJSONString(buf []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+	origin := len(buf)
+	buf = append(buf, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			buf = append(buf, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				buf = append(buf, '\\', c)
+			case '\n':
+				buf = append(buf, '\\', 'n')
+			case '\r':
+				buf = append(buf, '\\', 'r')
+			case '\t':
+				buf = append(buf, '\\', 't')
+			case '<', '>', '&':
+				buf = append(buf, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			default:
+				return appendJSONStringSlow(buf[:origin], s)
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			return appendJSONStringSlow(buf[:origin], s)
+		}
+		if r == '\u2028' || r == '\u2029' {
+			// Line and paragraph separators are escaped for JavaScript
+			buf = append(buf, s[start:i]...)
+			buf = append(buf, '\\', 'u', '2', '0', '2', hex[r&0xf])
+			start = i + size
+		}
+		i += size
+	}
+	buf = append(buf, s[start:]...)
+	return append(buf, '"')
+}
+
+func appendJSONStringSlow(buf []byte, s string) []byte {
+	encoded, _ := json.Marshal(s)
+	return append(buf, encoded...)
+}
+
+// appendJSONValue appends the JSON encoding of value to buf using json.Marshal.
+func appendJSONValue(buf []byte, value any) ([]byte, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, encoded...), nil
+}
+
+// appendJSONTime appends the JSON encoding of timestamp to buf.
+func appendJSONTime(buf []byte, timestamp time.Time) ([]byte, error) {
+	encoded, err := timestamp.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, encoded...), nil
+}
+
+// closeJSONObject finishes a JSON object that starts at start in buf.
+// Each member of the object must have been written with a leading comma; the first one is replaced by the opening brace.
+func closeJSONObject(buf []byte, start int) []byte {
+	if len(buf) == start {
+		return append(buf, "{}"...)
+	}
+	buf[start] = '{'
+	return append(buf, '}')
+}
+
+// isComparableZero reports whether value is equal to the zero value of its type.
+func isComparableZero[T comparable](value T) bool {
+	var zero T
+	return value == zero
+}
+
+// hasZeroMethod reports whether value implements an IsZero method that returns true.
+func hasZeroMethod(value any) bool {
+	zeroer, isZeroer := value.(interface{ IsZero() bool })
+	return isZeroer && zeroer.IsZero()
+}
+
+// textLabelOr returns label, or fieldLabel if label is empty.
+func textLabelOr(label string, fieldLabel string) string {
+	if label == "" {
+		return fieldLabel
+	}
+	return label
+}
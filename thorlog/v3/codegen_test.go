@@ -0,0 +1,274 @@
+//go:build !jsonloggen
+
+package thorlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
+)
+
+// filler fills values deterministically with varying content, including nil pointers, empty slices
+// and strings that need escaping.
+type filler struct {
+	seed  int
+	depth int
+}
+
+var fillerStrings = []string{"", "value", "<&>", "line\nbreak", "quote\"backslash\\", "\u2028unicode\u00e4", "C:\\Windows\\system32"}
+
+var (
+	referenceType = reflect.TypeOf(jsonlog.Reference{})
+	timeType      = reflect.TypeOf(time.Time{})
+)
+
+func (f *filler) fill(v reflect.Value) {
+	f.seed++
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(f.seed%2 == 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(f.seed%7 - 1))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(uint64(f.seed % 5))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(float64(f.seed%4) / 2)
+	case reflect.String:
+		v.SetString(fillerStrings[f.seed%len(fillerStrings)])
+	case reflect.Ptr:
+		if f.seed%5 == 0 || v.Type().Elem() == referenceType {
+			return
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		f.fill(v.Elem())
+	case reflect.Slice:
+		length := f.seed % 4
+		if length == 0 {
+			return
+		}
+		v.Set(reflect.MakeSlice(v.Type(), length-1, length-1))
+		for i := 0; i < v.Len(); i++ {
+			f.fill(v.Index(i))
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			f.fill(v.Index(i))
+		}
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return
+		}
+		v.Set(reflect.MakeMap(v.Type()))
+		for i := 0; i < f.seed%3; i++ {
+			value := reflect.New(v.Type().Elem()).Elem()
+			f.fill(value)
+			v.SetMapIndex(reflect.ValueOf(fmt.Sprintf("key%d", i)).Convert(v.Type().Key()), value)
+		}
+	case reflect.Struct:
+		switch v.Type() {
+		case referenceType:
+		case timeType:
+			v.Set(reflect.ValueOf(time.Date(2024, 1, 2, 3, 4, 5, f.seed*1000, time.UTC)))
+		default:
+			for i := 0; i < v.NumField(); i++ {
+				if v.Field(i).CanSet() {
+					f.fill(v.Field(i))
+				}
+			}
+		}
+	case reflect.Interface:
+		if f.depth >= 2 {
+			return
+		}
+		var candidates []reflect.Type
+		for _, typ := range sortedObjectTypes() {
+			if typ.Implements(v.Type()) {
+				candidates = append(candidates, typ)
+			}
+		}
+		if len(candidates) == 0 {
+			return
+		}
+		object := reflect.New(candidates[f.seed%len(candidates)].Elem())
+		f.depth++
+		f.fill(object.Elem())
+		f.depth--
+		v.Set(object)
+	}
+}
+
+func sortedObjectTypes() []reflect.Type {
+	var types []reflect.Type
+	for typ := range reflectiveObjects {
+		types = append(types, typ)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].String() < types[j].String()
+	})
+	return types
+}
+
+// fieldPointers returns pointers to all fields within v.
+func fieldPointers(v reflect.Value) []any {
+	var pointers []any
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		if v.CanAddr() {
+			pointers = append(pointers, v.Addr().Interface())
+		}
+		switch v.Kind() {
+		case reflect.Ptr, reflect.Interface:
+			if !v.IsNil() && v.Elem().Kind() != reflect.Interface {
+				walk(v.Elem())
+			}
+		case reflect.Struct:
+			for i := 0; i < v.NumField(); i++ {
+				if v.Type().Field(i).IsExported() {
+					walk(v.Field(i))
+				}
+			}
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < v.Len(); i++ {
+				walk(v.Index(i))
+			}
+		}
+	}
+	walk(v.Elem())
+	return pointers[1:]
+}
+
+func TestGeneratedMethods_MatchReflection(t *testing.T) {
+	formatters := map[string]jsonlog.TextlogFormatter{
+		"plain": {},
+		"thor":  NewTextlogFormatter(),
+		"omit": {Omit: func(modifiers []string, value any) bool {
+			return slices.Contains(modifiers, "omitincontext")
+		}},
+	}
+	for _, typ := range sortedObjectTypes() {
+		typ := typ
+		t.Run(typ.Elem().Name(), func(t *testing.T) {
+			for seed := 0; seed < 8; seed++ {
+				object := reflect.New(typ.Elem())
+				if seed > 0 {
+					(&filler{seed: seed}).fill(object.Elem())
+				}
+				generated := object.Interface().(jsonlog.Object)
+				reflective := reflectiveObjects[typ](generated)
+
+				generatedJson, generatedErr := jsonlog.MarshalJSON(generated)
+				reflectiveJson, reflectiveErr := json.Marshal(reflective)
+				require.Equal(t, reflectiveErr != nil, generatedErr != nil, "seed %d: %v / %v", seed, reflectiveErr, generatedErr)
+				assert.Equal(t, string(reflectiveJson), string(generatedJson), "seed %d", seed)
+
+				for name, formatter := range formatters {
+					assert.Equal(t, formatter.Format(reflective), formatter.Format(generated), "seed %d, formatter %s", seed, name)
+				}
+
+				for _, pointer := range fieldPointers(object) {
+					assert.Equal(t, jsonlog.RelativeJsonPointer(reflective, pointer), jsonlog.RelativeJsonPointer(generated, pointer), "seed %d, %T", seed, pointer)
+					reflectiveLabel, reflectiveFound := jsonlog.RelativeTextLabel(reflective, pointer)
+					generatedLabel, generatedFound := jsonlog.RelativeTextLabel(generated, pointer)
+					assert.Equal(t, reflectiveFound, generatedFound, "seed %d, %T", seed, pointer)
+					assert.Equal(t, reflectiveLabel, generatedLabel, "seed %d, %T", seed, pointer)
+				}
+			}
+		})
+	}
+}
+
+// extendedFile is a newer definition of File, as it may be registered in a custom Registry.
+type extendedFile struct {
+	File
+	Extra string `json:"extra" textlog:"extra"`
+}
+
+func TestGeneratedMethods_Embedded(t *testing.T) {
+	file := &extendedFile{File: *NewFile("/x"), Extra: "x"}
+
+	// The fields of the embedded File are followed by the own fields of extendedFile
+	fileJson, err := jsonlog.MarshalJSON(&file.File)
+	require.NoError(t, err)
+	expectedJson := strings.TrimSuffix(string(fileJson), "}") + `,"extra":"x"}`
+	for _, marshal := range []func(any) ([]byte, error){json.Marshal, jsonlog.MarshalJSON} {
+		data, err := marshal(file)
+		require.NoError(t, err)
+		assert.Equal(t, expectedJson, string(data))
+	}
+
+	expectedEntry := append(jsonlog.TextlogFormatter{}.Format(&file.File), jsonlog.TextlogValuePair{Key: "EXTRA", Value: "x"})
+	assert.Equal(t, expectedEntry, jsonlog.TextlogFormatter{}.Format(file))
+
+	assert.Equal(t, "/extra", jsonlog.RelativeJsonPointer(file, &file.Extra).String())
+	assert.Equal(t, "/path", jsonlog.RelativeJsonPointer(file, &file.Path).String())
+	label, found := jsonlog.RelativeTextLabel(file, &file.Extra)
+	assert.True(t, found)
+	assert.Equal(t, "EXTRA", label)
+
+	// Within other objects, the embedding type is encoded by reflection as well
+	assessment := NewAssessment(file, "test")
+	data, err := jsonlog.MarshalJSON(assessment)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"extra":"x"`)
+	assert.Contains(t, concatEntry(jsonlog.TextlogFormatter{}.Format(assessment)), "EXTRA: x")
+}
+
+func TestAppendJSONString(t *testing.T) {
+	inputs := []string{"", "plain", "C:\\Windows\\", "<a href=\"x\">&amp;</a>", "\xff\xfeinvalid\xc3", "tab\tnew\nline\r\x00\x1f\x7f"}
+	for r := rune(0); r < 0x3000; r++ {
+		inputs = append(inputs, "x"+string(r)+"y")
+	}
+	for b := 0; b < 256; b++ {
+		inputs = append(inputs, string([]byte{byte(b)}))
+	}
+	for _, input := range inputs {
+		expected, err := json.Marshal(input)
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), string(appendJSONString(nil, input)), "%q", input)
+	}
+}
+
+func BenchmarkGeneratedMethods(b *testing.B) {
+	formatter := NewTextlogFormatter()
+	for _, object := range []jsonlog.Object{&File{}, &Process{}, &Assessment{}} {
+		(&filler{seed: 3}).fill(reflect.ValueOf(object).Elem())
+		pointers := fieldPointers(reflect.ValueOf(object))
+		lastField := pointers[len(pointers)-1]
+		for _, variant := range []struct {
+			name   string
+			object any
+		}{
+			{"Generated", object},
+			{"Reflection", reflectiveObjects[reflect.TypeOf(object)](object)},
+		} {
+			name := reflect.TypeOf(object).Elem().Name()
+			b.Run(name+"/JSON/"+variant.name, func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					_, _ = jsonlog.MarshalJSON(variant.object)
+				}
+			})
+			b.Run(name+"/Textlog/"+variant.name, func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					formatter.Format(variant.object)
+				}
+			})
+			b.Run(name+"/JsonPointer/"+variant.name, func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					jsonlog.RelativeJsonPointer(variant.object, lastField)
+				}
+			})
+		}
+	}
+}
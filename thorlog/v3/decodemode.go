@@ -0,0 +1,274 @@
+package thorlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/jsonpointer"
+)
+
+// DecodeMode controls how an EmbeddedObject handles JSON fields that do not correspond
+// to a field of the decoded object, e.g. because the JSON was created by a newer THOR version.
+type DecodeMode int
+
+const (
+	// DecodeStrict causes decoding to fail if the JSON contains unknown fields.
+	DecodeStrict DecodeMode = iota
+	// DecodeLenient decodes all known fields and ignores unknown fields.
+	// The unknown fields are kept in EmbeddedObject.UnknownFields.
+	DecodeLenient
+	// DecodeCollect works like DecodeLenient, but additionally lists the JSON pointers
+	// of all unknown fields, in the order of their occurrence, in EmbeddedObject.UnknownPointers.
+	DecodeCollect
+)
+
+// UnknownFields maps the JSON pointers (relative to the decoded object) of fields
+// that were ignored during decoding to their raw JSON values.
+type UnknownFields map[string]json.RawMessage
+
+type unknownField struct {
+	Pointer jsonpointer.Pointer
+	Value   json.RawMessage
+}
+
+var (
+	objectInterfaceType = reflect.TypeOf((*jsonlog.Object)(nil)).Elem()
+	unmarshalerType     = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	unknownObjectType   = reflect.TypeOf(UnknownObject{})
+	contextObjectType   = reflect.TypeOf(ContextObject{})
+)
+
+// removeUnknownFields removes all JSON fields from data that do not correspond to a field
+// in the given type (or one of its subtypes) and returns the remaining JSON and the removed fields.
+//
+// Subtypes that are log objects are resolved using their type field, as in EmbeddedObject.
+// Values that are decoded by a custom UnmarshalJSON method are kept as they are,
+// unless they are log objects or ContextObjects, whose JSON representation corresponds to their struct fields.
+func removeUnknownFields(data json.RawMessage, t reflect.Type, pointer jsonpointer.Pointer, registry *Registry) (json.RawMessage, []unknownField, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	trimmedData := bytes.TrimSpace(data)
+	if len(trimmedData) == 0 || (trimmedData[0] != '{' && trimmedData[0] != '[') {
+		return data, nil, nil
+	}
+
+	switch {
+	case t.Kind() == reflect.Interface:
+		if !t.Implements(objectInterfaceType) || trimmedData[0] != '{' {
+			return data, nil, nil
+		}
+		var header jsonlog.ObjectHeader
+		if err := json.Unmarshal(data, &header); err != nil {
+			return data, nil, nil // Not a valid log object, decoding will fail anyway
+		}
+		objectBlank := registry.Lookup(header.Type)
+		if objectBlank == nil {
+			return data, nil, nil
+		}
+		return removeUnknownFields(data, reflect.TypeOf(objectBlank), pointer, registry)
+	case t == unknownObjectType:
+		return data, nil, nil
+	case reflect.PointerTo(t).Implements(unmarshalerType) && !isStructLike(t):
+		return data, nil, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if trimmedData[0] != '{' {
+			return data, nil, nil
+		}
+		fields := jsonFields(t)
+		return filterObject(data, pointer, registry, func(key string) (reflect.Type, bool) {
+			field, found := findJsonField(fields, key)
+			return field.Type, found
+		})
+	case reflect.Map:
+		if trimmedData[0] != '{' {
+			return data, nil, nil
+		}
+		return filterObject(data, pointer, registry, func(string) (reflect.Type, bool) {
+			return t.Elem(), true
+		})
+	case reflect.Slice, reflect.Array:
+		if trimmedData[0] != '[' {
+			return data, nil, nil
+		}
+		return filterArray(data, pointer, registry, t.Elem())
+	default:
+		return data, nil, nil
+	}
+}
+
+// isStructLike returns whether the JSON representation of a type with a custom UnmarshalJSON method
+// corresponds to its struct fields.
+func isStructLike(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	return t == contextObjectType || reflect.PointerTo(t).Implements(objectInterfaceType)
+}
+
+// jsonField describes a field of a struct type that is unmarshalled from JSON.
+type jsonField struct {
+	// Name is the JSON name of the field.
+	Name string
+	// Index is the index sequence of the field, as used by reflect.Value.FieldByIndex.
+	Index []int
+	Type  reflect.Type
+}
+
+// jsonFields returns all fields of the given struct type, including fields promoted from embedded structs,
+// in the order in which they are declared.
+func jsonFields(t reflect.Type) []jsonField {
+	var fields []jsonField
+	var names = map[string]bool{}
+	var embedded []jsonField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			embedded = append(embedded, jsonField{Index: field.Index, Type: fieldType})
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields = append(fields, jsonField{Name: name, Index: field.Index, Type: field.Type})
+		names[name] = true
+	}
+	// Fields of embedded structs are shadowed by fields of the outer struct
+	for _, embeddedField := range embedded {
+		for _, field := range jsonFields(embeddedField.Type) {
+			if !names[field.Name] {
+				fields = append(fields, jsonField{
+					Name:  field.Name,
+					Index: append(embeddedField.Index[:len(embeddedField.Index):len(embeddedField.Index)], field.Index...),
+					Type:  field.Type,
+				})
+				names[field.Name] = true
+			}
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return lessIndex(fields[i].Index, fields[j].Index)
+	})
+	return fields
+}
+
+// lessIndex returns whether the field with index sequence a is declared before the field with index sequence b.
+func lessIndex(a, b []int) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
+
+// findJsonField looks up a field by its JSON name. Like encoding/json, it prefers an exact match,
+// but falls back to the first field in declaration order that matches case-insensitively.
+func findJsonField(fields []jsonField, key string) (jsonField, bool) {
+	for _, field := range fields {
+		if field.Name == key {
+			return field, true
+		}
+	}
+	for _, field := range fields {
+		if strings.EqualFold(field.Name, key) {
+			return field, true
+		}
+	}
+	return jsonField{}, false
+}
+
+func filterObject(data json.RawMessage, pointer jsonpointer.Pointer, registry *Registry, fieldType func(key string) (reflect.Type, bool)) (json.RawMessage, []unknownField, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if _, err := decoder.Token(); err != nil {
+		return nil, nil, err
+	}
+	var unknown []unknownField
+	var result bytes.Buffer
+	result.WriteByte('{')
+	var first = true
+	for decoder.More() {
+		keyToken, err := decoder.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		key, isString := keyToken.(string)
+		if !isString {
+			return nil, nil, errors.New("expected string key")
+		}
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, nil, err
+		}
+		subpointer := appendPointer(pointer, key)
+		valueType, known := fieldType(key)
+		if !known {
+			unknown = append(unknown, unknownField{Pointer: subpointer, Value: value})
+			continue
+		}
+		filteredValue, subUnknown, err := removeUnknownFields(value, valueType, subpointer, registry)
+		if err != nil {
+			return nil, nil, err
+		}
+		unknown = append(unknown, subUnknown...)
+		if !first {
+			result.WriteByte(',')
+		}
+		first = false
+		encodedKey, _ := json.Marshal(key)
+		result.Write(encodedKey)
+		result.WriteByte(':')
+		result.Write(filteredValue)
+	}
+	result.WriteByte('}')
+	return result.Bytes(), unknown, nil
+}
+
+func filterArray(data json.RawMessage, pointer jsonpointer.Pointer, registry *Registry, elementType reflect.Type) (json.RawMessage, []unknownField, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if _, err := decoder.Token(); err != nil {
+		return nil, nil, err
+	}
+	var unknown []unknownField
+	var result bytes.Buffer
+	result.WriteByte('[')
+	for i := 0; decoder.More(); i++ {
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, nil, err
+		}
+		subpointer := appendPointer(pointer, strconv.Itoa(i))
+		filteredValue, subUnknown, err := removeUnknownFields(value, elementType, subpointer, registry)
+		if err != nil {
+			return nil, nil, err
+		}
+		unknown = append(unknown, subUnknown...)
+		if i > 0 {
+			result.WriteByte(',')
+		}
+		result.Write(filteredValue)
+	}
+	result.WriteByte(']')
+	return result.Bytes(), unknown, nil
+}
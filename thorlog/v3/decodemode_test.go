@@ -0,0 +1,94 @@
+package thorlog
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/NextronSystems/jsonlog/jsonpointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const assessmentWithUnknownFields = `{"type":"THOR assessment","meta":{"time":"2024-09-24T14:18:46+02:00","level":"Alert","module":"Test","scan_id":"abdc","event_id":"","hostname":"host","new_meta":1},"message":"test","subject":{"type":"file","path":"path/to/file","new_field":"value","permissions":{"type":"Unix permissions","owner":"root","acl":[]}},"score":70,"reasons":[{"type":"reason","summary":"Reason 1","signature":{"score":70,"origin":"internal","kind":"","new_sig":true}}],"context":[{"object":{"type":"file","path":"other"},"relations":[{"relation_type":"related to","relation_name":"","unique":false}]}],"log_version":"v3.1.0"}`
+
+func TestEmbeddedObject_UnmarshalJSON_Strict(t *testing.T) {
+	var object EmbeddedObject
+	assert.Error(t, json.Unmarshal([]byte(assessmentWithUnknownFields), &object))
+}
+
+func TestEmbeddedObject_UnmarshalJSON_Lenient(t *testing.T) {
+	var object = EmbeddedObject{Mode: DecodeLenient}
+	require.NoError(t, json.Unmarshal([]byte(assessmentWithUnknownFields), &object))
+
+	assessment, isAssessment := object.Object.(*Assessment)
+	require.True(t, isAssessment)
+	file, isFile := assessment.Subject.(*File)
+	require.True(t, isFile)
+	assert.Equal(t, "path/to/file", file.Path)
+	permissions, isUnixPermissions := file.Permissions.(*UnixPermissions)
+	require.True(t, isUnixPermissions)
+	assert.Equal(t, "root", permissions.Owner)
+	require.Len(t, assessment.Reasons, 1)
+	assert.Equal(t, int64(70), assessment.Reasons[0].Score)
+	require.Len(t, assessment.EventContext, 1)
+
+	assert.Equal(t, UnknownFields{
+		"/meta/new_meta":               json.RawMessage(`1`),
+		"/subject/new_field":           json.RawMessage(`"value"`),
+		"/subject/permissions/acl":     json.RawMessage(`[]`),
+		"/reasons/0/signature/new_sig": json.RawMessage(`true`),
+	}, object.UnknownFields)
+	assert.Nil(t, object.UnknownPointers)
+}
+
+func TestEmbeddedObject_UnmarshalJSON_Collect(t *testing.T) {
+	var object = EmbeddedObject{Mode: DecodeCollect}
+	require.NoError(t, json.Unmarshal([]byte(assessmentWithUnknownFields), &object))
+
+	assert.Len(t, object.UnknownFields, 4)
+	assert.Equal(t, []jsonpointer.Pointer{
+		{"meta", "new_meta"},
+		{"subject", "new_field"},
+		{"subject", "permissions", "acl"},
+		{"reasons", "0", "signature", "new_sig"},
+	}, object.UnknownPointers)
+}
+
+func TestEmbeddedObject_UnmarshalJSON_LenientWithoutUnknownFields(t *testing.T) {
+	message := NewMessage(LogEventMetadata{Mod: "Test"}, "test", "b", 1, "a", "value")
+	jsonform, err := json.Marshal(message)
+	require.NoError(t, err)
+
+	var object = EmbeddedObject{Mode: DecodeCollect}
+	require.NoError(t, json.Unmarshal(jsonform, &object))
+	assert.Nil(t, object.UnknownFields)
+	assert.Nil(t, object.UnknownPointers)
+	parsedMessage, isMessage := object.Object.(*Message)
+	require.True(t, isMessage)
+	assert.Equal(t, []string{"b", "a"}, []string{parsedMessage.Fields[0].Key, parsedMessage.Fields[1].Key})
+}
+
+func TestFindJsonField(t *testing.T) {
+	type inner struct {
+		Lower string `json:"value"`
+		Other string `json:"other"`
+	}
+	type outer struct {
+		inner
+		Upper string `json:"VALUE"`
+		Mixed string `json:"Value"`
+	}
+	fields := jsonFields(reflect.TypeOf(outer{}))
+	for i := 0; i < 10; i++ {
+		field, found := findJsonField(fields, "Value")
+		require.True(t, found)
+		assert.Equal(t, "Value", field.Name)
+		field, found = findJsonField(fields, "vALUE")
+		require.True(t, found)
+		assert.Equal(t, "value", field.Name, "the first field in declaration order should match")
+		assert.Equal(t, []int{0, 0}, field.Index)
+	}
+	_, found := findJsonField(fields, "missing")
+	assert.False(t, found)
+}
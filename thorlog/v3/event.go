@@ -72,17 +72,21 @@ func (a *Assessment) Metadata() *LogEventMetadata {
 }
 
 func (a *Assessment) UnmarshalJSON(data []byte) error {
+	return withObjectType(data, a.unmarshalJSONWith(data, objectDecoder{}))
+}
+
+func (a *Assessment) unmarshalJSONWith(data []byte, d objectDecoder) error {
 	type plainAssessment Assessment
 	var rawAssessment struct {
 		plainAssessment                // Embed without unmarshal method to avoid infinite recursion
 		Subject         EmbeddedObject `json:"subject"` // EmbeddedObject is used to allow unmarshalling of the subject as a ObservedObject
 	}
-	if err := json.Unmarshal(data, &rawAssessment); err != nil {
+	if err := d.unmarshal(data, &rawAssessment); err != nil {
 		return err
 	}
 	subject, ok := rawAssessment.Subject.Object.(ObservedObject)
 	if !ok {
-		return fmt.Errorf("subject must implement the ObservedObject interface")
+		return d.errorAt("subject", unexpectedObjectError(rawAssessment.Subject.Object, "observed object"))
 	}
 	*a = Assessment(rawAssessment.plainAssessment) // Copy the fields from rawAssessment to a
 	a.Subject = subject
@@ -97,7 +101,7 @@ func (a *Assessment) UnmarshalJSON(data []byte) error {
 			}
 			target, err := jsonpointer.Resolve(a.Subject, a.Reasons[i].StringMatches[j].Field.ToJsonPointer())
 			if err != nil {
-				return err
+				return newUnmarshalError(appendPointer(d.pointer, "reasons", strconv.Itoa(i), "matched", strconv.Itoa(j), "field"), err)
 			}
 			a.Reasons[i].StringMatches[j].Field = jsonlog.NewReference(a.Subject, target)
 		}
@@ -108,7 +112,7 @@ func (a *Assessment) UnmarshalJSON(data []byte) error {
 		}
 		target, err := jsonpointer.Resolve(a, a.Issues[i].Affected.ToJsonPointer())
 		if err != nil {
-			return err
+			return newUnmarshalError(appendPointer(d.pointer, "issues", strconv.Itoa(i), "affected"), err)
 		}
 		a.Issues[i].Affected = jsonlog.NewReference(a, target)
 	}
@@ -137,17 +141,21 @@ type Relation struct {
 }
 
 func (c *ContextObject) UnmarshalJSON(data []byte) error {
+	return c.unmarshalJSONWith(data, objectDecoder{})
+}
+
+func (c *ContextObject) unmarshalJSONWith(data []byte, d objectDecoder) error {
 	type plainContextObject ContextObject
 	var rawContextObject struct {
 		Object EmbeddedObject `json:"object"`
 		plainContextObject
 	}
-	if err := json.Unmarshal(data, &rawContextObject); err != nil {
+	if err := d.unmarshal(data, &rawContextObject); err != nil {
 		return err
 	}
 	reportableObject, isReportable := rawContextObject.Object.Object.(ObservedObject)
 	if !isReportable {
-		return fmt.Errorf("object of type %q must implement the ObservedObject interface", rawContextObject.Object.Object.EmbeddedHeader().Type)
+		return d.errorAt("object", unexpectedObjectError(rawContextObject.Object.Object, "observed object"))
 	}
 	*c = ContextObject(rawContextObject.plainContextObject) // Copy the fields from rawContextObject to c
 	c.Object = reportableObject
@@ -1,8 +1,6 @@
 package thorlog
 
 import (
-	"encoding/json"
-	"fmt"
 	"time"
 
 	"github.com/NextronSystems/jsonlog"
@@ -71,6 +69,10 @@ type File struct {
 func (File) observed() {}
 
 func (f *File) UnmarshalJSON(data []byte) error {
+	return withObjectType(data, f.unmarshalJSONWith(data, objectDecoder{}))
+}
+
+func (f *File) unmarshalJSONWith(data []byte, d objectDecoder) error {
 	// Permissions are either unix or windows permissions, so we need to try both
 	type plainFile File
 
@@ -78,13 +80,13 @@ func (f *File) UnmarshalJSON(data []byte) error {
 		plainFile
 		Permissions EmbeddedObject `json:"permissions"`
 	}
-	err := json.Unmarshal(data, &testFile)
+	err := d.unmarshal(data, &testFile)
 	if err != nil {
 		return err
 	}
 	perms, isPermissions := testFile.Permissions.Object.(Permissions)
 	if !isPermissions && testFile.Permissions.Object != nil {
-		return fmt.Errorf("invalid permissions type: %T", testFile.Permissions.Object)
+		return d.errorAt("permissions", unexpectedObjectError(testFile.Permissions.Object, "permissions object"))
 	}
 	*f = File(testFile.plainFile)
 	f.Permissions = perms
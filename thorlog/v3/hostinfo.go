@@ -1,8 +1,6 @@
 package thorlog
 
 import (
-	"encoding/json"
-	"fmt"
 	"time"
 
 	"github.com/NextronSystems/jsonlog"
@@ -37,6 +35,10 @@ func NewHostInfo() *HostInfo {
 }
 
 func (h *HostInfo) UnmarshalJSON(data []byte) error {
+	return withObjectType(data, h.unmarshalJSONWith(data, objectDecoder{}))
+}
+
+func (h *HostInfo) unmarshalJSONWith(data []byte, d objectDecoder) error {
 	// PlatformInfo is an embedded object, so we need to unmarshal first into a struct
 	// that correctly resolves it based on the type.
 	// To do this, we create a struct that has the same fields as HostInfo,
@@ -46,7 +48,7 @@ func (h *HostInfo) UnmarshalJSON(data []byte) error {
 		hostInfoClone
 		Platform EmbeddedObject `json:"platform"`
 	}
-	err := json.Unmarshal(data, &unmarshalableInfo)
+	err := d.unmarshal(data, &unmarshalableInfo)
 	if err != nil {
 		return err
 	}
@@ -54,7 +56,7 @@ func (h *HostInfo) UnmarshalJSON(data []byte) error {
 	if platformInfo, isPlatformInfo := unmarshalableInfo.Platform.Object.(PlatformInfo); isPlatformInfo {
 		h.Platform = platformInfo
 	} else {
-		return fmt.Errorf("platform information has invalid type %s", unmarshalableInfo.Platform.Object.EmbeddedHeader().Type)
+		return d.errorAt("platform", unexpectedObjectError(unmarshalableInfo.Platform.Object, "platform information"))
 	}
 	return nil
 }
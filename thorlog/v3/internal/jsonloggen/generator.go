@@ -0,0 +1,404 @@
+//go:build jsonloggen
+
+package main
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NextronSystems/jsonlog"
+)
+
+// aspect is a group of methods that is generated for a type.
+type aspect int
+
+const (
+	aspectJSON aspect = iota
+	aspectTextlog
+	aspectJsonPointer
+	aspectTextLabel
+	aspectCount
+)
+
+var (
+	objectType           = reflect.TypeOf((*jsonlog.Object)(nil)).Elem()
+	referenceType        = reflect.TypeOf(jsonlog.Reference{})
+	jsonMarshalerType    = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType    = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	jsonNumberType       = reflect.TypeOf(json.Number(""))
+	timeType             = reflect.TypeOf(time.Time{})
+	textlogMarshalerType = reflect.TypeOf((*jsonlog.TextlogMarshaler)(nil)).Elem()
+	jsonResolverType     = reflect.TypeOf((*jsonlog.JsonReferenceResolver)(nil)).Elem()
+	textResolverType     = reflect.TypeOf((*jsonlog.TextReferenceResolver)(nil)).Elem()
+	zeroerType           = reflect.TypeOf((*interface{ IsZero() bool })(nil)).Elem()
+)
+
+// aspectInterfaces contains the interface that corresponds to the generated methods of each aspect.
+var aspectInterfaces = [aspectCount]reflect.Type{
+	aspectJSON:        jsonMarshalerType,
+	aspectTextlog:     textlogMarshalerType,
+	aspectJsonPointer: jsonResolverType,
+	aspectTextLabel:   textResolverType,
+}
+
+// aspectMethods contains the names of the generated methods of each aspect.
+var aspectMethods = [aspectCount]string{
+	aspectJSON:        "appendJSON",
+	aspectTextlog:     "marshalTextLog",
+	aspectJsonPointer: "relativeJsonPointer",
+	aspectTextLabel:   "relativeTextPointer",
+}
+
+// aspectRegistrations contains the fields of jsonlog.Generated that register the generated methods of each aspect.
+// The type name is filled in for %[1]s.
+var aspectRegistrations = [aspectCount]string{
+	aspectJSON:        "AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*%[1]s).appendJSON(buf) },",
+	aspectTextlog:     "MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry { return object.(*%[1]s).marshalTextLog(t) },",
+	aspectJsonPointer: "RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer { return object.(*%[1]s).relativeJsonPointer(pointee) },",
+	aspectTextLabel:   "RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*%[1]s).relativeTextPointer(pointee) },",
+}
+
+// generator generates the methods for a set of struct types.
+//
+// All generated methods are unexported and have pointer receivers. Exported methods would be promoted
+// to structs that embed one of the types, which would then lose their own fields. Instead, the methods are
+// registered with jsonlog.RegisterGenerated, which uses them for values of exactly the generated type only.
+type generator struct {
+	types []reflect.Type
+	// pkgPath is the path of the package that contains the types.
+	pkgPath string
+	// generated contains the types that methods are generated for, by aspect.
+	generated [aspectCount]map[reflect.Type]bool
+	// modifiers maps textlog modifier lists to the names of the variables that contain them.
+	modifiers map[string]string
+
+	buf *bytes.Buffer
+	// vars is used to create unique variable names within a method.
+	vars int
+	// inlined contains the struct types whose fields are currently being inlined.
+	// Recursive types are delegated to the reflection based implementation when they are reached again.
+	inlined []reflect.Type
+	// usesErr is set when the current method needs an err variable.
+	usesErr bool
+}
+
+func newGenerator(types []reflect.Type) *generator {
+	g := &generator{
+		types:     types,
+		modifiers: map[string]string{},
+	}
+	if len(types) > 0 {
+		g.pkgPath = types[0].PkgPath()
+	}
+	for a := range g.generated {
+		g.generated[a] = map[reflect.Type]bool{}
+	}
+	for _, t := range types {
+		if !implementsOriginal(t, jsonMarshalerType) && !implementsOriginal(t, textMarshalerType) && g.jsonSupported(t) {
+			g.generated[aspectJSON][t] = true
+		}
+		if !implementsOriginal(t, textlogMarshalerType) && !mayHaveLowercaseKeys(t, map[reflect.Type]bool{}) {
+			g.generated[aspectTextlog][t] = true
+		}
+		if !implementsOriginal(t, jsonResolverType) {
+			g.generated[aspectJsonPointer][t] = true
+		}
+		if !implementsOriginal(t, textResolverType) {
+			g.generated[aspectTextLabel][t] = true
+		}
+	}
+	return g
+}
+
+// implementsOriginal reports whether t or *t implements iface without the generated methods.
+func implementsOriginal(t reflect.Type, iface reflect.Type) bool {
+	return t.Implements(iface) || reflect.PointerTo(t).Implements(iface)
+}
+
+// method returns the name of the method that implements the given aspect for t, including the generated methods,
+// or "" if t does not implement it.
+func (g *generator) method(t reflect.Type, a aspect) string {
+	if t.Implements(aspectInterfaces[a]) {
+		return aspectInterfaces[a].Method(0).Name
+	}
+	if t.Kind() == reflect.Ptr && g.generated[a][t.Elem()] {
+		return aspectMethods[a]
+	}
+	return ""
+}
+
+// mayHaveLowercaseKeys reports whether the text log entry of t may contain keys that are not upper case.
+// Text log entries returned by a TextlogMarshaler are converted to upper case, so types whose entries
+// contain lower case keys (which can only originate from maps) can't implement TextlogMarshaler without changing their output.
+func mayHaveLowercaseKeys(t reflect.Type, visited map[reflect.Type]bool) bool {
+	if visited[t] {
+		return false
+	}
+	visited[t] = true
+	if t.Implements(textlogMarshalerType) {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Map:
+		return true
+	case reflect.Interface:
+		// Log objects are generated or have upper case keys by convention
+		return !t.Implements(objectType)
+	case reflect.Ptr, reflect.Slice:
+		return mayHaveLowercaseKeys(t.Elem(), visited)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			modifiers := strings.Split(field.Tag.Get("textlog"), ",")
+			if modifiers[0] == "-" {
+				continue
+			}
+			if (field.Anonymous || contains(modifiers[1:], "expand")) && mayHaveLowercaseKeys(field.Type, visited) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// generate returns the source code of the generated methods.
+func (g *generator) generate() []byte {
+	var methods bytes.Buffer
+	g.buf = &methods
+	for _, t := range g.types {
+		if g.generated[aspectJSON][t] {
+			g.writeJSON(t)
+		}
+		if g.generated[aspectTextlog][t] {
+			g.writeTextlog(t)
+		}
+		if g.generated[aspectJsonPointer][t] {
+			g.writeJsonPointer(t)
+		}
+		if g.generated[aspectTextLabel][t] {
+			g.writeTextLabel(t)
+		}
+	}
+
+	g.buf = &methods
+	g.writeRegistration()
+
+	var out bytes.Buffer
+	g.buf = &out
+	g.writeHeader(methods.String(), "github.com/NextronSystems/jsonlog", "github.com/NextronSystems/jsonlog/jsonpointer", "math", "reflect", "strconv")
+	if len(g.modifiers) > 0 {
+		names := make([]string, 0, len(g.modifiers))
+		lists := map[string]string{}
+		for list, name := range g.modifiers {
+			names = append(names, name)
+			lists[name] = list
+		}
+		sort.Strings(names)
+		g.p("// Modifier lists of the textlog tags, passed to TextlogFormatter.Omit and TextlogFormatter.FormatField.")
+		g.p("var (")
+		for _, name := range names {
+			var quoted []string
+			if lists[name] != "" {
+				for _, modifier := range strings.Split(lists[name], ",") {
+					quoted = append(quoted, fmt.Sprintf("%q", modifier))
+				}
+			}
+			g.p("%s = []string{%s}", name, strings.Join(quoted, ", "))
+		}
+		g.p(")")
+		g.p("")
+	}
+	out.Write(methods.Bytes())
+	return formatSource(out.Bytes())
+}
+
+// writeRegistration writes an init function that registers the generated methods with jsonlog.RegisterGenerated.
+func (g *generator) writeRegistration() {
+	g.p("func init() {")
+	for _, t := range g.types {
+		var fields []string
+		for a := aspect(0); a < aspectCount; a++ {
+			if g.generated[a][t] {
+				fields = append(fields, fmt.Sprintf(aspectRegistrations[a], t.Name()))
+			}
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		g.p("jsonlog.RegisterGenerated(reflect.TypeOf((*%s)(nil)), jsonlog.Generated{", t.Name())
+		for _, field := range fields {
+			g.p("%s", field)
+		}
+		g.p("})")
+	}
+	g.p("}")
+	g.p("")
+}
+
+// generateTest returns the source code of the test helpers.
+// For each type with generated methods, it contains a copy of the type without these methods.
+func (g *generator) generateTest() []byte {
+	var body bytes.Buffer
+	g.buf = &body
+	var names []string
+	for _, t := range g.types {
+		for a := aspect(0); a < aspectCount; a++ {
+			if g.generated[a][t] {
+				names = append(names, t.Name())
+				break
+			}
+		}
+	}
+	for _, name := range names {
+		g.p("type reflective%s %s", name, name)
+	}
+	g.p("")
+	g.p("// reflectiveObjects converts log objects with generated methods into types with the same fields,")
+	g.p("// but without the generated methods, so that the reflection based implementations are used for them.")
+	g.p("var reflectiveObjects = map[reflect.Type]func(object jsonlog.Object) any{")
+	for _, name := range names {
+		g.p("reflect.TypeOf(&%s{}): func(object jsonlog.Object) any { return (*reflective%s)(object.(*%s)) },", name, name, name)
+	}
+	g.p("}")
+
+	var out bytes.Buffer
+	g.buf = &out
+	g.writeHeader(body.String(), "github.com/NextronSystems/jsonlog", "reflect")
+	out.Write(body.Bytes())
+	return formatSource(out.Bytes())
+}
+
+// writeHeader writes the file header including all imports out of candidates that are used in body.
+func (g *generator) writeHeader(body string, candidates ...string) {
+	g.p("// Code generated by jsonloggen. DO NOT EDIT.")
+	g.p("")
+	g.p("//go:build !jsonloggen")
+	g.p("")
+	g.p("package thorlog")
+	g.p("")
+	var std, other []string
+	for _, candidate := range candidates {
+		if !strings.Contains(body, importName(candidate)+".") {
+			continue
+		}
+		if strings.Contains(candidate, ".") {
+			other = append(other, candidate)
+		} else {
+			std = append(std, candidate)
+		}
+	}
+	if len(std)+len(other) > 0 {
+		g.p("import (")
+		for _, imp := range std {
+			g.p("%q", imp)
+		}
+		if len(std) > 0 && len(other) > 0 {
+			g.p("")
+		}
+		for _, imp := range other {
+			g.p("%q", imp)
+		}
+		g.p(")")
+		g.p("")
+	}
+}
+
+// importName returns the name that an imported package is referred to by.
+func importName(pkgPath string) string {
+	return pkgPath[strings.LastIndex(pkgPath, "/")+1:]
+}
+
+func formatSource(source []byte) []byte {
+	formatted, err := format.Source(source)
+	if err != nil {
+		panic(fmt.Sprintf("generated invalid code: %v\n%s", err, source))
+	}
+	return formatted
+}
+
+// p writes a line of code.
+func (g *generator) p(format string, args ...any) {
+	fmt.Fprintf(g.buf, format+"\n", args...)
+}
+
+// capture returns the code that is written by f.
+func (g *generator) capture(f func()) string {
+	previous := g.buf
+	var buf bytes.Buffer
+	g.buf = &buf
+	f()
+	g.buf = previous
+	return buf.String()
+}
+
+// startMethod resets the per method state.
+func (g *generator) startMethod() {
+	g.vars = 0
+	g.usesErr = false
+}
+
+// newVar returns a variable name that is unique within the current method.
+func (g *generator) newVar(prefix string) string {
+	name := fmt.Sprintf("%s%d", prefix, g.vars)
+	g.vars++
+	return name
+}
+
+// enter marks t as inlined and reports whether this is possible, i.e. t is not already being inlined.
+func (g *generator) enter(t reflect.Type) bool {
+	for _, inlined := range g.inlined {
+		if inlined == t {
+			return false
+		}
+	}
+	g.inlined = append(g.inlined, t)
+	return true
+}
+
+func (g *generator) leave() {
+	g.inlined = g.inlined[:len(g.inlined)-1]
+}
+
+// sel returns an expression that selects the field name from the struct expression expr.
+func sel(expr string, name string) string {
+	if strings.HasPrefix(expr, "(*") && strings.HasSuffix(expr, ")") {
+		// Selectors dereference pointers automatically
+		expr = expr[2 : len(expr)-1]
+	}
+	return expr + "." + name
+}
+
+// addr returns an expression that evaluates to the address of the addressable expression expr.
+func addr(expr string) string {
+	if strings.HasPrefix(expr, "(*") && strings.HasSuffix(expr, ")") {
+		return expr[2 : len(expr)-1]
+	}
+	return "&" + expr
+}
+
+// deref returns an expression that dereferences the pointer expression expr.
+func deref(expr string) string {
+	if strings.HasPrefix(expr, "&") {
+		return expr[1:]
+	}
+	return "(*" + expr + ")"
+}
+
+func contains(list []string, element string) bool {
+	for _, e := range list {
+		if e == element {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,407 @@
+//go:build jsonloggen
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// jsonField is a field of a struct as it is encoded by encoding/json.
+type jsonField struct {
+	name      string
+	tag       bool
+	index     []int
+	typ       reflect.Type
+	omitEmpty bool
+	omitZero  bool
+	quoted    bool
+}
+
+// jsonTypeFields returns the fields that encoding/json encodes for the struct type t, in encoding order.
+// It follows the same rules as encoding/json for embedded structs and conflicting names.
+func jsonTypeFields(t reflect.Type) []jsonField {
+	var current []jsonField
+	next := []jsonField{{typ: t}}
+	var count, nextCount map[reflect.Type]int
+	visited := map[reflect.Type]bool{}
+	var fields []jsonField
+
+	for len(next) > 0 {
+		current, next = next, current[:0]
+		count, nextCount = nextCount, map[reflect.Type]int{}
+
+		for _, f := range current {
+			if visited[f.typ] {
+				continue
+			}
+			visited[f.typ] = true
+
+			for i := 0; i < f.typ.NumField(); i++ {
+				sf := f.typ.Field(i)
+				if sf.Anonymous {
+					t := sf.Type
+					if t.Kind() == reflect.Ptr {
+						t = t.Elem()
+					}
+					if !sf.IsExported() && t.Kind() != reflect.Struct {
+						continue
+					}
+				} else if !sf.IsExported() {
+					continue
+				}
+				tag := sf.Tag.Get("json")
+				if tag == "-" {
+					continue
+				}
+				name, opts, _ := strings.Cut(tag, ",")
+				if !isValidTag(name) {
+					name = ""
+				}
+				index := make([]int, len(f.index)+1)
+				copy(index, f.index)
+				index[len(f.index)] = i
+
+				ft := sf.Type
+				if ft.Name() == "" && ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				options := strings.Split(opts, ",")
+				quoted := false
+				if contains(options, "string") {
+					switch ft.Kind() {
+					case reflect.Bool,
+						reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+						reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+						reflect.Float32, reflect.Float64,
+						reflect.String:
+						quoted = true
+					}
+				}
+
+				if name != "" || !sf.Anonymous || ft.Kind() != reflect.Struct {
+					tagged := name != ""
+					if name == "" {
+						name = sf.Name
+					}
+					fields = append(fields, jsonField{
+						name:      name,
+						tag:       tagged,
+						index:     index,
+						typ:       ft,
+						omitEmpty: contains(options, "omitempty"),
+						omitZero:  contains(options, "omitzero"),
+						quoted:    quoted,
+					})
+					if count[f.typ] > 1 {
+						// Add a duplicate so that the field is annihilated below
+						fields = append(fields, fields[len(fields)-1])
+					}
+					continue
+				}
+
+				nextCount[ft]++
+				if nextCount[ft] == 1 {
+					next = append(next, jsonField{name: ft.Name(), index: index, typ: ft})
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(fields, func(i, j int) bool {
+		a, b := fields[i], fields[j]
+		if a.name != b.name {
+			return a.name < b.name
+		}
+		if len(a.index) != len(b.index) {
+			return len(a.index) < len(b.index)
+		}
+		if a.tag != b.tag {
+			return a.tag
+		}
+		return compareIndex(a.index, b.index) < 0
+	})
+
+	// Remove fields that are hidden by the Go rules for embedded fields, except that tagged fields are promoted
+	out := fields[:0]
+	for advance, i := 0, 0; i < len(fields); i += advance {
+		fi := fields[i]
+		for advance = 1; i+advance < len(fields); advance++ {
+			if fields[i+advance].name != fi.name {
+				break
+			}
+		}
+		if advance == 1 {
+			out = append(out, fi)
+			continue
+		}
+		dominant := fields[i : i+advance]
+		if len(dominant[0].index) == len(dominant[1].index) && dominant[0].tag == dominant[1].tag {
+			continue
+		}
+		out = append(out, dominant[0])
+	}
+
+	fields = out
+	sort.Slice(fields, func(i, j int) bool {
+		return compareIndex(fields[i].index, fields[j].index) < 0
+	})
+	return fields
+}
+
+func compareIndex(a, b []int) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] - b[i]
+		}
+	}
+	return len(a) - len(b)
+}
+
+func isValidTag(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		switch {
+		case strings.ContainsRune("!#$%&()*+-./:;<=>?@[]^_{|}~ ", c):
+		case !unicode.IsLetter(c) && !unicode.IsDigit(c):
+			return false
+		}
+	}
+	return true
+}
+
+// jsonSupported reports whether the fields of the struct type t can be encoded by generated code.
+func (g *generator) jsonSupported(t reflect.Type) bool {
+	for _, field := range jsonTypeFields(t) {
+		if field.quoted {
+			return false
+		}
+		fieldType := t
+		for _, i := range field.index {
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			structField := fieldType.Field(i)
+			if structField.PkgPath != "" && structField.PkgPath != g.pkgPath {
+				// Unexported field of another package
+				return false
+			}
+			fieldType = structField.Type
+		}
+		if field.omitZero && fieldType.Kind() == reflect.Interface && fieldType.Implements(zeroerType) {
+			return false
+		}
+	}
+	return true
+}
+
+func (g *generator) writeJSON(t reflect.Type) {
+	g.startMethod()
+	body := g.capture(func() {
+		g.jsonStruct("(*o)", t)
+	})
+
+	g.p("// appendJSON appends the JSON encoding of o to buf, like json.Marshal.")
+	g.p("func (o *%s) appendJSON(buf []byte) ([]byte, error) {", t.Name())
+	g.p("if o == nil {")
+	g.p(`return append(buf, "null"...), nil`)
+	g.p("}")
+	if g.usesErr {
+		g.p("var err error")
+	}
+	g.buf.WriteString(body)
+	g.p("return buf, nil")
+	g.p("}")
+	g.p("")
+}
+
+// jsonStruct writes code that appends the struct expr of type t to buf.
+func (g *generator) jsonStruct(expr string, t reflect.Type) {
+	if !g.jsonSupported(t) || !g.enter(t) {
+		g.jsonDelegate(addr(expr))
+		return
+	}
+	defer g.leave()
+
+	start := g.newVar("start")
+	g.p("%s := len(buf)", start)
+	for _, field := range jsonTypeFields(t) {
+		fieldExpr, fieldType := expr, t
+		var blocks int
+		for _, i := range field.index {
+			if fieldType.Kind() == reflect.Ptr {
+				// Fields of nil embedded structs are omitted
+				g.p("if %s != nil {", fieldExpr)
+				blocks++
+				fieldExpr, fieldType = deref(fieldExpr), fieldType.Elem()
+			}
+			structField := fieldType.Field(i)
+			fieldExpr, fieldType = sel(fieldExpr, structField.Name), structField.Type
+		}
+		if conditions := jsonIncludeConditions(fieldExpr, fieldType, field); len(conditions) > 0 {
+			g.p("if %s {", strings.Join(conditions, " && "))
+			blocks++
+		}
+		var key bytes.Buffer
+		json.HTMLEscape(&key, []byte(field.name))
+		g.p("buf = append(buf, %s...)", quote(`,"`+key.String()+`":`))
+		g.jsonValue(fieldExpr, fieldType)
+		for ; blocks > 0; blocks-- {
+			g.p("}")
+		}
+	}
+	g.p("buf = closeJSONObject(buf, %s)", start)
+}
+
+// jsonIncludeConditions returns the conditions that must be fulfilled for the field expr of type t to be included.
+func jsonIncludeConditions(expr string, t reflect.Type, field jsonField) []string {
+	var conditions []string
+	if field.omitEmpty {
+		switch t.Kind() {
+		case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+			conditions = append(conditions, fmt.Sprintf("len(%s) != 0", expr))
+		case reflect.Bool:
+			conditions = append(conditions, expr)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			conditions = append(conditions, fmt.Sprintf("%s != 0", expr))
+		case reflect.Float32, reflect.Float64:
+			// Negative zero is not empty
+			conditions = append(conditions, fmt.Sprintf("math.Float64bits(float64(%s)) != 0", expr))
+		case reflect.Interface, reflect.Ptr:
+			conditions = append(conditions, fmt.Sprintf("%s != nil", expr))
+		}
+	}
+	if field.omitZero {
+		switch {
+		case t.Kind() == reflect.Ptr && t.Implements(zeroerType):
+			conditions = append(conditions, fmt.Sprintf("%s != nil && !%s.IsZero()", expr, expr))
+		case t.Implements(zeroerType), reflect.PointerTo(t).Implements(zeroerType):
+			conditions = append(conditions, fmt.Sprintf("!%s.IsZero()", expr))
+		default:
+			switch t.Kind() {
+			case reflect.Bool:
+				conditions = append(conditions, expr)
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+				reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+				conditions = append(conditions, fmt.Sprintf("%s != 0", expr))
+			case reflect.Float32, reflect.Float64:
+				conditions = append(conditions, fmt.Sprintf("math.Float64bits(float64(%s)) != 0", expr))
+			case reflect.String:
+				conditions = append(conditions, fmt.Sprintf("len(%s) != 0", expr))
+			case reflect.Interface, reflect.Ptr, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+				conditions = append(conditions, fmt.Sprintf("%s != nil", expr))
+			default:
+				conditions = append(conditions, fmt.Sprintf("!reflect.ValueOf(%s).IsZero()", expr))
+			}
+		}
+	}
+	return conditions
+}
+
+// jsonValue writes code that appends the addressable expression expr of type t to buf.
+func (g *generator) jsonValue(expr string, t reflect.Type) {
+	switch {
+	case t.Kind() == reflect.Ptr && g.generated[aspectJSON][t.Elem()]:
+		g.jsonCall(expr + ".appendJSON(buf)")
+		return
+	case g.generated[aspectJSON][t]:
+		g.jsonCall(sel(expr, "appendJSON") + "(buf)")
+		return
+	case t == timeType:
+		g.jsonCall("appendJSONTime(buf, " + expr + ")")
+		return
+	case t == jsonNumberType, implementsOriginal(t, jsonMarshalerType), implementsOriginal(t, textMarshalerType):
+		g.jsonDelegate(addr(expr))
+		return
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		g.p("buf = strconv.AppendBool(buf, %s)", convert(expr, t, "bool"))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		g.p("buf = strconv.AppendInt(buf, %s, 10)", convert(expr, t, "int64"))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		g.p("buf = strconv.AppendUint(buf, %s, 10)", convert(expr, t, "uint64"))
+	case reflect.String:
+		g.p("buf = appendJSONString(buf, %s)", convert(expr, t, "string"))
+	case reflect.Interface:
+		// The dynamic type is only known at runtime, so use the generated methods registered for it, if any
+		g.p("if %s == nil {", expr)
+		g.p(`buf = append(buf, "null"...)`)
+		g.p("} else {")
+		g.jsonCall("jsonlog.AppendJSON(buf, " + expr + ")")
+		g.p("}")
+	case reflect.Ptr:
+		g.p("if %s == nil {", expr)
+		g.p(`buf = append(buf, "null"...)`)
+		g.p("} else {")
+		g.jsonValue(deref(expr), t.Elem())
+		g.p("}")
+	case reflect.Struct:
+		g.jsonStruct(expr, t)
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 && !implementsOriginal(t.Elem(), jsonMarshalerType) && !implementsOriginal(t.Elem(), textMarshalerType) {
+			// Byte slices are encoded as base64
+			g.jsonDelegate(addr(expr))
+			return
+		}
+		g.p("if %s == nil {", expr)
+		g.p(`buf = append(buf, "null"...)`)
+		g.p("} else {")
+		g.jsonArray(expr, t.Elem())
+		g.p("}")
+	case reflect.Array:
+		g.jsonArray(expr, t.Elem())
+	default:
+		g.jsonDelegate(addr(expr))
+	}
+}
+
+// jsonArray writes code that appends the elements of the slice or array expr to buf as a JSON array.
+func (g *generator) jsonArray(expr string, elem reflect.Type) {
+	i := g.newVar("i")
+	g.p("buf = append(buf, '[')")
+	g.p("for %s := range %s {", i, expr)
+	g.p("if %s > 0 {", i)
+	g.p("buf = append(buf, ',')")
+	g.p("}")
+	g.jsonValue(expr+"["+i+"]", elem)
+	g.p("}")
+	g.p("buf = append(buf, ']')")
+}
+
+// convert returns an expression that converts expr of type t to the predeclared type typeName.
+func convert(expr string, t reflect.Type, typeName string) string {
+	if t.PkgPath() == "" && t.Name() == typeName {
+		return expr
+	}
+	return typeName + "(" + expr + ")"
+}
+
+// quote returns s as a Go string literal, preferring raw string literals.
+func quote(s string) string {
+	if strconv.CanBackquote(s) {
+		return "`" + s + "`"
+	}
+	return strconv.Quote(s)
+}
+
+// jsonDelegate writes code that appends value to buf using encoding/json.
+func (g *generator) jsonDelegate(value string) {
+	g.jsonCall("appendJSONValue(buf, " + value + ")")
+}
+
+func (g *generator) jsonCall(call string) {
+	g.usesErr = true
+	g.p("if buf, err = %s; err != nil {", call)
+	g.p("return nil, err")
+	g.p("}")
+}
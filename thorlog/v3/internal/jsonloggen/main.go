@@ -0,0 +1,65 @@
+//go:build jsonloggen
+
+// Command jsonloggen generates reflection-free implementations of the JSON and text log encodings and of the
+// reference lookups for the log object types registered in thorlog/v3. They are registered with jsonlog.RegisterGenerated.
+//
+// The generator inspects the registered types with reflection. To ensure that it sees the types without
+// previously generated methods, it must be built with the jsonloggen tag, which excludes the generated code:
+//
+//	go run -tags jsonloggen ./internal/jsonloggen -output marshal_gen.go -test-output marshal_gen_test.go
+//
+// Methods are only generated for types that do not implement them already. The generated code produces
+// exactly the same output as the reflection based implementations; the test output contains copies of all
+// types without the generated methods, which can be used to verify this.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+
+	thorlog "github.com/NextronSystems/jsonlog/thorlog/v3"
+)
+
+func main() {
+	output := flag.String("output", "marshal_gen.go", "file to write the generated methods to")
+	testOutput := flag.String("test-output", "marshal_gen_test.go", "file to write the generated test helpers to")
+	flag.Parse()
+
+	g := newGenerator(registeredTypes())
+	if err := writeSource(*output, g.generate()); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := writeSource(*testOutput, g.generateTest()); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// registeredTypes returns the struct types of all registered log objects, sorted by name.
+func registeredTypes() []reflect.Type {
+	pkgPath := reflect.TypeOf(thorlog.File{}).PkgPath()
+	var types []reflect.Type
+	seen := map[reflect.Type]bool{}
+	for _, object := range thorlog.LogObjectTypes {
+		t := reflect.TypeOf(object)
+		if t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct || t.Elem().PkgPath() != pkgPath {
+			continue
+		}
+		if !seen[t.Elem()] {
+			seen[t.Elem()] = true
+			types = append(types, t.Elem())
+		}
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].Name() < types[j].Name()
+	})
+	return types
+}
+
+func writeSource(filename string, source []byte) error {
+	return os.WriteFile(filename, source, 0644)
+}
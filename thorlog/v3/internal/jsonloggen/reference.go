@@ -0,0 +1,245 @@
+//go:build jsonloggen
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/NextronSystems/jsonlog"
+)
+
+func (g *generator) writeJsonPointer(t reflect.Type) {
+	g.startMethod()
+	body := g.capture(func() {
+		g.pointerStruct("(*o)", t, nil)
+	})
+
+	g.p("// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.")
+	g.p("func (o *%s) relativeJsonPointer(pointee any) jsonpointer.Pointer {", t.Name())
+	g.p("if o == nil {")
+	g.p("return nil")
+	g.p("}")
+	g.p("if pointee == any(o) {")
+	g.p("return jsonpointer.Pointer{}")
+	g.p("}")
+	g.buf.WriteString(body)
+	g.p("return nil")
+	g.p("}")
+	g.p("")
+}
+
+// pointerStruct writes code that returns the JSON pointer to pointee if it is found in the struct expr of type t.
+// path contains expressions for the tokens of the JSON pointer to expr.
+func (g *generator) pointerStruct(expr string, t reflect.Type, path []string) {
+	if !g.enter(t) {
+		g.pointerDelegate(addr(expr), path)
+		return
+	}
+	defer g.leave()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fieldPath := path
+		if !field.Anonymous {
+			fieldPath = append(path[:len(path):len(path)], strconv.Quote(strings.SplitN(field.Tag.Get("json"), ",", 2)[0]))
+		}
+		g.pointerWalk(addr(sel(expr, field.Name)), reflect.PointerTo(field.Type), fieldPath)
+	}
+}
+
+// pointerWalk writes code that returns the JSON pointer to pointee if it is found in expr of type t.
+// The code mirrors the reflection based search in jsonlog.RelativeJsonPointer.
+func (g *generator) pointerWalk(expr string, t reflect.Type, path []string) {
+	if t.Kind() == reflect.Interface {
+		g.pointerDelegate(expr, path)
+		return
+	}
+	if t.Kind() == reflect.Ptr {
+		g.p("if pointee == any(%s) {", expr)
+		g.p("return %s", pointerLiteral(path))
+		g.p("}")
+	}
+	if method := g.method(t, aspectJsonPointer); method != "" {
+		g.p("if p := %s(pointee); p != nil {", sel(deref(expr), method))
+		g.p("return %s", appendPointer(path, "p"))
+		g.p("}")
+		return
+	}
+	if t == referenceType {
+		// Other references are not searched
+		return
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		body := g.capture(func() {
+			g.pointerWalk(deref(expr), t.Elem(), path)
+		})
+		if strings.HasPrefix(expr, "&") {
+			// Addresses of variables are never nil
+			g.buf.WriteString(body)
+		} else if body != "" {
+			g.p("if %s != nil {", expr)
+			g.buf.WriteString(body)
+			g.p("}")
+		}
+	case reflect.Struct:
+		g.pointerStruct(expr, t, path)
+	case reflect.Slice, reflect.Array:
+		i := g.newVar("i")
+		body := g.capture(func() {
+			g.pointerWalk(addr(expr+"["+i+"]"), reflect.PointerTo(t.Elem()), append(path[:len(path):len(path)], "strconv.Itoa("+i+")"))
+		})
+		if body != "" {
+			g.p("for %s := range %s {", i, expr)
+			g.buf.WriteString(body)
+			g.p("}")
+		}
+	}
+}
+
+// pointerDelegate writes code that searches pointee in value using reflection.
+func (g *generator) pointerDelegate(value string, path []string) {
+	g.p("if p := jsonlog.RelativeJsonPointer(%s, pointee); p != nil {", value)
+	g.p("return %s", appendPointer(path, "p"))
+	g.p("}")
+}
+
+func pointerLiteral(path []string) string {
+	return "jsonpointer.Pointer{" + strings.Join(path, ", ") + "}"
+}
+
+// appendPointer returns an expression that appends the pointer expression pointer to path.
+func appendPointer(path []string, pointer string) string {
+	if len(path) == 0 {
+		return pointer
+	}
+	return fmt.Sprintf("append(%s, %s...)", pointerLiteral(path), pointer)
+}
+
+// labelTransform describes how the text label of a field is derived from the label within the field.
+type labelTransform struct {
+	label  string
+	expand bool
+}
+
+func (g *generator) writeTextLabel(t reflect.Type) {
+	g.startMethod()
+	body := g.capture(func() {
+		g.labelStruct("(*o)", t, nil)
+	})
+
+	g.p("// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.")
+	g.p("func (o *%s) relativeTextPointer(pointee any) (string, bool) {", t.Name())
+	g.p("if o == nil {")
+	g.p(`return "", false`)
+	g.p("}")
+	g.p("if pointee == any(o) {")
+	g.p(`return "", true`)
+	g.p("}")
+	g.buf.WriteString(body)
+	g.p(`return "", false`)
+	g.p("}")
+	g.p("")
+}
+
+// labelStruct writes code that returns the text label of pointee if it is found in the struct expr of type t.
+// The code mirrors the reflection based search in jsonlog.RelativeTextLabel.
+func (g *generator) labelStruct(expr string, t reflect.Type, transforms []labelTransform) {
+	if !g.enter(t) {
+		g.labelDelegate(addr(expr), transforms)
+		return
+	}
+	defer g.leave()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		modifiers := strings.Split(field.Tag.Get("textlog"), ",")
+		fieldTransforms := append(transforms[:len(transforms):len(transforms)], labelTransform{
+			label:  strings.ToUpper(modifiers[0]),
+			expand: contains(modifiers[1:], "expand"),
+		})
+		fieldExpr := sel(expr, field.Name)
+		fieldPointer, fieldPointerType := addr(fieldExpr), reflect.PointerTo(field.Type)
+		if field.Type.Kind() == reflect.Ptr {
+			fieldPointer, fieldPointerType = fieldExpr, field.Type
+		}
+
+		g.p("if pointee == any(%s) {", fieldPointer)
+		g.p("return %s, true", strconv.Quote(textLabel("", fieldTransforms)))
+		g.p("}")
+		if field.Type.Kind() == reflect.Ptr {
+			// The pointer field itself is referenced
+			g.p("if pointee == any(%s) {", addr(fieldExpr))
+			g.p("return %s, true", strconv.Quote(textLabel("", fieldTransforms)))
+			g.p("}")
+		}
+		if method := g.method(fieldPointerType, aspectTextLabel); method != "" {
+			g.p("if label, ok := %s(pointee); ok {", sel(deref(fieldPointer), method))
+			g.p("return %s, true", labelExpr("label", fieldTransforms))
+			g.p("}")
+			continue
+		}
+		switch field.Type.Kind() {
+		case reflect.Ptr:
+			if field.Type.Elem().Kind() != reflect.Struct {
+				continue
+			}
+			body := g.capture(func() {
+				g.labelStruct(deref(fieldExpr), field.Type.Elem(), fieldTransforms)
+			})
+			if body != "" {
+				g.p("if %s != nil {", fieldExpr)
+				g.buf.WriteString(body)
+				g.p("}")
+			}
+		case reflect.Interface:
+			g.labelDelegate(addr(fieldExpr), fieldTransforms)
+		case reflect.Struct:
+			g.labelStruct(fieldExpr, field.Type, fieldTransforms)
+		}
+	}
+}
+
+// labelDelegate writes code that searches pointee in value using reflection.
+func (g *generator) labelDelegate(value string, transforms []labelTransform) {
+	g.p("if label, ok := jsonlog.RelativeTextLabel(%s, pointee); ok {", value)
+	g.p("return %s, true", labelExpr("label", transforms))
+	g.p("}")
+}
+
+// textLabel applies transforms to a label found within a field.
+func textLabel(label string, transforms []labelTransform) string {
+	for i := len(transforms) - 1; i >= 0; i-- {
+		transform := transforms[i]
+		if transform.expand {
+			label = jsonlog.ConcatTextLabels(transform.label, label)
+		} else if label == "" {
+			label = transform.label
+		}
+	}
+	return label
+}
+
+// labelExpr returns an expression that applies transforms to the label in the variable label.
+func labelExpr(label string, transforms []labelTransform) string {
+	for i := len(transforms) - 1; i >= 0; i-- {
+		transform := transforms[i]
+		switch {
+		case transform.label == "":
+		case transform.expand:
+			label = fmt.Sprintf("jsonlog.ConcatTextLabels(%q, %s)", transform.label, label)
+		default:
+			label = fmt.Sprintf("textLabelOr(%s, %q)", label, transform.label)
+		}
+	}
+	return label
+}
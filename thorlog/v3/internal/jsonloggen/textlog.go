@@ -0,0 +1,233 @@
+//go:build jsonloggen
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/NextronSystems/jsonlog"
+)
+
+// keyOp is an operation that is applied to the keys of nested text log entries.
+// Nested entries are either prefixed with the label of the field that contains them,
+// or suffixed with the variable that contains the index of the slice element that contains them.
+type keyOp struct {
+	prefix      string
+	suffixIndex string
+}
+
+func (g *generator) writeTextlog(t reflect.Type) {
+	g.startMethod()
+	body := g.capture(func() {
+		g.textlogStruct("(*o)", t, nil)
+	})
+
+	g.p("// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.")
+	g.p("func (o *%s) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {", t.Name())
+	g.p("if o == nil {")
+	g.p("return nil")
+	g.p("}")
+	g.p("var entry jsonlog.TextlogEntry")
+	g.buf.WriteString(body)
+	g.p("return entry")
+	g.p("}")
+	g.p("")
+}
+
+// textlogStruct writes code that appends the fields of the struct expr of type t to entry.
+func (g *generator) textlogStruct(expr string, t reflect.Type, ops []keyOp) {
+	if !g.enter(t) {
+		g.textlogDelegate(addr(expr), ops)
+		return
+	}
+	defer g.leave()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get("textlog")
+		modifiers := strings.Split(tag, ",")
+		key := strings.ToUpper(modifiers[0])
+		modifiers = modifiers[1:]
+		if key == "-" {
+			continue
+		}
+		if !field.Anonymous && tag == "" && !contains(modifiers, "explicit") {
+			continue
+		}
+		fieldExpr := sel(expr, field.Name)
+
+		var body string
+		if field.Anonymous || contains(modifiers, "expand") {
+			body = g.capture(func() {
+				g.textlogExpand(fieldExpr, field.Type, append(ops[:len(ops):len(ops)], keyOp{prefix: key}))
+			})
+		} else {
+			body = g.capture(func() {
+				g.textlogValue(fieldExpr, field.Type, textlogKey(strconv.Quote(key), key, true, ops), g.modifierVar(modifiers))
+			})
+		}
+		if body == "" {
+			continue
+		}
+		var conditions []string
+		if contains(modifiers, "omitempty") {
+			conditions = append(conditions, "!("+textlogZero(fieldExpr, field.Type)+")")
+		}
+		conditions = append(conditions, fmt.Sprintf("(t.Omit == nil || !t.Omit(%s, %s))", g.modifierVar(modifiers), fieldExpr))
+		g.p("if %s {", strings.Join(conditions, " && "))
+		g.buf.WriteString(body)
+		g.p("}")
+	}
+}
+
+// textlogExpand writes code that appends the entry of the expanded field expr of type t to entry.
+func (g *generator) textlogExpand(expr string, t reflect.Type, ops []keyOp) {
+	switch {
+	case t.Kind() == reflect.Interface:
+		g.textlogDelegate(expr, ops)
+	case t.Kind() == reflect.Ptr && g.generated[aspectTextlog][t.Elem()]:
+		g.textlogEntries(expr+".marshalTextLog(t)", ops)
+	case t.Implements(textlogMarshalerType):
+		g.textlogDelegate(expr, ops)
+	case t.Kind() == reflect.Ptr:
+		body := g.capture(func() {
+			g.textlogExpand(deref(expr), t.Elem(), ops)
+		})
+		if body != "" {
+			g.p("if %s != nil {", expr)
+			g.buf.WriteString(body)
+			g.p("}")
+		}
+	case t.Kind() == reflect.Struct:
+		g.textlogStruct(expr, t, ops)
+	case t.Kind() == reflect.Slice:
+		i, index := g.newVar("i"), g.newVar("index")
+		body := g.capture(func() {
+			g.textlogExpand(expr+"["+i+"]", t.Elem(), append(ops[:len(ops):len(ops)], keyOp{suffixIndex: index}))
+		})
+		if body == "" {
+			return
+		}
+		g.p("for %s := range %s {", i, expr)
+		if strings.Contains(body, index) {
+			g.p("%s := strconv.Itoa(%s + 1)", index, i)
+		}
+		g.buf.WriteString(body)
+		g.p("}")
+	case t.Kind() == reflect.Map:
+		g.textlogDelegate(expr, ops)
+	default:
+		// Other types have no entries when they are expanded
+	}
+}
+
+// textlogValue writes code that appends the field expr of type t as a single value to entry.
+func (g *generator) textlogValue(expr string, t reflect.Type, key string, modifiers string) {
+	value := expr
+	if t.Kind() == reflect.Ptr {
+		// Pointers are formatted by the value they point to
+		value = g.newVar("value")
+		g.p("var %s any = %s", value, expr)
+		g.p("if %s != nil {", expr)
+		g.p("%s = %s", value, deref(expr))
+		g.p("}")
+	}
+	g.p("entry = append(entry, jsonlog.TextlogValuePair{Key: %s, Value: t.FormatField(%s, %s)})", key, value, modifiers)
+}
+
+// textlogDelegate writes code that appends the entry of value to entry, using the reflection based formatter.
+func (g *generator) textlogDelegate(value string, ops []keyOp) {
+	g.textlogEntries("t.FormatEntry("+value+")", ops)
+}
+
+// textlogEntries writes code that appends the entry that is returned by call to entry, with ops applied to the keys.
+func (g *generator) textlogEntries(call string, ops []keyOp) {
+	g.p("for _, pair := range %s {", call)
+	g.p("entry = append(entry, jsonlog.TextlogValuePair{Key: %s, Value: pair.Value})", textlogKey("pair.Key", "", false, ops))
+	g.p("}")
+}
+
+// textlogKey returns an expression for a key that results from applying ops to the key expr.
+// If static is set, expr is a constant with the value constant, and the operations are applied at generation time where possible.
+func textlogKey(expr string, constant string, static bool, ops []keyOp) string {
+	for i := len(ops) - 1; i >= 0; i-- {
+		op := ops[i]
+		switch {
+		case op.suffixIndex != "":
+			if static && constant == "" {
+				expr = op.suffixIndex
+			} else {
+				expr = fmt.Sprintf("jsonlog.ConcatTextLabels(%s, %s)", expr, op.suffixIndex)
+			}
+			static = false
+		case op.prefix == "":
+		case static:
+			constant = jsonlog.ConcatTextLabels(op.prefix, constant)
+			expr = strconv.Quote(constant)
+		default:
+			expr = fmt.Sprintf("jsonlog.ConcatTextLabels(%q, %s)", op.prefix, expr)
+		}
+	}
+	return expr
+}
+
+// textlogZero returns an expression that reports whether the field expr of type t is empty for the omitempty modifier.
+func textlogZero(expr string, t reflect.Type) string {
+	var zero string
+	switch t.Kind() {
+	case reflect.Interface:
+		return fmt.Sprintf("%s == nil || hasZeroMethod(%s)", expr, expr)
+	case reflect.Bool:
+		zero = "!" + expr
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		zero = expr + " == 0"
+	case reflect.String:
+		zero = expr + ` == ""`
+	case reflect.Ptr, reflect.Chan, reflect.Func, reflect.Map, reflect.Slice, reflect.UnsafePointer:
+		zero = expr + " == nil"
+	default:
+		if t.Comparable() {
+			zero = "isComparableZero(" + expr + ")"
+		} else {
+			zero = "reflect.ValueOf(" + expr + ").IsZero()"
+		}
+	}
+	if t.Implements(zeroerType) {
+		zero += " || " + expr + ".IsZero()"
+	}
+	return zero
+}
+
+// modifierVar returns the name of the variable that contains the given modifier list.
+func (g *generator) modifierVar(modifiers []string) string {
+	list := strings.Join(modifiers, ",")
+	if name, ok := g.modifiers[list]; ok {
+		return name
+	}
+	name := "textlogModifiers"
+	if len(modifiers) == 0 {
+		name += "None"
+	}
+	for _, modifier := range modifiers {
+		for _, part := range strings.FieldsFunc(modifier, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }) {
+			name += strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+	for _, existing := range g.modifiers {
+		if existing == name {
+			name += strconv.Itoa(len(g.modifiers))
+			break
+		}
+	}
+	g.modifiers[list] = name
+	return name
+}
@@ -20,4 +20,6 @@ const (
 	IssueCategoryTruncated = "truncated"
 	// IssueCategoryOutOfRange indicates that a value can't be represented in the format that the log uses.
 	IssueCategoryOutOfRange = "out_of_range"
+	// IssueCategoryNotConverted indicates that a value could not be converted from an older log version.
+	IssueCategoryNotConverted = "not_converted"
 )
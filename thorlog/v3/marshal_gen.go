@@ -0,0 +1,14425 @@
+// Code generated by jsonloggen. DO NOT EDIT.
+
+//go:build !jsonloggen
+
+package thorlog
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/jsonpointer"
+)
+
+// Modifier lists of the textlog tags, passed to TextlogFormatter.Omit and TextlogFormatter.FormatField.
+var (
+	textlogModifiersExpand              = []string{"expand"}
+	textlogModifiersExpandOmitempty     = []string{"expand", "omitempty"}
+	textlogModifiersInline              = []string{"inline"}
+	textlogModifiersNone                = []string{}
+	textlogModifiersOmitempty           = []string{"omitempty"}
+	textlogModifiersOmitemptyExpand     = []string{"omitempty", "expand"}
+	textlogModifiersOmitemptyWithMillis = []string{"omitempty", "with_millis"}
+	textlogModifiersOmitincontext       = []string{"omitincontext"}
+	textlogModifiersWithMillis          = []string{"with_millis"}
+)
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *AmcacheEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"file":`...)
+	if buf, err = o.File.appendJSON(buf); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"sha1":`...)
+	buf = appendJSONString(buf, o.SHA1)
+	buf = append(buf, `,"size":`...)
+	buf = strconv.AppendInt(buf, o.Size, 10)
+	buf = append(buf, `,"desc":`...)
+	buf = appendJSONString(buf, o.Desc)
+	buf = append(buf, `,"first_run":`...)
+	if buf, err = appendJSONTime(buf, o.FirstRun); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"created":`...)
+	if buf, err = appendJSONTime(buf, o.Created); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"product":`...)
+	buf = appendJSONString(buf, o.Product)
+	buf = append(buf, `,"company":`...)
+	buf = appendJSONString(buf, o.Company)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *AmcacheEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.File) {
+		for _, pair := range o.File.marshalTextLog(t) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("FILE", pair.Key), Value: pair.Value})
+		}
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.SHA1) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SHA1", Value: t.FormatField(o.SHA1, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Size) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SIZE", Value: t.FormatField(o.Size, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Desc) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "DESC", Value: t.FormatField(o.Desc, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.FirstRun) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FIRST_RUN", Value: t.FormatField(o.FirstRun, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Created) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "CREATED", Value: t.FormatField(o.Created, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Product) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PRODUCT", Value: t.FormatField(o.Product, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Company) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "COMPANY", Value: t.FormatField(o.Company, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *AmcacheEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.File) {
+		return jsonpointer.Pointer{"file"}
+	}
+	if pointee == any(o.File) {
+		return jsonpointer.Pointer{"file"}
+	}
+	if p := o.File.relativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"file"}, p...)
+	}
+	if pointee == any(&o.SHA1) {
+		return jsonpointer.Pointer{"sha1"}
+	}
+	if pointee == any(&o.Size) {
+		return jsonpointer.Pointer{"size"}
+	}
+	if pointee == any(&o.Desc) {
+		return jsonpointer.Pointer{"desc"}
+	}
+	if pointee == any(&o.FirstRun) {
+		return jsonpointer.Pointer{"first_run"}
+	}
+	if pointee == any(&o.Created) {
+		return jsonpointer.Pointer{"created"}
+	}
+	if pointee == any(&o.Product) {
+		return jsonpointer.Pointer{"product"}
+	}
+	if pointee == any(&o.Company) {
+		return jsonpointer.Pointer{"company"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *AmcacheEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(o.File) {
+		return "FILE", true
+	}
+	if pointee == any(&o.File) {
+		return "FILE", true
+	}
+	if label, ok := o.File.relativeTextPointer(pointee); ok {
+		return jsonlog.ConcatTextLabels("FILE", label), true
+	}
+	if pointee == any(&o.SHA1) {
+		return "SHA1", true
+	}
+	if pointee == any(&o.Size) {
+		return "SIZE", true
+	}
+	if pointee == any(&o.Desc) {
+		return "DESC", true
+	}
+	if pointee == any(&o.FirstRun) {
+		return "FIRST_RUN", true
+	}
+	if pointee == any(&o.Created) {
+		return "CREATED", true
+	}
+	if pointee == any(&o.Product) {
+		return "PRODUCT", true
+	}
+	if pointee == any(&o.Company) {
+		return "COMPANY", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *AntiVirusExclude) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"exclusion_type":`...)
+	buf = appendJSONString(buf, o.Type)
+	buf = append(buf, `,"exclusion":`...)
+	buf = appendJSONString(buf, o.Exclusion)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *AntiVirusExclude) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Type) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TYPE", Value: t.FormatField(o.Type, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Exclusion) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "EXCLUSION", Value: t.FormatField(o.Exclusion, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *AntiVirusExclude) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Type) {
+		return jsonpointer.Pointer{"exclusion_type"}
+	}
+	if pointee == any(&o.Exclusion) {
+		return jsonpointer.Pointer{"exclusion"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *AntiVirusExclude) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Type) {
+		return "TYPE", true
+	}
+	if pointee == any(&o.Exclusion) {
+		return "EXCLUSION", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *AntiVirusProduct) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"name":`...)
+	buf = appendJSONString(buf, o.Name)
+	buf = append(buf, `,"status":`...)
+	buf = appendJSONString(buf, o.Status)
+	buf = append(buf, `,"signature_status":`...)
+	buf = appendJSONString(buf, o.SignatureStatus)
+	buf = append(buf, `,"path":`...)
+	buf = appendJSONString(buf, o.Path)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *AntiVirusProduct) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Name) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NAME", Value: t.FormatField(o.Name, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Status) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "STATUS", Value: t.FormatField(o.Status, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.SignatureStatus) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SIGNATURE_STATUS", Value: t.FormatField(o.SignatureStatus, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Path) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PATH", Value: t.FormatField(o.Path, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *AntiVirusProduct) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Name) {
+		return jsonpointer.Pointer{"name"}
+	}
+	if pointee == any(&o.Status) {
+		return jsonpointer.Pointer{"status"}
+	}
+	if pointee == any(&o.SignatureStatus) {
+		return jsonpointer.Pointer{"signature_status"}
+	}
+	if pointee == any(&o.Path) {
+		return jsonpointer.Pointer{"path"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *AntiVirusProduct) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Name) {
+		return "NAME", true
+	}
+	if pointee == any(&o.Status) {
+		return "STATUS", true
+	}
+	if pointee == any(&o.SignatureStatus) {
+		return "SIGNATURE_STATUS", true
+	}
+	if pointee == any(&o.Path) {
+		return "PATH", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *Assessment) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"meta":`...)
+	start1 := len(buf)
+	buf = append(buf, `,"time":`...)
+	if buf, err = appendJSONTime(buf, o.Meta.Time); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"level":`...)
+	buf = appendJSONString(buf, string(o.Meta.Lvl))
+	buf = append(buf, `,"module":`...)
+	buf = appendJSONString(buf, o.Meta.Mod)
+	buf = append(buf, `,"scan_id":`...)
+	buf = appendJSONString(buf, o.Meta.ScanID)
+	if len(o.Meta.GenID) != 0 {
+		buf = append(buf, `,"event_id":`...)
+		buf = appendJSONString(buf, o.Meta.GenID)
+	}
+	buf = append(buf, `,"hostname":`...)
+	buf = appendJSONString(buf, o.Meta.Source)
+	buf = closeJSONObject(buf, start1)
+	buf = append(buf, `,"message":`...)
+	buf = appendJSONString(buf, o.Text)
+	buf = append(buf, `,"score":`...)
+	buf = strconv.AppendInt(buf, o.Score, 10)
+	buf = append(buf, `,"subject":`...)
+	if o.Subject == nil {
+		buf = append(buf, "null"...)
+	} else {
+		if buf, err = jsonlog.AppendJSON(buf, o.Subject); err != nil {
+			return nil, err
+		}
+	}
+	buf = append(buf, `,"reasons":`...)
+	if o.Reasons == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i2 := range o.Reasons {
+			if i2 > 0 {
+				buf = append(buf, ',')
+			}
+			if buf, err = o.Reasons[i2].appendJSON(buf); err != nil {
+				return nil, err
+			}
+		}
+		buf = append(buf, ']')
+	}
+	if o.ReasonCount != 0 {
+		buf = append(buf, `,"reason_count":`...)
+		buf = strconv.AppendInt(buf, int64(o.ReasonCount), 10)
+	}
+	buf = append(buf, `,"context":`...)
+	if o.EventContext == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i3 := range o.EventContext {
+			if i3 > 0 {
+				buf = append(buf, ',')
+			}
+			start4 := len(buf)
+			buf = append(buf, `,"object":`...)
+			if o.EventContext[i3].Object == nil {
+				buf = append(buf, "null"...)
+			} else {
+				if buf, err = jsonlog.AppendJSON(buf, o.EventContext[i3].Object); err != nil {
+					return nil, err
+				}
+			}
+			buf = append(buf, `,"relations":`...)
+			if o.EventContext[i3].Relations == nil {
+				buf = append(buf, "null"...)
+			} else {
+				buf = append(buf, '[')
+				for i5 := range o.EventContext[i3].Relations {
+					if i5 > 0 {
+						buf = append(buf, ',')
+					}
+					start6 := len(buf)
+					buf = append(buf, `,"relation_type":`...)
+					buf = appendJSONString(buf, o.EventContext[i3].Relations[i5].Type)
+					buf = append(buf, `,"relation_name":`...)
+					buf = appendJSONString(buf, o.EventContext[i3].Relations[i5].Name)
+					buf = append(buf, `,"unique":`...)
+					buf = strconv.AppendBool(buf, o.EventContext[i3].Relations[i5].Unique)
+					buf = closeJSONObject(buf, start6)
+				}
+				buf = append(buf, ']')
+			}
+			buf = closeJSONObject(buf, start4)
+		}
+		buf = append(buf, ']')
+	}
+	if len(o.Issues) != 0 {
+		buf = append(buf, `,"issues":`...)
+		if o.Issues == nil {
+			buf = append(buf, "null"...)
+		} else {
+			buf = append(buf, '[')
+			for i7 := range o.Issues {
+				if i7 > 0 {
+					buf = append(buf, ',')
+				}
+				start8 := len(buf)
+				buf = append(buf, `,"affected":`...)
+				if buf, err = appendJSONValue(buf, &o.Issues[i7].Affected); err != nil {
+					return nil, err
+				}
+				buf = append(buf, `,"category":`...)
+				buf = appendJSONString(buf, o.Issues[i7].Category)
+				buf = append(buf, `,"description":`...)
+				buf = appendJSONString(buf, o.Issues[i7].Description)
+				buf = closeJSONObject(buf, start8)
+			}
+			buf = append(buf, ']')
+		}
+	}
+	buf = append(buf, `,"log_version":`...)
+	buf = appendJSONString(buf, string(o.LogVersion))
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *Assessment) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.Meta) {
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Meta.Mod) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "MODULE", Value: t.FormatField(o.Meta.Mod, textlogModifiersNone)})
+		}
+		if !(o.Meta.ScanID == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Meta.ScanID)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "SCANID", Value: t.FormatField(o.Meta.ScanID, textlogModifiersOmitempty)})
+		}
+		if !(o.Meta.GenID == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Meta.GenID)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "UID", Value: t.FormatField(o.Meta.GenID, textlogModifiersOmitempty)})
+		}
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Text) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "MESSAGE", Value: t.FormatField(o.Text, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Score) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SCORE", Value: t.FormatField(o.Score, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.Subject) {
+		for _, pair := range t.FormatEntry(o.Subject) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: pair.Key, Value: pair.Value})
+		}
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.Reasons) {
+		for i0 := range o.Reasons {
+			index1 := strconv.Itoa(i0 + 1)
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Reasons[i0].Summary) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("REASON", index1), Value: t.FormatField(o.Reasons[i0].Summary, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersInline, o.Reasons[i0].Signature) {
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Reasons[i0].Signature.Score) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("SUBSCORE", index1), Value: t.FormatField(o.Reasons[i0].Signature.Score, textlogModifiersNone)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Reasons[i0].Signature.Ref) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("REF", index1), Value: t.FormatField(o.Reasons[i0].Signature.Ref, textlogModifiersNone)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Reasons[i0].Signature.Type) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("SIGTYPE", index1), Value: t.FormatField(o.Reasons[i0].Signature.Type, textlogModifiersNone)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Reasons[i0].Signature.Class) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("SIGCLASS", index1), Value: t.FormatField(o.Reasons[i0].Signature.Class, textlogModifiersNone)})
+				}
+				if !(o.Reasons[i0].Signature.Date == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Reasons[i0].Signature.Date)) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("RULEDATE", index1), Value: t.FormatField(o.Reasons[i0].Signature.Date, textlogModifiersOmitempty)})
+				}
+				if !(o.Reasons[i0].Signature.Tags == nil) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Reasons[i0].Signature.Tags)) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("TAGS", index1), Value: t.FormatField(o.Reasons[i0].Signature.Tags, textlogModifiersOmitempty)})
+				}
+				if !(o.Reasons[i0].Signature.Rulename == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Reasons[i0].Signature.Rulename)) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("RULENAME", index1), Value: t.FormatField(o.Reasons[i0].Signature.Rulename, textlogModifiersOmitempty)})
+				}
+				if !(o.Reasons[i0].Signature.LongDescription == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Reasons[i0].Signature.LongDescription)) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("DESCRIPTION", index1), Value: t.FormatField(o.Reasons[i0].Signature.LongDescription, textlogModifiersOmitempty)})
+				}
+				if !(o.Reasons[i0].Signature.Author == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Reasons[i0].Signature.Author)) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("AUTHOR", index1), Value: t.FormatField(o.Reasons[i0].Signature.Author, textlogModifiersOmitempty)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Reasons[i0].Signature.RuleId) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("ID", index1), Value: t.FormatField(o.Reasons[i0].Signature.RuleId, textlogModifiersNone)})
+				}
+				if !(o.Reasons[i0].Signature.FalsePositives == nil) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Reasons[i0].Signature.FalsePositives)) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("FALSEPOSITIVES", index1), Value: t.FormatField(o.Reasons[i0].Signature.FalsePositives, textlogModifiersOmitempty)})
+				}
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Reasons[i0].StringMatches) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("MATCHED", index1), Value: t.FormatField(o.Reasons[i0].StringMatches, textlogModifiersNone)})
+			}
+		}
+	}
+	if !(o.ReasonCount == 0) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.ReasonCount)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "REASONS_COUNT", Value: t.FormatField(o.ReasonCount, textlogModifiersOmitempty)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.EventContext) {
+		for _, pair := range t.FormatEntry(o.EventContext) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: pair.Key, Value: pair.Value})
+		}
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *Assessment) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Meta) {
+		return jsonpointer.Pointer{"meta"}
+	}
+	if pointee == any(&o.Meta.Time) {
+		return jsonpointer.Pointer{"meta", "time"}
+	}
+	if pointee == any(&o.Meta.Lvl) {
+		return jsonpointer.Pointer{"meta", "level"}
+	}
+	if pointee == any(&o.Meta.Mod) {
+		return jsonpointer.Pointer{"meta", "module"}
+	}
+	if pointee == any(&o.Meta.ScanID) {
+		return jsonpointer.Pointer{"meta", "scan_id"}
+	}
+	if pointee == any(&o.Meta.GenID) {
+		return jsonpointer.Pointer{"meta", "event_id"}
+	}
+	if pointee == any(&o.Meta.Source) {
+		return jsonpointer.Pointer{"meta", "hostname"}
+	}
+	if pointee == any(&o.Text) {
+		return jsonpointer.Pointer{"message"}
+	}
+	if pointee == any(&o.Score) {
+		return jsonpointer.Pointer{"score"}
+	}
+	if pointee == any(&o.Subject) {
+		return jsonpointer.Pointer{"subject"}
+	}
+	if p := jsonlog.RelativeJsonPointer(o.Subject, pointee); p != nil {
+		return append(jsonpointer.Pointer{"subject"}, p...)
+	}
+	if pointee == any(&o.Reasons) {
+		return jsonpointer.Pointer{"reasons"}
+	}
+	for i0 := range o.Reasons {
+		if pointee == any(&o.Reasons[i0]) {
+			return jsonpointer.Pointer{"reasons", strconv.Itoa(i0)}
+		}
+		if p := o.Reasons[i0].relativeJsonPointer(pointee); p != nil {
+			return append(jsonpointer.Pointer{"reasons", strconv.Itoa(i0)}, p...)
+		}
+	}
+	if pointee == any(&o.ReasonCount) {
+		return jsonpointer.Pointer{"reason_count"}
+	}
+	if pointee == any(&o.EventContext) {
+		return jsonpointer.Pointer{"context"}
+	}
+	for i1 := range o.EventContext {
+		if pointee == any(&o.EventContext[i1]) {
+			return jsonpointer.Pointer{"context", strconv.Itoa(i1)}
+		}
+		if pointee == any(&o.EventContext[i1].Object) {
+			return jsonpointer.Pointer{"context", strconv.Itoa(i1), "object"}
+		}
+		if p := jsonlog.RelativeJsonPointer(o.EventContext[i1].Object, pointee); p != nil {
+			return append(jsonpointer.Pointer{"context", strconv.Itoa(i1), "object"}, p...)
+		}
+		if pointee == any(&o.EventContext[i1].Relations) {
+			return jsonpointer.Pointer{"context", strconv.Itoa(i1), "relations"}
+		}
+		for i2 := range o.EventContext[i1].Relations {
+			if pointee == any(&o.EventContext[i1].Relations[i2]) {
+				return jsonpointer.Pointer{"context", strconv.Itoa(i1), "relations", strconv.Itoa(i2)}
+			}
+			if pointee == any(&o.EventContext[i1].Relations[i2].Type) {
+				return jsonpointer.Pointer{"context", strconv.Itoa(i1), "relations", strconv.Itoa(i2), "relation_type"}
+			}
+			if pointee == any(&o.EventContext[i1].Relations[i2].Name) {
+				return jsonpointer.Pointer{"context", strconv.Itoa(i1), "relations", strconv.Itoa(i2), "relation_name"}
+			}
+			if pointee == any(&o.EventContext[i1].Relations[i2].Unique) {
+				return jsonpointer.Pointer{"context", strconv.Itoa(i1), "relations", strconv.Itoa(i2), "unique"}
+			}
+		}
+	}
+	if pointee == any(&o.Issues) {
+		return jsonpointer.Pointer{"issues"}
+	}
+	for i3 := range o.Issues {
+		if pointee == any(&o.Issues[i3]) {
+			return jsonpointer.Pointer{"issues", strconv.Itoa(i3)}
+		}
+		if pointee == any(&o.Issues[i3].Affected) {
+			return jsonpointer.Pointer{"issues", strconv.Itoa(i3), "affected"}
+		}
+		if pointee == any(o.Issues[i3].Affected) {
+			return jsonpointer.Pointer{"issues", strconv.Itoa(i3), "affected"}
+		}
+		if pointee == any(&o.Issues[i3].Category) {
+			return jsonpointer.Pointer{"issues", strconv.Itoa(i3), "category"}
+		}
+		if pointee == any(&o.Issues[i3].Description) {
+			return jsonpointer.Pointer{"issues", strconv.Itoa(i3), "description"}
+		}
+	}
+	if pointee == any(&o.LogVersion) {
+		return jsonpointer.Pointer{"log_version"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *Assessment) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Meta) {
+		return "", true
+	}
+	if pointee == any(&o.Meta.Time) {
+		return "-", true
+	}
+	if pointee == any(&o.Meta.Lvl) {
+		return "-", true
+	}
+	if pointee == any(&o.Meta.Mod) {
+		return "MODULE", true
+	}
+	if pointee == any(&o.Meta.ScanID) {
+		return "SCANID", true
+	}
+	if pointee == any(&o.Meta.GenID) {
+		return "UID", true
+	}
+	if pointee == any(&o.Meta.Source) {
+		return "-", true
+	}
+	if pointee == any(&o.Text) {
+		return "MESSAGE", true
+	}
+	if pointee == any(&o.Score) {
+		return "SCORE", true
+	}
+	if pointee == any(&o.Subject) {
+		return "", true
+	}
+	if label, ok := jsonlog.RelativeTextLabel(&o.Subject, pointee); ok {
+		return label, true
+	}
+	if pointee == any(&o.Reasons) {
+		return "", true
+	}
+	if pointee == any(&o.ReasonCount) {
+		return "REASONS_COUNT", true
+	}
+	if pointee == any(&o.EventContext) {
+		return "", true
+	}
+	if pointee == any(&o.Issues) {
+		return "-", true
+	}
+	if pointee == any(&o.LogVersion) {
+		return "", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *AtJob) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"command":`...)
+	buf = appendJSONString(buf, o.Command)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *AtJob) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Command) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "COMMAND", Value: t.FormatField(o.Command, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *AtJob) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Command) {
+		return jsonpointer.Pointer{"command"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *AtJob) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Command) {
+		return "COMMAND", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *AuditLogEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"entry":`...)
+	if buf, err = appendJSONValue(buf, &o.Entry); err != nil {
+		return nil, err
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *AuditLogEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Entry) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ENTRY", Value: t.FormatField(o.Entry, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *AuditLogEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Entry) {
+		return jsonpointer.Pointer{"entry"}
+	}
+	if p := o.Entry.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"entry"}, p...)
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *AuditLogEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Entry) {
+		return "ENTRY", true
+	}
+	if label, ok := o.Entry.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "ENTRY"), true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *AuthorizedKeysEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"key_type":`...)
+	buf = appendJSONString(buf, o.Type)
+	buf = append(buf, `,"key":`...)
+	buf = appendJSONString(buf, o.Key)
+	buf = append(buf, `,"comment":`...)
+	buf = appendJSONString(buf, o.Comment)
+	buf = append(buf, `,"line":`...)
+	buf = appendJSONString(buf, o.Line)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *AuthorizedKeysEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Type) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TYPE", Value: t.FormatField(o.Type, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Key) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "KEY", Value: t.FormatField(o.Key, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Comment) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "COMMENT", Value: t.FormatField(o.Comment, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Line) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LINE", Value: t.FormatField(o.Line, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *AuthorizedKeysEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Type) {
+		return jsonpointer.Pointer{"key_type"}
+	}
+	if pointee == any(&o.Key) {
+		return jsonpointer.Pointer{"key"}
+	}
+	if pointee == any(&o.Comment) {
+		return jsonpointer.Pointer{"comment"}
+	}
+	if pointee == any(&o.Line) {
+		return jsonpointer.Pointer{"line"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *AuthorizedKeysEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Type) {
+		return "TYPE", true
+	}
+	if pointee == any(&o.Key) {
+		return "KEY", true
+	}
+	if pointee == any(&o.Comment) {
+		return "COMMENT", true
+	}
+	if pointee == any(&o.Line) {
+		return "LINE", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *AutorunEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"autorun_type":`...)
+	buf = appendJSONString(buf, o.Type)
+	buf = append(buf, `,"location":`...)
+	buf = appendJSONString(buf, o.Location)
+	buf = append(buf, `,"image":`...)
+	if buf, err = o.Image.appendJSON(buf); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"arguments":`...)
+	buf = appendJSONString(buf, o.Arguments)
+	buf = append(buf, `,"entry":`...)
+	buf = appendJSONString(buf, o.Entry)
+	buf = append(buf, `,"launch_string":`...)
+	buf = appendJSONString(buf, o.LaunchString)
+	if len(o.OldMd5) != 0 {
+		buf = append(buf, `,"old_md5":`...)
+		buf = appendJSONString(buf, o.OldMd5)
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *AutorunEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Type) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "AUTORUN_TYPE", Value: t.FormatField(o.Type, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Location) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LOCATION", Value: t.FormatField(o.Location, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.Image) {
+		for _, pair := range o.Image.marshalTextLog(t) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: pair.Key, Value: pair.Value})
+		}
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Arguments) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ARGUMENTS", Value: t.FormatField(o.Arguments, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Entry) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ENTRY", Value: t.FormatField(o.Entry, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LaunchString) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LAUNCH_STRING", Value: t.FormatField(o.LaunchString, textlogModifiersNone)})
+	}
+	if !(o.OldMd5 == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.OldMd5)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "MD5_BEFORE", Value: t.FormatField(o.OldMd5, textlogModifiersOmitempty)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *AutorunEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Type) {
+		return jsonpointer.Pointer{"autorun_type"}
+	}
+	if pointee == any(&o.Location) {
+		return jsonpointer.Pointer{"location"}
+	}
+	if pointee == any(&o.Image) {
+		return jsonpointer.Pointer{"image"}
+	}
+	if pointee == any(o.Image) {
+		return jsonpointer.Pointer{"image"}
+	}
+	if p := o.Image.relativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"image"}, p...)
+	}
+	if pointee == any(&o.Arguments) {
+		return jsonpointer.Pointer{"arguments"}
+	}
+	if pointee == any(&o.Entry) {
+		return jsonpointer.Pointer{"entry"}
+	}
+	if pointee == any(&o.LaunchString) {
+		return jsonpointer.Pointer{"launch_string"}
+	}
+	if pointee == any(&o.OldMd5) {
+		return jsonpointer.Pointer{"old_md5"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *AutorunEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Type) {
+		return "AUTORUN_TYPE", true
+	}
+	if pointee == any(&o.Location) {
+		return "LOCATION", true
+	}
+	if pointee == any(o.Image) {
+		return "", true
+	}
+	if pointee == any(&o.Image) {
+		return "", true
+	}
+	if label, ok := o.Image.relativeTextPointer(pointee); ok {
+		return label, true
+	}
+	if pointee == any(&o.Arguments) {
+		return "ARGUMENTS", true
+	}
+	if pointee == any(&o.Entry) {
+		return "ENTRY", true
+	}
+	if pointee == any(&o.LaunchString) {
+		return "LAUNCH_STRING", true
+	}
+	if pointee == any(&o.OldMd5) {
+		return "MD5_BEFORE", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *CronJob) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"user":`...)
+	buf = appendJSONString(buf, o.User)
+	buf = append(buf, `,"schedule":`...)
+	buf = appendJSONString(buf, o.Schedule)
+	buf = append(buf, `,"command":`...)
+	buf = appendJSONString(buf, o.Command)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *CronJob) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.User) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "USER", Value: t.FormatField(o.User, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Schedule) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SCHEDULE", Value: t.FormatField(o.Schedule, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Command) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "COMMAND", Value: t.FormatField(o.Command, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *CronJob) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.User) {
+		return jsonpointer.Pointer{"user"}
+	}
+	if pointee == any(&o.Schedule) {
+		return jsonpointer.Pointer{"schedule"}
+	}
+	if pointee == any(&o.Command) {
+		return jsonpointer.Pointer{"command"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *CronJob) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.User) {
+		return "USER", true
+	}
+	if pointee == any(&o.Schedule) {
+		return "SCHEDULE", true
+	}
+	if pointee == any(&o.Command) {
+		return "COMMAND", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *DeepDiveChunk) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"chunk_offset":`...)
+	buf = strconv.AppendUint(buf, uint64(o.ChunkOffset), 10)
+	buf = append(buf, `,"chunk_end":`...)
+	buf = strconv.AppendUint(buf, uint64(o.ChunkEnd), 10)
+	buf = append(buf, `,"content":`...)
+	if buf, err = o.Content.appendJSON(buf); err != nil {
+		return nil, err
+	}
+	if o.BeaconConfig != nil {
+		buf = append(buf, `,"beacon_config":`...)
+		if o.BeaconConfig == nil {
+			buf = append(buf, "null"...)
+		} else {
+			start1 := len(buf)
+			buf = append(buf, `,"beacon_type":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.Type)
+			buf = append(buf, `,"c2":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.C2)
+			buf = append(buf, `,"port":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.Port)
+			buf = append(buf, `,"spawn_to":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.SpawnTo)
+			buf = append(buf, `,"injection_process":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.InjectionProcess)
+			buf = append(buf, `,"pipe_name":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.Pipename)
+			buf = append(buf, `,"user_agent":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.UserAgent)
+			buf = append(buf, `,"proxy":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.Proxy)
+			buf = append(buf, `,"full_config":`...)
+			if buf, err = appendJSONValue(buf, &o.BeaconConfig.FullConfig); err != nil {
+				return nil, err
+			}
+			buf = append(buf, `,"cipher_parameters":`...)
+			start2 := len(buf)
+			buf = append(buf, `,"xaf_encoded":`...)
+			buf = strconv.AppendBool(buf, o.BeaconConfig.CipherParameters.XafEncoded)
+			buf = append(buf, `,"xaf_encoding_anchor":`...)
+			buf = strconv.AppendInt(buf, o.BeaconConfig.CipherParameters.XafEncodingAnchor, 10)
+			buf = append(buf, `,"xor_key":`...)
+			buf = strconv.AppendUint(buf, uint64(o.BeaconConfig.CipherParameters.XorKey), 10)
+			buf = append(buf, `,"beacon_offset":`...)
+			buf = strconv.AppendUint(buf, o.BeaconConfig.CipherParameters.BeaconOffset, 10)
+			buf = append(buf, `,"beacon_length":`...)
+			buf = strconv.AppendUint(buf, o.BeaconConfig.CipherParameters.BeaconLength, 10)
+			buf = append(buf, `,"block_start":`...)
+			if buf, err = appendJSONValue(buf, &o.BeaconConfig.CipherParameters.BlockStart); err != nil {
+				return nil, err
+			}
+			buf = append(buf, `,"pairwise_swapped":`...)
+			buf = strconv.AppendBool(buf, o.BeaconConfig.CipherParameters.PairwiseSwapped)
+			buf = closeJSONObject(buf, start2)
+			buf = closeJSONObject(buf, start1)
+		}
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *DeepDiveChunk) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ChunkOffset) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "CHUNK_OFFSET", Value: t.FormatField(o.ChunkOffset, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ChunkEnd) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "CHUNK_END", Value: t.FormatField(o.ChunkEnd, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.Content) {
+		for _, pair := range o.Content.marshalTextLog(t) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("CONTENT", pair.Key), Value: pair.Value})
+		}
+	}
+	if !(o.BeaconConfig == nil) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.BeaconConfig)) {
+		if o.BeaconConfig != nil {
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.Type) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_TYPE", Value: t.FormatField(o.BeaconConfig.Type, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.C2) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_C2", Value: t.FormatField(o.BeaconConfig.C2, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.Port) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_PORT", Value: t.FormatField(o.BeaconConfig.Port, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.SpawnTo) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_SPAWNTO", Value: t.FormatField(o.BeaconConfig.SpawnTo, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.InjectionProcess) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_INJECTION_PROCESS", Value: t.FormatField(o.BeaconConfig.InjectionProcess, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.Pipename) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_PIPENAME", Value: t.FormatField(o.BeaconConfig.Pipename, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.UserAgent) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_USER_AGENT", Value: t.FormatField(o.BeaconConfig.UserAgent, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.Proxy) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_PROXY", Value: t.FormatField(o.BeaconConfig.Proxy, textlogModifiersNone)})
+			}
+			if !(reflect.ValueOf(o.BeaconConfig.CipherParameters).IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.BeaconConfig.CipherParameters)) {
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.XafEncoded) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_XAF_ENCODED", Value: t.FormatField(o.BeaconConfig.CipherParameters.XafEncoded, textlogModifiersNone)})
+				}
+				if !(o.BeaconConfig.CipherParameters.XafEncodingAnchor == 0) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.BeaconConfig.CipherParameters.XafEncodingAnchor)) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_XAF_ENCODING_ANCHOR", Value: t.FormatField(o.BeaconConfig.CipherParameters.XafEncodingAnchor, textlogModifiersOmitempty)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.XorKey) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_XOR_KEY", Value: t.FormatField(o.BeaconConfig.CipherParameters.XorKey, textlogModifiersNone)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.BeaconOffset) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_BEACON_OFFSET", Value: t.FormatField(o.BeaconConfig.CipherParameters.BeaconOffset, textlogModifiersNone)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.BeaconLength) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_BEACON_LENGTH", Value: t.FormatField(o.BeaconConfig.CipherParameters.BeaconLength, textlogModifiersNone)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.BlockStart) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_BLOCK_START", Value: t.FormatField(o.BeaconConfig.CipherParameters.BlockStart, textlogModifiersNone)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.PairwiseSwapped) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_PAIRWISE_SWAPPED", Value: t.FormatField(o.BeaconConfig.CipherParameters.PairwiseSwapped, textlogModifiersNone)})
+				}
+			}
+		}
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *DeepDiveChunk) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.ChunkOffset) {
+		return jsonpointer.Pointer{"chunk_offset"}
+	}
+	if pointee == any(&o.ChunkEnd) {
+		return jsonpointer.Pointer{"chunk_end"}
+	}
+	if pointee == any(&o.Content) {
+		return jsonpointer.Pointer{"content"}
+	}
+	if pointee == any(o.Content) {
+		return jsonpointer.Pointer{"content"}
+	}
+	if p := o.Content.relativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"content"}, p...)
+	}
+	if pointee == any(&o.BeaconConfig) {
+		return jsonpointer.Pointer{"beacon_config"}
+	}
+	if pointee == any(o.BeaconConfig) {
+		return jsonpointer.Pointer{"beacon_config"}
+	}
+	if o.BeaconConfig != nil {
+		if pointee == any(&o.BeaconConfig.Type) {
+			return jsonpointer.Pointer{"beacon_config", "beacon_type"}
+		}
+		if pointee == any(&o.BeaconConfig.C2) {
+			return jsonpointer.Pointer{"beacon_config", "c2"}
+		}
+		if pointee == any(&o.BeaconConfig.Port) {
+			return jsonpointer.Pointer{"beacon_config", "port"}
+		}
+		if pointee == any(&o.BeaconConfig.SpawnTo) {
+			return jsonpointer.Pointer{"beacon_config", "spawn_to"}
+		}
+		if pointee == any(&o.BeaconConfig.InjectionProcess) {
+			return jsonpointer.Pointer{"beacon_config", "injection_process"}
+		}
+		if pointee == any(&o.BeaconConfig.Pipename) {
+			return jsonpointer.Pointer{"beacon_config", "pipe_name"}
+		}
+		if pointee == any(&o.BeaconConfig.UserAgent) {
+			return jsonpointer.Pointer{"beacon_config", "user_agent"}
+		}
+		if pointee == any(&o.BeaconConfig.Proxy) {
+			return jsonpointer.Pointer{"beacon_config", "proxy"}
+		}
+		if pointee == any(&o.BeaconConfig.FullConfig) {
+			return jsonpointer.Pointer{"beacon_config", "full_config"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XafEncoded) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "xaf_encoded"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XafEncodingAnchor) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "xaf_encoding_anchor"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XorKey) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "xor_key"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BeaconOffset) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "beacon_offset"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BeaconLength) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "beacon_length"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BlockStart) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "block_start"}
+		}
+		for i0 := range o.BeaconConfig.CipherParameters.BlockStart {
+			if pointee == any(&o.BeaconConfig.CipherParameters.BlockStart[i0]) {
+				return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "block_start", strconv.Itoa(i0)}
+			}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.PairwiseSwapped) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "pairwise_swapped"}
+		}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *DeepDiveChunk) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.ChunkOffset) {
+		return "CHUNK_OFFSET", true
+	}
+	if pointee == any(&o.ChunkEnd) {
+		return "CHUNK_END", true
+	}
+	if pointee == any(o.Content) {
+		return "CONTENT", true
+	}
+	if pointee == any(&o.Content) {
+		return "CONTENT", true
+	}
+	if label, ok := o.Content.relativeTextPointer(pointee); ok {
+		return jsonlog.ConcatTextLabels("CONTENT", label), true
+	}
+	if pointee == any(o.BeaconConfig) {
+		return "BEACON", true
+	}
+	if pointee == any(&o.BeaconConfig) {
+		return "BEACON", true
+	}
+	if o.BeaconConfig != nil {
+		if pointee == any(&o.BeaconConfig.Type) {
+			return "BEACON_TYPE", true
+		}
+		if pointee == any(&o.BeaconConfig.C2) {
+			return "BEACON_C2", true
+		}
+		if pointee == any(&o.BeaconConfig.Port) {
+			return "BEACON_PORT", true
+		}
+		if pointee == any(&o.BeaconConfig.SpawnTo) {
+			return "BEACON_SPAWNTO", true
+		}
+		if pointee == any(&o.BeaconConfig.InjectionProcess) {
+			return "BEACON_INJECTION_PROCESS", true
+		}
+		if pointee == any(&o.BeaconConfig.Pipename) {
+			return "BEACON_PIPENAME", true
+		}
+		if pointee == any(&o.BeaconConfig.UserAgent) {
+			return "BEACON_USER_AGENT", true
+		}
+		if pointee == any(&o.BeaconConfig.Proxy) {
+			return "BEACON_PROXY", true
+		}
+		if pointee == any(&o.BeaconConfig.FullConfig) {
+			return "BEACON_-", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters) {
+			return "BEACON_CIPHER_PARAMETERS", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XafEncoded) {
+			return "BEACON_CIPHER_PARAMETERS_XAF_ENCODED", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XafEncodingAnchor) {
+			return "BEACON_CIPHER_PARAMETERS_XAF_ENCODING_ANCHOR", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XorKey) {
+			return "BEACON_CIPHER_PARAMETERS_XOR_KEY", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BeaconOffset) {
+			return "BEACON_CIPHER_PARAMETERS_BEACON_OFFSET", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BeaconLength) {
+			return "BEACON_CIPHER_PARAMETERS_BEACON_LENGTH", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BlockStart) {
+			return "BEACON_CIPHER_PARAMETERS_BLOCK_START", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.PairwiseSwapped) {
+			return "BEACON_CIPHER_PARAMETERS_PAIRWISE_SWAPPED", true
+		}
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *DetectionAddEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"time":`...)
+	if buf, err = appendJSONTime(buf, o.Time); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"threat_name":`...)
+	buf = appendJSONString(buf, o.ThreatName)
+	buf = append(buf, `,"detected":`...)
+	if buf, err = appendJSONValue(buf, &o.Detected); err != nil {
+		return nil, err
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *DetectionAddEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Time) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TIME", Value: t.FormatField(o.Time, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ThreatName) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "THREAT_NAME", Value: t.FormatField(o.ThreatName, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *DetectionAddEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Time) {
+		return jsonpointer.Pointer{"time"}
+	}
+	if pointee == any(&o.ThreatName) {
+		return jsonpointer.Pointer{"threat_name"}
+	}
+	if pointee == any(&o.Detected) {
+		return jsonpointer.Pointer{"detected"}
+	}
+	if p := o.Detected.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"detected"}, p...)
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *DetectionAddEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Time) {
+		return "TIME", true
+	}
+	if pointee == any(&o.ThreatName) {
+		return "THREAT_NAME", true
+	}
+	if pointee == any(&o.Detected) {
+		return "", true
+	}
+	if label, ok := o.Detected.RelativeTextPointer(pointee); ok {
+		return label, true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *DnsCacheEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"host":`...)
+	buf = appendJSONString(buf, o.Host)
+	buf = append(buf, `,"ip":`...)
+	buf = appendJSONString(buf, o.IP)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *DnsCacheEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Host) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ENTRY", Value: t.FormatField(o.Host, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.IP) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "IP", Value: t.FormatField(o.IP, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *DnsCacheEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Host) {
+		return jsonpointer.Pointer{"host"}
+	}
+	if pointee == any(&o.IP) {
+		return jsonpointer.Pointer{"ip"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *DnsCacheEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Host) {
+		return "ENTRY", true
+	}
+	if pointee == any(&o.IP) {
+		return "IP", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *DoublePulsarHandshake) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"handshake_type":`...)
+	buf = appendJSONString(buf, o.Type)
+	if o.Key != 0 {
+		buf = append(buf, `,"key":`...)
+		buf = strconv.AppendUint(buf, uint64(o.Key), 10)
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *DoublePulsarHandshake) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Type) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TYPE", Value: t.FormatField(o.Type, textlogModifiersNone)})
+	}
+	if !(o.Key == 0) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Key)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "KEY", Value: t.FormatField(o.Key, textlogModifiersOmitempty)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *DoublePulsarHandshake) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Type) {
+		return jsonpointer.Pointer{"handshake_type"}
+	}
+	if pointee == any(&o.Key) {
+		return jsonpointer.Pointer{"key"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *DoublePulsarHandshake) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Type) {
+		return "TYPE", true
+	}
+	if pointee == any(&o.Key) {
+		return "KEY", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *EBPFProgram) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"tag":`...)
+	buf = appendJSONString(buf, o.Tag)
+	buf = append(buf, `,"user":`...)
+	buf = appendJSONString(buf, o.User)
+	buf = append(buf, `,"name":`...)
+	buf = appendJSONString(buf, o.Name)
+	buf = append(buf, `,"size":`...)
+	buf = strconv.AppendUint(buf, o.Size, 10)
+	buf = append(buf, `,"maps":`...)
+	if o.Maps == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i1 := range o.Maps {
+			if i1 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.Maps[i1])
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"functions":`...)
+	if o.Functions == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i2 := range o.Functions {
+			if i2 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.Functions[i2])
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"load_time":`...)
+	if buf, err = appendJSONTime(buf, o.LoadTime); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"memory_locked":`...)
+	buf = strconv.AppendUint(buf, o.MemoryLocked, 10)
+	buf = append(buf, `,"link_type":`...)
+	buf = appendJSONString(buf, o.LinkType)
+	buf = append(buf, `,"program_type":`...)
+	buf = appendJSONString(buf, o.ProgramType)
+	buf = append(buf, `,"attach_target":`...)
+	start3 := len(buf)
+	if len(o.AttachTarget.Path) != 0 {
+		buf = append(buf, `,"path":`...)
+		buf = appendJSONString(buf, o.AttachTarget.Path)
+	}
+	if o.AttachTarget.Pid != 0 {
+		buf = append(buf, `,"pid":`...)
+		buf = strconv.AppendUint(buf, uint64(o.AttachTarget.Pid), 10)
+	}
+	if len(o.AttachTarget.Symbols) != 0 {
+		buf = append(buf, `,"symbols":`...)
+		if o.AttachTarget.Symbols == nil {
+			buf = append(buf, "null"...)
+		} else {
+			buf = append(buf, '[')
+			for i4 := range o.AttachTarget.Symbols {
+				if i4 > 0 {
+					buf = append(buf, ',')
+				}
+				buf = appendJSONString(buf, o.AttachTarget.Symbols[i4])
+			}
+			buf = append(buf, ']')
+		}
+	}
+	if len(o.AttachTarget.Interface) != 0 {
+		buf = append(buf, `,"interface":`...)
+		buf = appendJSONString(buf, o.AttachTarget.Interface)
+	}
+	if o.AttachTarget.ObjectId != 0 {
+		buf = append(buf, `,"object_id":`...)
+		buf = strconv.AppendInt(buf, o.AttachTarget.ObjectId, 10)
+	}
+	if len(o.AttachTarget.Protocol) != 0 {
+		buf = append(buf, `,"protocol":`...)
+		buf = appendJSONString(buf, o.AttachTarget.Protocol)
+	}
+	if len(o.AttachTarget.Hook) != 0 {
+		buf = append(buf, `,"hook":`...)
+		buf = appendJSONString(buf, o.AttachTarget.Hook)
+	}
+	if o.AttachTarget.Priority != 0 {
+		buf = append(buf, `,"priority":`...)
+		buf = strconv.AppendInt(buf, int64(o.AttachTarget.Priority), 10)
+	}
+	buf = closeJSONObject(buf, start3)
+	if o.Content != nil {
+		buf = append(buf, `,"content":`...)
+		if buf, err = o.Content.appendJSON(buf); err != nil {
+			return nil, err
+		}
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *EBPFProgram) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Tag) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TAG", Value: t.FormatField(o.Tag, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.User) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "USER", Value: t.FormatField(o.User, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Name) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NAME", Value: t.FormatField(o.Name, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Size) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SIZE", Value: t.FormatField(o.Size, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LoadTime) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LOAD_TIME", Value: t.FormatField(o.LoadTime, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LinkType) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LINK_TYPE", Value: t.FormatField(o.LinkType, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.AttachTarget) {
+		if !(o.AttachTarget.Path == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.AttachTarget.Path)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "PATH", Value: t.FormatField(o.AttachTarget.Path, textlogModifiersOmitempty)})
+		}
+		if !(o.AttachTarget.Pid == 0) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.AttachTarget.Pid)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "PID", Value: t.FormatField(o.AttachTarget.Pid, textlogModifiersOmitempty)})
+		}
+		if !(o.AttachTarget.Symbols == nil) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.AttachTarget.Symbols)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "SYMBOL", Value: t.FormatField(o.AttachTarget.Symbols, textlogModifiersOmitempty)})
+		}
+		if !(o.AttachTarget.Interface == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.AttachTarget.Interface)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "INTERFACE", Value: t.FormatField(o.AttachTarget.Interface, textlogModifiersOmitempty)})
+		}
+		if !(o.AttachTarget.ObjectId == 0) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.AttachTarget.ObjectId)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "OBJECT_ID", Value: t.FormatField(o.AttachTarget.ObjectId, textlogModifiersOmitempty)})
+		}
+		if !(o.AttachTarget.Protocol == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.AttachTarget.Protocol)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "PROTOCOL", Value: t.FormatField(o.AttachTarget.Protocol, textlogModifiersOmitempty)})
+		}
+		if !(o.AttachTarget.Hook == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.AttachTarget.Hook)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "HOOK", Value: t.FormatField(o.AttachTarget.Hook, textlogModifiersOmitempty)})
+		}
+		if !(o.AttachTarget.Priority == 0) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.AttachTarget.Priority)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "PRIORITY", Value: t.FormatField(o.AttachTarget.Priority, textlogModifiersOmitempty)})
+		}
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *EBPFProgram) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Tag) {
+		return jsonpointer.Pointer{"tag"}
+	}
+	if pointee == any(&o.User) {
+		return jsonpointer.Pointer{"user"}
+	}
+	if pointee == any(&o.Name) {
+		return jsonpointer.Pointer{"name"}
+	}
+	if pointee == any(&o.Size) {
+		return jsonpointer.Pointer{"size"}
+	}
+	if pointee == any(&o.Maps) {
+		return jsonpointer.Pointer{"maps"}
+	}
+	for i0 := range o.Maps {
+		if pointee == any(&o.Maps[i0]) {
+			return jsonpointer.Pointer{"maps", strconv.Itoa(i0)}
+		}
+	}
+	if pointee == any(&o.Functions) {
+		return jsonpointer.Pointer{"functions"}
+	}
+	for i1 := range o.Functions {
+		if pointee == any(&o.Functions[i1]) {
+			return jsonpointer.Pointer{"functions", strconv.Itoa(i1)}
+		}
+	}
+	if pointee == any(&o.LoadTime) {
+		return jsonpointer.Pointer{"load_time"}
+	}
+	if pointee == any(&o.MemoryLocked) {
+		return jsonpointer.Pointer{"memory_locked"}
+	}
+	if pointee == any(&o.LinkType) {
+		return jsonpointer.Pointer{"link_type"}
+	}
+	if pointee == any(&o.ProgramType) {
+		return jsonpointer.Pointer{"program_type"}
+	}
+	if pointee == any(&o.AttachTarget) {
+		return jsonpointer.Pointer{"attach_target"}
+	}
+	if pointee == any(&o.AttachTarget.Path) {
+		return jsonpointer.Pointer{"attach_target", "path"}
+	}
+	if pointee == any(&o.AttachTarget.Pid) {
+		return jsonpointer.Pointer{"attach_target", "pid"}
+	}
+	if pointee == any(&o.AttachTarget.Symbols) {
+		return jsonpointer.Pointer{"attach_target", "symbols"}
+	}
+	if p := o.AttachTarget.Symbols.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"attach_target", "symbols"}, p...)
+	}
+	if pointee == any(&o.AttachTarget.Interface) {
+		return jsonpointer.Pointer{"attach_target", "interface"}
+	}
+	if pointee == any(&o.AttachTarget.ObjectId) {
+		return jsonpointer.Pointer{"attach_target", "object_id"}
+	}
+	if pointee == any(&o.AttachTarget.Protocol) {
+		return jsonpointer.Pointer{"attach_target", "protocol"}
+	}
+	if pointee == any(&o.AttachTarget.Hook) {
+		return jsonpointer.Pointer{"attach_target", "hook"}
+	}
+	if pointee == any(&o.AttachTarget.Priority) {
+		return jsonpointer.Pointer{"attach_target", "priority"}
+	}
+	if pointee == any(&o.Content) {
+		return jsonpointer.Pointer{"content"}
+	}
+	if pointee == any(o.Content) {
+		return jsonpointer.Pointer{"content"}
+	}
+	if p := o.Content.relativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"content"}, p...)
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *EBPFProgram) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Tag) {
+		return "TAG", true
+	}
+	if pointee == any(&o.User) {
+		return "USER", true
+	}
+	if pointee == any(&o.Name) {
+		return "NAME", true
+	}
+	if pointee == any(&o.Size) {
+		return "SIZE", true
+	}
+	if pointee == any(&o.Maps) {
+		return "", true
+	}
+	if pointee == any(&o.Functions) {
+		return "", true
+	}
+	if pointee == any(&o.LoadTime) {
+		return "LOAD_TIME", true
+	}
+	if pointee == any(&o.MemoryLocked) {
+		return "", true
+	}
+	if pointee == any(&o.LinkType) {
+		return "LINK_TYPE", true
+	}
+	if pointee == any(&o.ProgramType) {
+		return "", true
+	}
+	if pointee == any(&o.AttachTarget) {
+		return "", true
+	}
+	if pointee == any(&o.AttachTarget.Path) {
+		return "PATH", true
+	}
+	if pointee == any(&o.AttachTarget.Pid) {
+		return "PID", true
+	}
+	if pointee == any(&o.AttachTarget.Symbols) {
+		return "SYMBOL", true
+	}
+	if label, ok := o.AttachTarget.Symbols.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "SYMBOL"), true
+	}
+	if pointee == any(&o.AttachTarget.Interface) {
+		return "INTERFACE", true
+	}
+	if pointee == any(&o.AttachTarget.ObjectId) {
+		return "OBJECT_ID", true
+	}
+	if pointee == any(&o.AttachTarget.Protocol) {
+		return "PROTOCOL", true
+	}
+	if pointee == any(&o.AttachTarget.Hook) {
+		return "HOOK", true
+	}
+	if pointee == any(&o.AttachTarget.Priority) {
+		return "PRIORITY", true
+	}
+	if pointee == any(o.Content) {
+		return "", true
+	}
+	if pointee == any(&o.Content) {
+		return "", true
+	}
+	if label, ok := o.Content.relativeTextPointer(pointee); ok {
+		return label, true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *EmsDetectionEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"time":`...)
+	if buf, err = appendJSONTime(buf, o.Time); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"threat_name":`...)
+	buf = appendJSONString(buf, o.ThreatName)
+	buf = append(buf, `,"pid":`...)
+	buf = strconv.AppendInt(buf, int64(o.Pid), 10)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *EmsDetectionEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Time) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TIME", Value: t.FormatField(o.Time, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ThreatName) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "THREAT", Value: t.FormatField(o.ThreatName, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Pid) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PID", Value: t.FormatField(o.Pid, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *EmsDetectionEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Time) {
+		return jsonpointer.Pointer{"time"}
+	}
+	if pointee == any(&o.ThreatName) {
+		return jsonpointer.Pointer{"threat_name"}
+	}
+	if pointee == any(&o.Pid) {
+		return jsonpointer.Pointer{"pid"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *EmsDetectionEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Time) {
+		return "TIME", true
+	}
+	if pointee == any(&o.ThreatName) {
+		return "THREAT", true
+	}
+	if pointee == any(&o.Pid) {
+		return "PID", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *EndOfLifeReport) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"version":`...)
+	buf = appendJSONString(buf, o.Version)
+	buf = append(buf, `,"end_of_life":`...)
+	if buf, err = appendJSONTime(buf, o.EndOfLife); err != nil {
+		return nil, err
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *EndOfLifeReport) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Version) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "VERSION", Value: t.FormatField(o.Version, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.EndOfLife) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "END_TIME", Value: t.FormatField(o.EndOfLife, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *EndOfLifeReport) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Version) {
+		return jsonpointer.Pointer{"version"}
+	}
+	if pointee == any(&o.EndOfLife) {
+		return jsonpointer.Pointer{"end_of_life"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *EndOfLifeReport) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Version) {
+		return "VERSION", true
+	}
+	if pointee == any(&o.EndOfLife) {
+		return "END_TIME", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *EnvironmentVariable) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"variable":`...)
+	buf = appendJSONString(buf, o.Variable)
+	buf = append(buf, `,"value":`...)
+	buf = appendJSONString(buf, o.Value)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *EnvironmentVariable) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Variable) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "VAR", Value: t.FormatField(o.Variable, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Value) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "VALUE", Value: t.FormatField(o.Value, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *EnvironmentVariable) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Variable) {
+		return jsonpointer.Pointer{"variable"}
+	}
+	if pointee == any(&o.Value) {
+		return jsonpointer.Pointer{"value"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *EnvironmentVariable) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Variable) {
+		return "VAR", true
+	}
+	if pointee == any(&o.Value) {
+		return "VALUE", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *EstimatedImpactEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"time":`...)
+	if buf, err = appendJSONTime(buf, o.Time); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"image":`...)
+	buf = appendJSONString(buf, o.ProcessImageName)
+	buf = append(buf, `,"pid":`...)
+	buf = strconv.AppendInt(buf, int64(o.Pid), 10)
+	buf = append(buf, `,"file":`...)
+	buf = appendJSONString(buf, o.AccessedFile)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *EstimatedImpactEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Time) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TIME", Value: t.FormatField(o.Time, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ProcessImageName) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "IMAGE", Value: t.FormatField(o.ProcessImageName, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Pid) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PID", Value: t.FormatField(o.Pid, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.AccessedFile) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FILE", Value: t.FormatField(o.AccessedFile, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *EstimatedImpactEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Time) {
+		return jsonpointer.Pointer{"time"}
+	}
+	if pointee == any(&o.ProcessImageName) {
+		return jsonpointer.Pointer{"image"}
+	}
+	if pointee == any(&o.Pid) {
+		return jsonpointer.Pointer{"pid"}
+	}
+	if pointee == any(&o.AccessedFile) {
+		return jsonpointer.Pointer{"file"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *EstimatedImpactEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Time) {
+		return "TIME", true
+	}
+	if pointee == any(&o.ProcessImageName) {
+		return "IMAGE", true
+	}
+	if pointee == any(&o.Pid) {
+		return "PID", true
+	}
+	if pointee == any(&o.AccessedFile) {
+		return "FILE", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *EventlogProcessStart) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"process":`...)
+	buf = appendJSONString(buf, o.Process)
+	buf = append(buf, `,"start_times":`...)
+	if o.StartTimes == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i1 := range o.StartTimes {
+			if i1 > 0 {
+				buf = append(buf, ',')
+			}
+			if buf, err = appendJSONTime(buf, o.StartTimes[i1]); err != nil {
+				return nil, err
+			}
+		}
+		buf = append(buf, ']')
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *EventlogProcessStart) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Process) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PROCESS", Value: t.FormatField(o.Process, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Count) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "COUNT", Value: t.FormatField(o.Count, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *EventlogProcessStart) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Process) {
+		return jsonpointer.Pointer{"process"}
+	}
+	if pointee == any(&o.StartTimes) {
+		return jsonpointer.Pointer{"start_times"}
+	}
+	for i0 := range o.StartTimes {
+		if pointee == any(&o.StartTimes[i0]) {
+			return jsonpointer.Pointer{"start_times", strconv.Itoa(i0)}
+		}
+	}
+	if pointee == any(&o.Count) {
+		return jsonpointer.Pointer{"-"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *EventlogProcessStart) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Process) {
+		return "PROCESS", true
+	}
+	if pointee == any(&o.StartTimes) {
+		return "-", true
+	}
+	if pointee == any(&o.Count) {
+		return "COUNT", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *File) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"path":`...)
+	buf = appendJSONString(buf, o.Path)
+	buf = append(buf, `,"exists":`...)
+	buf = appendJSONString(buf, string(o.Exists))
+	buf = append(buf, `,"extension":`...)
+	buf = appendJSONString(buf, o.Extension)
+	if len(o.MagicHeader) != 0 {
+		buf = append(buf, `,"magic_header":`...)
+		buf = appendJSONString(buf, o.MagicHeader)
+	}
+	if o.Hashes != nil {
+		buf = append(buf, `,"hashes":`...)
+		if o.Hashes == nil {
+			buf = append(buf, "null"...)
+		} else {
+			start1 := len(buf)
+			buf = append(buf, `,"md5":`...)
+			buf = appendJSONString(buf, o.Hashes.Md5)
+			buf = append(buf, `,"sha1":`...)
+			buf = appendJSONString(buf, o.Hashes.Sha1)
+			buf = append(buf, `,"sha256":`...)
+			buf = appendJSONString(buf, o.Hashes.Sha256)
+			buf = closeJSONObject(buf, start1)
+		}
+	}
+	if len(o.FirstBytes) != 0 {
+		buf = append(buf, `,"first_bytes":`...)
+		if buf, err = appendJSONValue(buf, &o.FirstBytes); err != nil {
+			return nil, err
+		}
+	}
+	if o.Filetimes != nil {
+		buf = append(buf, `,"file_times":`...)
+		if o.Filetimes == nil {
+			buf = append(buf, "null"...)
+		} else {
+			start2 := len(buf)
+			buf = append(buf, `,"modified":`...)
+			if buf, err = appendJSONTime(buf, o.Filetimes.Mtime); err != nil {
+				return nil, err
+			}
+			if o.Filetimes.Atime != nil {
+				buf = append(buf, `,"accessed":`...)
+				if buf, err = appendJSONValue(buf, &o.Filetimes.Atime); err != nil {
+					return nil, err
+				}
+			}
+			if o.Filetimes.Ctime != nil {
+				buf = append(buf, `,"changed":`...)
+				if buf, err = appendJSONValue(buf, &o.Filetimes.Ctime); err != nil {
+					return nil, err
+				}
+			}
+			if o.Filetimes.Btime != nil {
+				buf = append(buf, `,"created":`...)
+				if buf, err = appendJSONValue(buf, &o.Filetimes.Btime); err != nil {
+					return nil, err
+				}
+			}
+			if o.Filetimes.UsnChangeTime != nil {
+				buf = append(buf, `,"usn_change_time":`...)
+				if buf, err = appendJSONValue(buf, &o.Filetimes.UsnChangeTime); err != nil {
+					return nil, err
+				}
+			}
+			if o.Filetimes.MftFileNameModified != nil {
+				buf = append(buf, `,"mft_file_name_modified":`...)
+				if buf, err = appendJSONValue(buf, &o.Filetimes.MftFileNameModified); err != nil {
+					return nil, err
+				}
+			}
+			if o.Filetimes.MftFileNameAccessed != nil {
+				buf = append(buf, `,"mft_file_name_accessed":`...)
+				if buf, err = appendJSONValue(buf, &o.Filetimes.MftFileNameAccessed); err != nil {
+					return nil, err
+				}
+			}
+			if o.Filetimes.MftFileNameChanged != nil {
+				buf = append(buf, `,"mft_file_name_changed":`...)
+				if buf, err = appendJSONValue(buf, &o.Filetimes.MftFileNameChanged); err != nil {
+					return nil, err
+				}
+			}
+			if o.Filetimes.MftFileNameCreated != nil {
+				buf = append(buf, `,"mft_file_name_created":`...)
+				if buf, err = appendJSONValue(buf, &o.Filetimes.MftFileNameCreated); err != nil {
+					return nil, err
+				}
+			}
+			buf = closeJSONObject(buf, start2)
+		}
+	}
+	if o.Size != 0 {
+		buf = append(buf, `,"size":`...)
+		buf = strconv.AppendUint(buf, o.Size, 10)
+	}
+	if o.Permissions != nil {
+		buf = append(buf, `,"permissions":`...)
+		if o.Permissions == nil {
+			buf = append(buf, "null"...)
+		} else {
+			if buf, err = jsonlog.AppendJSON(buf, o.Permissions); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if o.PeInfo != nil {
+		buf = append(buf, `,"pe_info":`...)
+		if o.PeInfo == nil {
+			buf = append(buf, "null"...)
+		} else {
+			start3 := len(buf)
+			buf = append(buf, `,"company":`...)
+			buf = appendJSONString(buf, o.PeInfo.Company)
+			buf = append(buf, `,"description":`...)
+			buf = appendJSONString(buf, o.PeInfo.FileDescription)
+			buf = append(buf, `,"legal_copyright":`...)
+			buf = appendJSONString(buf, o.PeInfo.LegalCopyright)
+			buf = append(buf, `,"product":`...)
+			buf = appendJSONString(buf, o.PeInfo.Product)
+			buf = append(buf, `,"original_name":`...)
+			buf = appendJSONString(buf, o.PeInfo.OriginalName)
+			buf = append(buf, `,"internal_name":`...)
+			buf = appendJSONString(buf, o.PeInfo.InternalName)
+			buf = append(buf, `,"signed":`...)
+			buf = strconv.AppendBool(buf, o.PeInfo.Signed)
+			buf = append(buf, `,"signatures":`...)
+			if o.PeInfo.Signatures == nil {
+				buf = append(buf, "null"...)
+			} else {
+				buf = append(buf, '[')
+				for i4 := range o.PeInfo.Signatures {
+					if i4 > 0 {
+						buf = append(buf, ',')
+					}
+					start5 := len(buf)
+					buf = append(buf, `,"certificate_name":`...)
+					buf = appendJSONString(buf, o.PeInfo.Signatures[i4].CertificateName)
+					buf = append(buf, `,"signature_valid":`...)
+					buf = strconv.AppendBool(buf, o.PeInfo.Signatures[i4].SignatureValid)
+					buf = closeJSONObject(buf, start5)
+				}
+				buf = append(buf, ']')
+			}
+			buf = append(buf, `,"imphash":`...)
+			buf = appendJSONString(buf, o.PeInfo.Imphash)
+			buf = append(buf, `,"rich_header_hash":`...)
+			buf = appendJSONString(buf, o.PeInfo.RichHeaderHash)
+			buf = append(buf, `,"creation_timestamp":`...)
+			if buf, err = appendJSONTime(buf, o.PeInfo.CreationTimestamp); err != nil {
+				return nil, err
+			}
+			buf = closeJSONObject(buf, start3)
+		}
+	}
+	if len(o.Target) != 0 {
+		buf = append(buf, `,"target":`...)
+		buf = appendJSONString(buf, o.Target)
+	}
+	if len(o.UnpackSource) != 0 {
+		buf = append(buf, `,"unpack_source":`...)
+		if o.UnpackSource == nil {
+			buf = append(buf, "null"...)
+		} else {
+			buf = append(buf, '[')
+			for i6 := range o.UnpackSource {
+				if i6 > 0 {
+					buf = append(buf, ',')
+				}
+				buf = appendJSONString(buf, o.UnpackSource[i6])
+			}
+			buf = append(buf, ']')
+		}
+	}
+	if o.LinkInfo != nil {
+		buf = append(buf, `,"link_info":`...)
+		if o.LinkInfo == nil {
+			buf = append(buf, "null"...)
+		} else {
+			start7 := len(buf)
+			buf = append(buf, `,"target":`...)
+			buf = appendJSONString(buf, o.LinkInfo.Target)
+			buf = append(buf, `,"arguments":`...)
+			buf = appendJSONString(buf, o.LinkInfo.Arguments)
+			buf = append(buf, `,"command_line":`...)
+			buf = appendJSONString(buf, o.LinkInfo.CommandLine)
+			buf = append(buf, `,"created":`...)
+			if buf, err = appendJSONTime(buf, o.LinkInfo.CreationTime); err != nil {
+				return nil, err
+			}
+			buf = append(buf, `,"modified":`...)
+			if buf, err = appendJSONTime(buf, o.LinkInfo.WriteTime); err != nil {
+				return nil, err
+			}
+			buf = append(buf, `,"accessed":`...)
+			if buf, err = appendJSONTime(buf, o.LinkInfo.AccessTime); err != nil {
+				return nil, err
+			}
+			buf = closeJSONObject(buf, start7)
+		}
+	}
+	if o.RecycleBinInfo != nil {
+		buf = append(buf, `,"recycle_bin_info":`...)
+		if o.RecycleBinInfo == nil {
+			buf = append(buf, "null"...)
+		} else {
+			start8 := len(buf)
+			buf = append(buf, `,"original_file_name":`...)
+			buf = appendJSONString(buf, o.RecycleBinInfo.OriginalFilename)
+			buf = append(buf, `,"deletion_time":`...)
+			if buf, err = appendJSONTime(buf, o.RecycleBinInfo.DeletionTime); err != nil {
+				return nil, err
+			}
+			buf = append(buf, `,"original_file_size":`...)
+			buf = strconv.AppendUint(buf, o.RecycleBinInfo.OriginalFilesize, 10)
+			buf = closeJSONObject(buf, start8)
+		}
+	}
+	if o.WERInfo != nil {
+		buf = append(buf, `,"wer_info":`...)
+		if o.WERInfo == nil {
+			buf = append(buf, "null"...)
+		} else {
+			start9 := len(buf)
+			buf = append(buf, `,"type":`...)
+			buf = appendJSONString(buf, string(o.WERInfo.ReportType))
+			buf = append(buf, `,"event_name":`...)
+			buf = appendJSONString(buf, o.WERInfo.EventName)
+			buf = append(buf, `,"event_type":`...)
+			buf = appendJSONString(buf, o.WERInfo.EventType)
+			buf = append(buf, `,"date":`...)
+			if buf, err = appendJSONTime(buf, o.WERInfo.Date); err != nil {
+				return nil, err
+			}
+			buf = append(buf, `,"app_path":`...)
+			buf = appendJSONString(buf, o.WERInfo.AppPath)
+			buf = append(buf, `,"app_name":`...)
+			buf = appendJSONString(buf, o.WERInfo.AppName)
+			if len(o.WERInfo.Exe) != 0 {
+				buf = append(buf, `,"exe":`...)
+				buf = appendJSONString(buf, o.WERInfo.Exe)
+			}
+			if len(o.WERInfo.Error) != 0 {
+				buf = append(buf, `,"error":`...)
+				buf = appendJSONString(buf, o.WERInfo.Error)
+			}
+			if len(o.WERInfo.FaultModule) != 0 {
+				buf = append(buf, `,"fault_in_module":`...)
+				buf = appendJSONString(buf, o.WERInfo.FaultModule)
+			}
+			buf = closeJSONObject(buf, start9)
+		}
+	}
+	if o.Content != nil {
+		buf = append(buf, `,"content":`...)
+		if buf, err = o.Content.appendJSON(buf); err != nil {
+			return nil, err
+		}
+	}
+	if o.BeaconConfig != nil {
+		buf = append(buf, `,"beacon_config":`...)
+		if o.BeaconConfig == nil {
+			buf = append(buf, "null"...)
+		} else {
+			start10 := len(buf)
+			buf = append(buf, `,"beacon_type":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.Type)
+			buf = append(buf, `,"c2":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.C2)
+			buf = append(buf, `,"port":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.Port)
+			buf = append(buf, `,"spawn_to":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.SpawnTo)
+			buf = append(buf, `,"injection_process":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.InjectionProcess)
+			buf = append(buf, `,"pipe_name":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.Pipename)
+			buf = append(buf, `,"user_agent":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.UserAgent)
+			buf = append(buf, `,"proxy":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.Proxy)
+			buf = append(buf, `,"full_config":`...)
+			if buf, err = appendJSONValue(buf, &o.BeaconConfig.FullConfig); err != nil {
+				return nil, err
+			}
+			buf = append(buf, `,"cipher_parameters":`...)
+			start11 := len(buf)
+			buf = append(buf, `,"xaf_encoded":`...)
+			buf = strconv.AppendBool(buf, o.BeaconConfig.CipherParameters.XafEncoded)
+			buf = append(buf, `,"xaf_encoding_anchor":`...)
+			buf = strconv.AppendInt(buf, o.BeaconConfig.CipherParameters.XafEncodingAnchor, 10)
+			buf = append(buf, `,"xor_key":`...)
+			buf = strconv.AppendUint(buf, uint64(o.BeaconConfig.CipherParameters.XorKey), 10)
+			buf = append(buf, `,"beacon_offset":`...)
+			buf = strconv.AppendUint(buf, o.BeaconConfig.CipherParameters.BeaconOffset, 10)
+			buf = append(buf, `,"beacon_length":`...)
+			buf = strconv.AppendUint(buf, o.BeaconConfig.CipherParameters.BeaconLength, 10)
+			buf = append(buf, `,"block_start":`...)
+			if buf, err = appendJSONValue(buf, &o.BeaconConfig.CipherParameters.BlockStart); err != nil {
+				return nil, err
+			}
+			buf = append(buf, `,"pairwise_swapped":`...)
+			buf = strconv.AppendBool(buf, o.BeaconConfig.CipherParameters.PairwiseSwapped)
+			buf = closeJSONObject(buf, start11)
+			buf = closeJSONObject(buf, start10)
+		}
+	}
+	if o.VirusTotalInfo != nil {
+		buf = append(buf, `,"virustotal":`...)
+		if o.VirusTotalInfo == nil {
+			buf = append(buf, "null"...)
+		} else {
+			start12 := len(buf)
+			buf = append(buf, `,"result":`...)
+			buf = appendJSONString(buf, o.VirusTotalInfo.LookupResult)
+			buf = append(buf, `,"positive_verdicts":`...)
+			buf = strconv.AppendInt(buf, o.VirusTotalInfo.PositiveVerdicts, 10)
+			buf = append(buf, `,"total_verdicts":`...)
+			buf = strconv.AppendInt(buf, o.VirusTotalInfo.TotalVerdicts, 10)
+			if o.VirusTotalInfo.History != nil {
+				buf = append(buf, `,"history":`...)
+				if o.VirusTotalInfo.History == nil {
+					buf = append(buf, "null"...)
+				} else {
+					start13 := len(buf)
+					if len(o.VirusTotalInfo.History.Names) != 0 {
+						buf = append(buf, `,"names":`...)
+						if o.VirusTotalInfo.History.Names == nil {
+							buf = append(buf, "null"...)
+						} else {
+							buf = append(buf, '[')
+							for i14 := range o.VirusTotalInfo.History.Names {
+								if i14 > 0 {
+									buf = append(buf, ',')
+								}
+								buf = appendJSONString(buf, o.VirusTotalInfo.History.Names[i14])
+							}
+							buf = append(buf, ']')
+						}
+					}
+					if len(o.VirusTotalInfo.History.Tags) != 0 {
+						buf = append(buf, `,"tags":`...)
+						if o.VirusTotalInfo.History.Tags == nil {
+							buf = append(buf, "null"...)
+						} else {
+							buf = append(buf, '[')
+							for i15 := range o.VirusTotalInfo.History.Tags {
+								if i15 > 0 {
+									buf = append(buf, ',')
+								}
+								buf = appendJSONString(buf, o.VirusTotalInfo.History.Tags[i15])
+							}
+							buf = append(buf, ']')
+						}
+					}
+					if o.VirusTotalInfo.History.Submissions != 0 {
+						buf = append(buf, `,"submissions":`...)
+						buf = strconv.AppendInt(buf, o.VirusTotalInfo.History.Submissions, 10)
+					}
+					if o.VirusTotalInfo.History.FirstSubmission != nil {
+						buf = append(buf, `,"first_submission":`...)
+						if buf, err = appendJSONValue(buf, &o.VirusTotalInfo.History.FirstSubmission); err != nil {
+							return nil, err
+						}
+					}
+					if o.VirusTotalInfo.History.LastSubmission != nil {
+						buf = append(buf, `,"last_submission":`...)
+						if buf, err = appendJSONValue(buf, &o.VirusTotalInfo.History.LastSubmission); err != nil {
+							return nil, err
+						}
+					}
+					buf = closeJSONObject(buf, start13)
+				}
+			}
+			buf = closeJSONObject(buf, start12)
+		}
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *File) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Path) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FILE", Value: t.FormatField(o.Path, textlogModifiersNone)})
+	}
+	if !(o.Exists == "" || o.Exists.IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Exists)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "EXISTS", Value: t.FormatField(o.Exists, textlogModifiersOmitempty)})
+	}
+	if !(o.Extension == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Extension)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "EXTENSION", Value: t.FormatField(o.Extension, textlogModifiersOmitempty)})
+	}
+	if !(o.MagicHeader == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.MagicHeader)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TYPE", Value: t.FormatField(o.MagicHeader, textlogModifiersOmitempty)})
+	}
+	if !(o.Hashes == nil) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.Hashes)) {
+		if o.Hashes != nil {
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Hashes.Md5) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "MD5", Value: t.FormatField(o.Hashes.Md5, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Hashes.Sha1) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "SHA1", Value: t.FormatField(o.Hashes.Sha1, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Hashes.Sha256) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "SHA256", Value: t.FormatField(o.Hashes.Sha256, textlogModifiersNone)})
+			}
+		}
+	}
+	if !(o.FirstBytes == nil) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.FirstBytes)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FIRSTBYTES", Value: t.FormatField(o.FirstBytes, textlogModifiersOmitempty)})
+	}
+	if !(o.Filetimes == nil) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.Filetimes)) {
+		if o.Filetimes != nil {
+			if t.Omit == nil || !t.Omit(textlogModifiersWithMillis, o.Filetimes.Mtime) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "MODIFIED", Value: t.FormatField(o.Filetimes.Mtime, textlogModifiersWithMillis)})
+			}
+			if !(o.Filetimes.Atime == nil || o.Filetimes.Atime.IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersOmitemptyWithMillis, o.Filetimes.Atime)) {
+				var value0 any = o.Filetimes.Atime
+				if o.Filetimes.Atime != nil {
+					value0 = (*o.Filetimes.Atime)
+				}
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "ACCESSED", Value: t.FormatField(value0, textlogModifiersOmitemptyWithMillis)})
+			}
+			if !(o.Filetimes.Ctime == nil || o.Filetimes.Ctime.IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersOmitemptyWithMillis, o.Filetimes.Ctime)) {
+				var value1 any = o.Filetimes.Ctime
+				if o.Filetimes.Ctime != nil {
+					value1 = (*o.Filetimes.Ctime)
+				}
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "CHANGED", Value: t.FormatField(value1, textlogModifiersOmitemptyWithMillis)})
+			}
+			if !(o.Filetimes.Btime == nil || o.Filetimes.Btime.IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersOmitemptyWithMillis, o.Filetimes.Btime)) {
+				var value2 any = o.Filetimes.Btime
+				if o.Filetimes.Btime != nil {
+					value2 = (*o.Filetimes.Btime)
+				}
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "CREATED", Value: t.FormatField(value2, textlogModifiersOmitemptyWithMillis)})
+			}
+			if !(o.Filetimes.UsnChangeTime == nil || o.Filetimes.UsnChangeTime.IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersOmitemptyWithMillis, o.Filetimes.UsnChangeTime)) {
+				var value3 any = o.Filetimes.UsnChangeTime
+				if o.Filetimes.UsnChangeTime != nil {
+					value3 = (*o.Filetimes.UsnChangeTime)
+				}
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "USN_CHANGE_TIME", Value: t.FormatField(value3, textlogModifiersOmitemptyWithMillis)})
+			}
+			if !(o.Filetimes.MftFileNameModified == nil || o.Filetimes.MftFileNameModified.IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersOmitemptyWithMillis, o.Filetimes.MftFileNameModified)) {
+				var value4 any = o.Filetimes.MftFileNameModified
+				if o.Filetimes.MftFileNameModified != nil {
+					value4 = (*o.Filetimes.MftFileNameModified)
+				}
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "MFT_FILE_NAME_MODIFIED", Value: t.FormatField(value4, textlogModifiersOmitemptyWithMillis)})
+			}
+			if !(o.Filetimes.MftFileNameAccessed == nil || o.Filetimes.MftFileNameAccessed.IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersOmitemptyWithMillis, o.Filetimes.MftFileNameAccessed)) {
+				var value5 any = o.Filetimes.MftFileNameAccessed
+				if o.Filetimes.MftFileNameAccessed != nil {
+					value5 = (*o.Filetimes.MftFileNameAccessed)
+				}
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "MFT_FILE_NAME_ACCESSED", Value: t.FormatField(value5, textlogModifiersOmitemptyWithMillis)})
+			}
+			if !(o.Filetimes.MftFileNameChanged == nil || o.Filetimes.MftFileNameChanged.IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersOmitemptyWithMillis, o.Filetimes.MftFileNameChanged)) {
+				var value6 any = o.Filetimes.MftFileNameChanged
+				if o.Filetimes.MftFileNameChanged != nil {
+					value6 = (*o.Filetimes.MftFileNameChanged)
+				}
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "MFT_FILE_NAME_CHANGED", Value: t.FormatField(value6, textlogModifiersOmitemptyWithMillis)})
+			}
+			if !(o.Filetimes.MftFileNameCreated == nil || o.Filetimes.MftFileNameCreated.IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersOmitemptyWithMillis, o.Filetimes.MftFileNameCreated)) {
+				var value7 any = o.Filetimes.MftFileNameCreated
+				if o.Filetimes.MftFileNameCreated != nil {
+					value7 = (*o.Filetimes.MftFileNameCreated)
+				}
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "MFT_FILE_NAME_CREATED", Value: t.FormatField(value7, textlogModifiersOmitemptyWithMillis)})
+			}
+		}
+	}
+	if !(o.Size == 0) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Size)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SIZE", Value: t.FormatField(o.Size, textlogModifiersOmitempty)})
+	}
+	if !(o.Permissions == nil || hasZeroMethod(o.Permissions)) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.Permissions)) {
+		for _, pair := range t.FormatEntry(o.Permissions) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: pair.Key, Value: pair.Value})
+		}
+	}
+	if !(o.PeInfo == nil) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.PeInfo)) {
+		if o.PeInfo != nil {
+			if !(o.PeInfo.Company == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.PeInfo.Company)) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "COMPANY", Value: t.FormatField(o.PeInfo.Company, textlogModifiersOmitempty)})
+			}
+			if !(o.PeInfo.FileDescription == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.PeInfo.FileDescription)) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "DESCRIPTION", Value: t.FormatField(o.PeInfo.FileDescription, textlogModifiersOmitempty)})
+			}
+			if !(o.PeInfo.LegalCopyright == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.PeInfo.LegalCopyright)) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "LEGAL_COPYRIGHT", Value: t.FormatField(o.PeInfo.LegalCopyright, textlogModifiersOmitempty)})
+			}
+			if !(o.PeInfo.Product == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.PeInfo.Product)) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "PRODUCT", Value: t.FormatField(o.PeInfo.Product, textlogModifiersOmitempty)})
+			}
+			if !(o.PeInfo.OriginalName == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.PeInfo.OriginalName)) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "ORIGINAL_NAME", Value: t.FormatField(o.PeInfo.OriginalName, textlogModifiersOmitempty)})
+			}
+			if !(o.PeInfo.InternalName == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.PeInfo.InternalName)) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "INTERNAL_NAME", Value: t.FormatField(o.PeInfo.InternalName, textlogModifiersOmitempty)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.PeInfo.Signed) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "SIGNED", Value: t.FormatField(o.PeInfo.Signed, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.PeInfo.Signatures) {
+				for i8 := range o.PeInfo.Signatures {
+					index9 := strconv.Itoa(i8 + 1)
+					if !(o.PeInfo.Signatures[i8].CertificateName == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.PeInfo.Signatures[i8].CertificateName)) {
+						entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("CERTIFICATE_NAME", index9), Value: t.FormatField(o.PeInfo.Signatures[i8].CertificateName, textlogModifiersOmitempty)})
+					}
+					if t.Omit == nil || !t.Omit(textlogModifiersNone, o.PeInfo.Signatures[i8].SignatureValid) {
+						entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("SIGNATURE_VALID", index9), Value: t.FormatField(o.PeInfo.Signatures[i8].SignatureValid, textlogModifiersNone)})
+					}
+				}
+			}
+			if !(o.PeInfo.Imphash == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.PeInfo.Imphash)) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "IMPHASH", Value: t.FormatField(o.PeInfo.Imphash, textlogModifiersOmitempty)})
+			}
+		}
+	}
+	if !(o.Target == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Target)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TARGET", Value: t.FormatField(o.Target, textlogModifiersOmitempty)})
+	}
+	if !(o.UnpackSource == nil) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.UnpackSource)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "UNPACK_SOURCE", Value: t.FormatField(o.UnpackSource, textlogModifiersOmitempty)})
+	}
+	if !(o.LinkInfo == nil) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.LinkInfo)) {
+		if o.LinkInfo != nil {
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LinkInfo.Target) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "TARGET", Value: t.FormatField(o.LinkInfo.Target, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LinkInfo.Arguments) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "ARGUMENTS", Value: t.FormatField(o.LinkInfo.Arguments, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LinkInfo.CommandLine) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "COMMAND_LINE", Value: t.FormatField(o.LinkInfo.CommandLine, textlogModifiersNone)})
+			}
+		}
+	}
+	if !(o.RecycleBinInfo == nil) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.RecycleBinInfo)) {
+		if o.RecycleBinInfo != nil {
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.RecycleBinInfo.OriginalFilename) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "ORIGINAL_FILENAME", Value: t.FormatField(o.RecycleBinInfo.OriginalFilename, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.RecycleBinInfo.DeletionTime) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "DELETION_TIME", Value: t.FormatField(o.RecycleBinInfo.DeletionTime, textlogModifiersNone)})
+			}
+		}
+	}
+	if !(o.WERInfo == nil) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.WERInfo)) {
+		if o.WERInfo != nil {
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.WERInfo.ReportType) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "REPORTTYPE", Value: t.FormatField(o.WERInfo.ReportType, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.WERInfo.EventName) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "EVENTNAME", Value: t.FormatField(o.WERInfo.EventName, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.WERInfo.EventType) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "EVENTTYPE", Value: t.FormatField(o.WERInfo.EventType, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.WERInfo.Date) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "DATE", Value: t.FormatField(o.WERInfo.Date, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.WERInfo.AppPath) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "APPPATH", Value: t.FormatField(o.WERInfo.AppPath, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.WERInfo.AppName) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "APPNAME", Value: t.FormatField(o.WERInfo.AppName, textlogModifiersNone)})
+			}
+			if !(o.WERInfo.Exe == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.WERInfo.Exe)) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "EXE", Value: t.FormatField(o.WERInfo.Exe, textlogModifiersOmitempty)})
+			}
+			if !(o.WERInfo.Error == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.WERInfo.Error)) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "ERROR", Value: t.FormatField(o.WERInfo.Error, textlogModifiersOmitempty)})
+			}
+			if !(o.WERInfo.FaultModule == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.WERInfo.FaultModule)) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "FAULT_IN_MODULE", Value: t.FormatField(o.WERInfo.FaultModule, textlogModifiersOmitempty)})
+			}
+		}
+	}
+	if !(o.Content == nil) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.Content)) {
+		for _, pair := range o.Content.marshalTextLog(t) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("CONTENT", pair.Key), Value: pair.Value})
+		}
+	}
+	if !(o.BeaconConfig == nil) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.BeaconConfig)) {
+		if o.BeaconConfig != nil {
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.Type) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_TYPE", Value: t.FormatField(o.BeaconConfig.Type, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.C2) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_C2", Value: t.FormatField(o.BeaconConfig.C2, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.Port) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_PORT", Value: t.FormatField(o.BeaconConfig.Port, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.SpawnTo) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_SPAWNTO", Value: t.FormatField(o.BeaconConfig.SpawnTo, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.InjectionProcess) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_INJECTION_PROCESS", Value: t.FormatField(o.BeaconConfig.InjectionProcess, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.Pipename) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_PIPENAME", Value: t.FormatField(o.BeaconConfig.Pipename, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.UserAgent) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_USER_AGENT", Value: t.FormatField(o.BeaconConfig.UserAgent, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.Proxy) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_PROXY", Value: t.FormatField(o.BeaconConfig.Proxy, textlogModifiersNone)})
+			}
+			if !(reflect.ValueOf(o.BeaconConfig.CipherParameters).IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.BeaconConfig.CipherParameters)) {
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.XafEncoded) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_XAF_ENCODED", Value: t.FormatField(o.BeaconConfig.CipherParameters.XafEncoded, textlogModifiersNone)})
+				}
+				if !(o.BeaconConfig.CipherParameters.XafEncodingAnchor == 0) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.BeaconConfig.CipherParameters.XafEncodingAnchor)) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_XAF_ENCODING_ANCHOR", Value: t.FormatField(o.BeaconConfig.CipherParameters.XafEncodingAnchor, textlogModifiersOmitempty)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.XorKey) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_XOR_KEY", Value: t.FormatField(o.BeaconConfig.CipherParameters.XorKey, textlogModifiersNone)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.BeaconOffset) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_BEACON_OFFSET", Value: t.FormatField(o.BeaconConfig.CipherParameters.BeaconOffset, textlogModifiersNone)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.BeaconLength) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_BEACON_LENGTH", Value: t.FormatField(o.BeaconConfig.CipherParameters.BeaconLength, textlogModifiersNone)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.BlockStart) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_BLOCK_START", Value: t.FormatField(o.BeaconConfig.CipherParameters.BlockStart, textlogModifiersNone)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.PairwiseSwapped) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_PAIRWISE_SWAPPED", Value: t.FormatField(o.BeaconConfig.CipherParameters.PairwiseSwapped, textlogModifiersNone)})
+				}
+			}
+		}
+	}
+	if !(o.VirusTotalInfo == nil) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.VirusTotalInfo)) {
+		if o.VirusTotalInfo != nil {
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.VirusTotalInfo.LookupResult) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "VIRUSTOTAL_RESULT", Value: t.FormatField(o.VirusTotalInfo.LookupResult, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.VirusTotalInfo.PositiveVerdicts) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "VIRUSTOTAL_VERDICTS", Value: t.FormatField(o.VirusTotalInfo.PositiveVerdicts, textlogModifiersNone)})
+			}
+			if !(o.VirusTotalInfo.History == nil) && (t.Omit == nil || !t.Omit(textlogModifiersOmitemptyExpand, o.VirusTotalInfo.History)) {
+				if o.VirusTotalInfo.History != nil {
+					if t.Omit == nil || !t.Omit(textlogModifiersNone, o.VirusTotalInfo.History.Names) {
+						entry = append(entry, jsonlog.TextlogValuePair{Key: "VIRUSTOTAL_NAMES", Value: t.FormatField(o.VirusTotalInfo.History.Names, textlogModifiersNone)})
+					}
+					if t.Omit == nil || !t.Omit(textlogModifiersNone, o.VirusTotalInfo.History.Tags) {
+						entry = append(entry, jsonlog.TextlogValuePair{Key: "VIRUSTOTAL_TAGS", Value: t.FormatField(o.VirusTotalInfo.History.Tags, textlogModifiersNone)})
+					}
+					if t.Omit == nil || !t.Omit(textlogModifiersNone, o.VirusTotalInfo.History.Submissions) {
+						entry = append(entry, jsonlog.TextlogValuePair{Key: "VIRUSTOTAL_SUBMISSIONS", Value: t.FormatField(o.VirusTotalInfo.History.Submissions, textlogModifiersNone)})
+					}
+					if !(o.VirusTotalInfo.History.FirstSubmission == nil || o.VirusTotalInfo.History.FirstSubmission.IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.VirusTotalInfo.History.FirstSubmission)) {
+						var value10 any = o.VirusTotalInfo.History.FirstSubmission
+						if o.VirusTotalInfo.History.FirstSubmission != nil {
+							value10 = (*o.VirusTotalInfo.History.FirstSubmission)
+						}
+						entry = append(entry, jsonlog.TextlogValuePair{Key: "VIRUSTOTAL_FIRST_SUBMISSION", Value: t.FormatField(value10, textlogModifiersOmitempty)})
+					}
+					if !(o.VirusTotalInfo.History.LastSubmission == nil || o.VirusTotalInfo.History.LastSubmission.IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.VirusTotalInfo.History.LastSubmission)) {
+						var value11 any = o.VirusTotalInfo.History.LastSubmission
+						if o.VirusTotalInfo.History.LastSubmission != nil {
+							value11 = (*o.VirusTotalInfo.History.LastSubmission)
+						}
+						entry = append(entry, jsonlog.TextlogValuePair{Key: "VIRUSTOTAL_LAST_SUBMISSION", Value: t.FormatField(value11, textlogModifiersOmitempty)})
+					}
+				}
+			}
+		}
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *File) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Path) {
+		return jsonpointer.Pointer{"path"}
+	}
+	if pointee == any(&o.Exists) {
+		return jsonpointer.Pointer{"exists"}
+	}
+	if pointee == any(&o.Extension) {
+		return jsonpointer.Pointer{"extension"}
+	}
+	if pointee == any(&o.FileMode) {
+		return jsonpointer.Pointer{"-"}
+	}
+	if pointee == any(&o.MagicHeader) {
+		return jsonpointer.Pointer{"magic_header"}
+	}
+	if pointee == any(&o.Hashes) {
+		return jsonpointer.Pointer{"hashes"}
+	}
+	if pointee == any(o.Hashes) {
+		return jsonpointer.Pointer{"hashes"}
+	}
+	if o.Hashes != nil {
+		if pointee == any(&o.Hashes.Md5) {
+			return jsonpointer.Pointer{"hashes", "md5"}
+		}
+		if pointee == any(&o.Hashes.Sha1) {
+			return jsonpointer.Pointer{"hashes", "sha1"}
+		}
+		if pointee == any(&o.Hashes.Sha256) {
+			return jsonpointer.Pointer{"hashes", "sha256"}
+		}
+	}
+	if pointee == any(&o.FirstBytes) {
+		return jsonpointer.Pointer{"first_bytes"}
+	}
+	for i0 := range o.FirstBytes {
+		if pointee == any(&o.FirstBytes[i0]) {
+			return jsonpointer.Pointer{"first_bytes", strconv.Itoa(i0)}
+		}
+	}
+	if pointee == any(&o.Filetimes) {
+		return jsonpointer.Pointer{"file_times"}
+	}
+	if pointee == any(o.Filetimes) {
+		return jsonpointer.Pointer{"file_times"}
+	}
+	if o.Filetimes != nil {
+		if pointee == any(&o.Filetimes.Mtime) {
+			return jsonpointer.Pointer{"file_times", "modified"}
+		}
+		if pointee == any(&o.Filetimes.Atime) {
+			return jsonpointer.Pointer{"file_times", "accessed"}
+		}
+		if pointee == any(o.Filetimes.Atime) {
+			return jsonpointer.Pointer{"file_times", "accessed"}
+		}
+		if pointee == any(&o.Filetimes.Ctime) {
+			return jsonpointer.Pointer{"file_times", "changed"}
+		}
+		if pointee == any(o.Filetimes.Ctime) {
+			return jsonpointer.Pointer{"file_times", "changed"}
+		}
+		if pointee == any(&o.Filetimes.Btime) {
+			return jsonpointer.Pointer{"file_times", "created"}
+		}
+		if pointee == any(o.Filetimes.Btime) {
+			return jsonpointer.Pointer{"file_times", "created"}
+		}
+		if pointee == any(&o.Filetimes.UsnChangeTime) {
+			return jsonpointer.Pointer{"file_times", "usn_change_time"}
+		}
+		if pointee == any(o.Filetimes.UsnChangeTime) {
+			return jsonpointer.Pointer{"file_times", "usn_change_time"}
+		}
+		if pointee == any(&o.Filetimes.MftFileNameModified) {
+			return jsonpointer.Pointer{"file_times", "mft_file_name_modified"}
+		}
+		if pointee == any(o.Filetimes.MftFileNameModified) {
+			return jsonpointer.Pointer{"file_times", "mft_file_name_modified"}
+		}
+		if pointee == any(&o.Filetimes.MftFileNameAccessed) {
+			return jsonpointer.Pointer{"file_times", "mft_file_name_accessed"}
+		}
+		if pointee == any(o.Filetimes.MftFileNameAccessed) {
+			return jsonpointer.Pointer{"file_times", "mft_file_name_accessed"}
+		}
+		if pointee == any(&o.Filetimes.MftFileNameChanged) {
+			return jsonpointer.Pointer{"file_times", "mft_file_name_changed"}
+		}
+		if pointee == any(o.Filetimes.MftFileNameChanged) {
+			return jsonpointer.Pointer{"file_times", "mft_file_name_changed"}
+		}
+		if pointee == any(&o.Filetimes.MftFileNameCreated) {
+			return jsonpointer.Pointer{"file_times", "mft_file_name_created"}
+		}
+		if pointee == any(o.Filetimes.MftFileNameCreated) {
+			return jsonpointer.Pointer{"file_times", "mft_file_name_created"}
+		}
+	}
+	if pointee == any(&o.Size) {
+		return jsonpointer.Pointer{"size"}
+	}
+	if pointee == any(&o.Permissions) {
+		return jsonpointer.Pointer{"permissions"}
+	}
+	if p := jsonlog.RelativeJsonPointer(o.Permissions, pointee); p != nil {
+		return append(jsonpointer.Pointer{"permissions"}, p...)
+	}
+	if pointee == any(&o.PeInfo) {
+		return jsonpointer.Pointer{"pe_info"}
+	}
+	if pointee == any(o.PeInfo) {
+		return jsonpointer.Pointer{"pe_info"}
+	}
+	if o.PeInfo != nil {
+		if pointee == any(&o.PeInfo.Company) {
+			return jsonpointer.Pointer{"pe_info", "company"}
+		}
+		if pointee == any(&o.PeInfo.FileDescription) {
+			return jsonpointer.Pointer{"pe_info", "description"}
+		}
+		if pointee == any(&o.PeInfo.LegalCopyright) {
+			return jsonpointer.Pointer{"pe_info", "legal_copyright"}
+		}
+		if pointee == any(&o.PeInfo.Product) {
+			return jsonpointer.Pointer{"pe_info", "product"}
+		}
+		if pointee == any(&o.PeInfo.OriginalName) {
+			return jsonpointer.Pointer{"pe_info", "original_name"}
+		}
+		if pointee == any(&o.PeInfo.InternalName) {
+			return jsonpointer.Pointer{"pe_info", "internal_name"}
+		}
+		if pointee == any(&o.PeInfo.Signed) {
+			return jsonpointer.Pointer{"pe_info", "signed"}
+		}
+		if pointee == any(&o.PeInfo.Signatures) {
+			return jsonpointer.Pointer{"pe_info", "signatures"}
+		}
+		for i1 := range o.PeInfo.Signatures {
+			if pointee == any(&o.PeInfo.Signatures[i1]) {
+				return jsonpointer.Pointer{"pe_info", "signatures", strconv.Itoa(i1)}
+			}
+			if pointee == any(&o.PeInfo.Signatures[i1].CertificateName) {
+				return jsonpointer.Pointer{"pe_info", "signatures", strconv.Itoa(i1), "certificate_name"}
+			}
+			if pointee == any(&o.PeInfo.Signatures[i1].SignatureValid) {
+				return jsonpointer.Pointer{"pe_info", "signatures", strconv.Itoa(i1), "signature_valid"}
+			}
+		}
+		if pointee == any(&o.PeInfo.Imphash) {
+			return jsonpointer.Pointer{"pe_info", "imphash"}
+		}
+		if pointee == any(&o.PeInfo.RichHeaderHash) {
+			return jsonpointer.Pointer{"pe_info", "rich_header_hash"}
+		}
+		if pointee == any(&o.PeInfo.CreationTimestamp) {
+			return jsonpointer.Pointer{"pe_info", "creation_timestamp"}
+		}
+	}
+	if pointee == any(&o.Target) {
+		return jsonpointer.Pointer{"target"}
+	}
+	if pointee == any(&o.UnpackSource) {
+		return jsonpointer.Pointer{"unpack_source"}
+	}
+	if p := o.UnpackSource.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"unpack_source"}, p...)
+	}
+	if pointee == any(&o.LinkInfo) {
+		return jsonpointer.Pointer{"link_info"}
+	}
+	if pointee == any(o.LinkInfo) {
+		return jsonpointer.Pointer{"link_info"}
+	}
+	if o.LinkInfo != nil {
+		if pointee == any(&o.LinkInfo.Target) {
+			return jsonpointer.Pointer{"link_info", "target"}
+		}
+		if pointee == any(&o.LinkInfo.Arguments) {
+			return jsonpointer.Pointer{"link_info", "arguments"}
+		}
+		if pointee == any(&o.LinkInfo.CommandLine) {
+			return jsonpointer.Pointer{"link_info", "command_line"}
+		}
+		if pointee == any(&o.LinkInfo.CreationTime) {
+			return jsonpointer.Pointer{"link_info", "created"}
+		}
+		if pointee == any(&o.LinkInfo.WriteTime) {
+			return jsonpointer.Pointer{"link_info", "modified"}
+		}
+		if pointee == any(&o.LinkInfo.AccessTime) {
+			return jsonpointer.Pointer{"link_info", "accessed"}
+		}
+	}
+	if pointee == any(&o.RecycleBinInfo) {
+		return jsonpointer.Pointer{"recycle_bin_info"}
+	}
+	if pointee == any(o.RecycleBinInfo) {
+		return jsonpointer.Pointer{"recycle_bin_info"}
+	}
+	if o.RecycleBinInfo != nil {
+		if pointee == any(&o.RecycleBinInfo.Version) {
+			return jsonpointer.Pointer{"recycle_bin_info", "-"}
+		}
+		if pointee == any(&o.RecycleBinInfo.OriginalFilename) {
+			return jsonpointer.Pointer{"recycle_bin_info", "original_file_name"}
+		}
+		if pointee == any(&o.RecycleBinInfo.DeletionTime) {
+			return jsonpointer.Pointer{"recycle_bin_info", "deletion_time"}
+		}
+		if pointee == any(&o.RecycleBinInfo.OriginalFilesize) {
+			return jsonpointer.Pointer{"recycle_bin_info", "original_file_size"}
+		}
+	}
+	if pointee == any(&o.WERInfo) {
+		return jsonpointer.Pointer{"wer_info"}
+	}
+	if pointee == any(o.WERInfo) {
+		return jsonpointer.Pointer{"wer_info"}
+	}
+	if o.WERInfo != nil {
+		if pointee == any(&o.WERInfo.ReportType) {
+			return jsonpointer.Pointer{"wer_info", "type"}
+		}
+		if pointee == any(&o.WERInfo.EventName) {
+			return jsonpointer.Pointer{"wer_info", "event_name"}
+		}
+		if pointee == any(&o.WERInfo.EventType) {
+			return jsonpointer.Pointer{"wer_info", "event_type"}
+		}
+		if pointee == any(&o.WERInfo.Date) {
+			return jsonpointer.Pointer{"wer_info", "date"}
+		}
+		if pointee == any(&o.WERInfo.AppPath) {
+			return jsonpointer.Pointer{"wer_info", "app_path"}
+		}
+		if pointee == any(&o.WERInfo.AppName) {
+			return jsonpointer.Pointer{"wer_info", "app_name"}
+		}
+		if pointee == any(&o.WERInfo.Exe) {
+			return jsonpointer.Pointer{"wer_info", "exe"}
+		}
+		if pointee == any(&o.WERInfo.Error) {
+			return jsonpointer.Pointer{"wer_info", "error"}
+		}
+		if pointee == any(&o.WERInfo.FaultModule) {
+			return jsonpointer.Pointer{"wer_info", "fault_in_module"}
+		}
+	}
+	if pointee == any(&o.Content) {
+		return jsonpointer.Pointer{"content"}
+	}
+	if pointee == any(o.Content) {
+		return jsonpointer.Pointer{"content"}
+	}
+	if p := o.Content.relativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"content"}, p...)
+	}
+	if pointee == any(&o.BeaconConfig) {
+		return jsonpointer.Pointer{"beacon_config"}
+	}
+	if pointee == any(o.BeaconConfig) {
+		return jsonpointer.Pointer{"beacon_config"}
+	}
+	if o.BeaconConfig != nil {
+		if pointee == any(&o.BeaconConfig.Type) {
+			return jsonpointer.Pointer{"beacon_config", "beacon_type"}
+		}
+		if pointee == any(&o.BeaconConfig.C2) {
+			return jsonpointer.Pointer{"beacon_config", "c2"}
+		}
+		if pointee == any(&o.BeaconConfig.Port) {
+			return jsonpointer.Pointer{"beacon_config", "port"}
+		}
+		if pointee == any(&o.BeaconConfig.SpawnTo) {
+			return jsonpointer.Pointer{"beacon_config", "spawn_to"}
+		}
+		if pointee == any(&o.BeaconConfig.InjectionProcess) {
+			return jsonpointer.Pointer{"beacon_config", "injection_process"}
+		}
+		if pointee == any(&o.BeaconConfig.Pipename) {
+			return jsonpointer.Pointer{"beacon_config", "pipe_name"}
+		}
+		if pointee == any(&o.BeaconConfig.UserAgent) {
+			return jsonpointer.Pointer{"beacon_config", "user_agent"}
+		}
+		if pointee == any(&o.BeaconConfig.Proxy) {
+			return jsonpointer.Pointer{"beacon_config", "proxy"}
+		}
+		if pointee == any(&o.BeaconConfig.FullConfig) {
+			return jsonpointer.Pointer{"beacon_config", "full_config"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XafEncoded) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "xaf_encoded"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XafEncodingAnchor) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "xaf_encoding_anchor"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XorKey) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "xor_key"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BeaconOffset) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "beacon_offset"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BeaconLength) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "beacon_length"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BlockStart) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "block_start"}
+		}
+		for i2 := range o.BeaconConfig.CipherParameters.BlockStart {
+			if pointee == any(&o.BeaconConfig.CipherParameters.BlockStart[i2]) {
+				return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "block_start", strconv.Itoa(i2)}
+			}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.PairwiseSwapped) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "pairwise_swapped"}
+		}
+	}
+	if pointee == any(&o.VirusTotalInfo) {
+		return jsonpointer.Pointer{"virustotal"}
+	}
+	if pointee == any(o.VirusTotalInfo) {
+		return jsonpointer.Pointer{"virustotal"}
+	}
+	if o.VirusTotalInfo != nil {
+		if pointee == any(&o.VirusTotalInfo.LookupResult) {
+			return jsonpointer.Pointer{"virustotal", "result"}
+		}
+		if pointee == any(&o.VirusTotalInfo.PositiveVerdicts) {
+			return jsonpointer.Pointer{"virustotal", "positive_verdicts"}
+		}
+		if pointee == any(&o.VirusTotalInfo.TotalVerdicts) {
+			return jsonpointer.Pointer{"virustotal", "total_verdicts"}
+		}
+		if pointee == any(&o.VirusTotalInfo.History) {
+			return jsonpointer.Pointer{"virustotal", "history"}
+		}
+		if pointee == any(o.VirusTotalInfo.History) {
+			return jsonpointer.Pointer{"virustotal", "history"}
+		}
+		if o.VirusTotalInfo.History != nil {
+			if pointee == any(&o.VirusTotalInfo.History.Names) {
+				return jsonpointer.Pointer{"virustotal", "history", "names"}
+			}
+			if p := o.VirusTotalInfo.History.Names.RelativeJsonPointer(pointee); p != nil {
+				return append(jsonpointer.Pointer{"virustotal", "history", "names"}, p...)
+			}
+			if pointee == any(&o.VirusTotalInfo.History.Tags) {
+				return jsonpointer.Pointer{"virustotal", "history", "tags"}
+			}
+			if p := o.VirusTotalInfo.History.Tags.RelativeJsonPointer(pointee); p != nil {
+				return append(jsonpointer.Pointer{"virustotal", "history", "tags"}, p...)
+			}
+			if pointee == any(&o.VirusTotalInfo.History.Submissions) {
+				return jsonpointer.Pointer{"virustotal", "history", "submissions"}
+			}
+			if pointee == any(&o.VirusTotalInfo.History.FirstSubmission) {
+				return jsonpointer.Pointer{"virustotal", "history", "first_submission"}
+			}
+			if pointee == any(o.VirusTotalInfo.History.FirstSubmission) {
+				return jsonpointer.Pointer{"virustotal", "history", "first_submission"}
+			}
+			if pointee == any(&o.VirusTotalInfo.History.LastSubmission) {
+				return jsonpointer.Pointer{"virustotal", "history", "last_submission"}
+			}
+			if pointee == any(o.VirusTotalInfo.History.LastSubmission) {
+				return jsonpointer.Pointer{"virustotal", "history", "last_submission"}
+			}
+		}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *File) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Path) {
+		return "FILE", true
+	}
+	if pointee == any(&o.Exists) {
+		return "EXISTS", true
+	}
+	if pointee == any(&o.Extension) {
+		return "EXTENSION", true
+	}
+	if pointee == any(&o.FileMode) {
+		return "-", true
+	}
+	if pointee == any(&o.MagicHeader) {
+		return "TYPE", true
+	}
+	if pointee == any(o.Hashes) {
+		return "", true
+	}
+	if pointee == any(&o.Hashes) {
+		return "", true
+	}
+	if o.Hashes != nil {
+		if pointee == any(&o.Hashes.Md5) {
+			return "MD5", true
+		}
+		if pointee == any(&o.Hashes.Sha1) {
+			return "SHA1", true
+		}
+		if pointee == any(&o.Hashes.Sha256) {
+			return "SHA256", true
+		}
+	}
+	if pointee == any(&o.FirstBytes) {
+		return "FIRSTBYTES", true
+	}
+	if pointee == any(o.Filetimes) {
+		return "", true
+	}
+	if pointee == any(&o.Filetimes) {
+		return "", true
+	}
+	if o.Filetimes != nil {
+		if pointee == any(&o.Filetimes.Mtime) {
+			return "MODIFIED", true
+		}
+		if pointee == any(o.Filetimes.Atime) {
+			return "ACCESSED", true
+		}
+		if pointee == any(&o.Filetimes.Atime) {
+			return "ACCESSED", true
+		}
+		if pointee == any(o.Filetimes.Ctime) {
+			return "CHANGED", true
+		}
+		if pointee == any(&o.Filetimes.Ctime) {
+			return "CHANGED", true
+		}
+		if pointee == any(o.Filetimes.Btime) {
+			return "CREATED", true
+		}
+		if pointee == any(&o.Filetimes.Btime) {
+			return "CREATED", true
+		}
+		if pointee == any(o.Filetimes.UsnChangeTime) {
+			return "USN_CHANGE_TIME", true
+		}
+		if pointee == any(&o.Filetimes.UsnChangeTime) {
+			return "USN_CHANGE_TIME", true
+		}
+		if pointee == any(o.Filetimes.MftFileNameModified) {
+			return "MFT_FILE_NAME_MODIFIED", true
+		}
+		if pointee == any(&o.Filetimes.MftFileNameModified) {
+			return "MFT_FILE_NAME_MODIFIED", true
+		}
+		if pointee == any(o.Filetimes.MftFileNameAccessed) {
+			return "MFT_FILE_NAME_ACCESSED", true
+		}
+		if pointee == any(&o.Filetimes.MftFileNameAccessed) {
+			return "MFT_FILE_NAME_ACCESSED", true
+		}
+		if pointee == any(o.Filetimes.MftFileNameChanged) {
+			return "MFT_FILE_NAME_CHANGED", true
+		}
+		if pointee == any(&o.Filetimes.MftFileNameChanged) {
+			return "MFT_FILE_NAME_CHANGED", true
+		}
+		if pointee == any(o.Filetimes.MftFileNameCreated) {
+			return "MFT_FILE_NAME_CREATED", true
+		}
+		if pointee == any(&o.Filetimes.MftFileNameCreated) {
+			return "MFT_FILE_NAME_CREATED", true
+		}
+	}
+	if pointee == any(&o.Size) {
+		return "SIZE", true
+	}
+	if pointee == any(&o.Permissions) {
+		return "", true
+	}
+	if label, ok := jsonlog.RelativeTextLabel(&o.Permissions, pointee); ok {
+		return label, true
+	}
+	if pointee == any(o.PeInfo) {
+		return "", true
+	}
+	if pointee == any(&o.PeInfo) {
+		return "", true
+	}
+	if o.PeInfo != nil {
+		if pointee == any(&o.PeInfo.Company) {
+			return "COMPANY", true
+		}
+		if pointee == any(&o.PeInfo.FileDescription) {
+			return "DESCRIPTION", true
+		}
+		if pointee == any(&o.PeInfo.LegalCopyright) {
+			return "LEGAL_COPYRIGHT", true
+		}
+		if pointee == any(&o.PeInfo.Product) {
+			return "PRODUCT", true
+		}
+		if pointee == any(&o.PeInfo.OriginalName) {
+			return "ORIGINAL_NAME", true
+		}
+		if pointee == any(&o.PeInfo.InternalName) {
+			return "INTERNAL_NAME", true
+		}
+		if pointee == any(&o.PeInfo.Signed) {
+			return "SIGNED", true
+		}
+		if pointee == any(&o.PeInfo.Signatures) {
+			return "", true
+		}
+		if pointee == any(&o.PeInfo.Imphash) {
+			return "IMPHASH", true
+		}
+		if pointee == any(&o.PeInfo.RichHeaderHash) {
+			return "", true
+		}
+		if pointee == any(&o.PeInfo.CreationTimestamp) {
+			return "", true
+		}
+	}
+	if pointee == any(&o.Target) {
+		return "TARGET", true
+	}
+	if pointee == any(&o.UnpackSource) {
+		return "UNPACK_SOURCE", true
+	}
+	if label, ok := o.UnpackSource.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "UNPACK_SOURCE"), true
+	}
+	if pointee == any(o.LinkInfo) {
+		return "", true
+	}
+	if pointee == any(&o.LinkInfo) {
+		return "", true
+	}
+	if o.LinkInfo != nil {
+		if pointee == any(&o.LinkInfo.Target) {
+			return "TARGET", true
+		}
+		if pointee == any(&o.LinkInfo.Arguments) {
+			return "ARGUMENTS", true
+		}
+		if pointee == any(&o.LinkInfo.CommandLine) {
+			return "COMMAND_LINE", true
+		}
+		if pointee == any(&o.LinkInfo.CreationTime) {
+			return "-", true
+		}
+		if pointee == any(&o.LinkInfo.WriteTime) {
+			return "-", true
+		}
+		if pointee == any(&o.LinkInfo.AccessTime) {
+			return "-", true
+		}
+	}
+	if pointee == any(o.RecycleBinInfo) {
+		return "", true
+	}
+	if pointee == any(&o.RecycleBinInfo) {
+		return "", true
+	}
+	if o.RecycleBinInfo != nil {
+		if pointee == any(&o.RecycleBinInfo.Version) {
+			return "-", true
+		}
+		if pointee == any(&o.RecycleBinInfo.OriginalFilename) {
+			return "ORIGINAL_FILENAME", true
+		}
+		if pointee == any(&o.RecycleBinInfo.DeletionTime) {
+			return "DELETION_TIME", true
+		}
+		if pointee == any(&o.RecycleBinInfo.OriginalFilesize) {
+			return "-", true
+		}
+	}
+	if pointee == any(o.WERInfo) {
+		return "", true
+	}
+	if pointee == any(&o.WERInfo) {
+		return "", true
+	}
+	if o.WERInfo != nil {
+		if pointee == any(&o.WERInfo.ReportType) {
+			return "REPORTTYPE", true
+		}
+		if pointee == any(&o.WERInfo.EventName) {
+			return "EVENTNAME", true
+		}
+		if pointee == any(&o.WERInfo.EventType) {
+			return "EVENTTYPE", true
+		}
+		if pointee == any(&o.WERInfo.Date) {
+			return "DATE", true
+		}
+		if pointee == any(&o.WERInfo.AppPath) {
+			return "APPPATH", true
+		}
+		if pointee == any(&o.WERInfo.AppName) {
+			return "APPNAME", true
+		}
+		if pointee == any(&o.WERInfo.Exe) {
+			return "EXE", true
+		}
+		if pointee == any(&o.WERInfo.Error) {
+			return "ERROR", true
+		}
+		if pointee == any(&o.WERInfo.FaultModule) {
+			return "FAULT_IN_MODULE", true
+		}
+	}
+	if pointee == any(o.Content) {
+		return "CONTENT", true
+	}
+	if pointee == any(&o.Content) {
+		return "CONTENT", true
+	}
+	if label, ok := o.Content.relativeTextPointer(pointee); ok {
+		return jsonlog.ConcatTextLabels("CONTENT", label), true
+	}
+	if pointee == any(o.BeaconConfig) {
+		return "BEACON", true
+	}
+	if pointee == any(&o.BeaconConfig) {
+		return "BEACON", true
+	}
+	if o.BeaconConfig != nil {
+		if pointee == any(&o.BeaconConfig.Type) {
+			return "BEACON_TYPE", true
+		}
+		if pointee == any(&o.BeaconConfig.C2) {
+			return "BEACON_C2", true
+		}
+		if pointee == any(&o.BeaconConfig.Port) {
+			return "BEACON_PORT", true
+		}
+		if pointee == any(&o.BeaconConfig.SpawnTo) {
+			return "BEACON_SPAWNTO", true
+		}
+		if pointee == any(&o.BeaconConfig.InjectionProcess) {
+			return "BEACON_INJECTION_PROCESS", true
+		}
+		if pointee == any(&o.BeaconConfig.Pipename) {
+			return "BEACON_PIPENAME", true
+		}
+		if pointee == any(&o.BeaconConfig.UserAgent) {
+			return "BEACON_USER_AGENT", true
+		}
+		if pointee == any(&o.BeaconConfig.Proxy) {
+			return "BEACON_PROXY", true
+		}
+		if pointee == any(&o.BeaconConfig.FullConfig) {
+			return "BEACON_-", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters) {
+			return "BEACON_CIPHER_PARAMETERS", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XafEncoded) {
+			return "BEACON_CIPHER_PARAMETERS_XAF_ENCODED", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XafEncodingAnchor) {
+			return "BEACON_CIPHER_PARAMETERS_XAF_ENCODING_ANCHOR", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XorKey) {
+			return "BEACON_CIPHER_PARAMETERS_XOR_KEY", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BeaconOffset) {
+			return "BEACON_CIPHER_PARAMETERS_BEACON_OFFSET", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BeaconLength) {
+			return "BEACON_CIPHER_PARAMETERS_BEACON_LENGTH", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BlockStart) {
+			return "BEACON_CIPHER_PARAMETERS_BLOCK_START", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.PairwiseSwapped) {
+			return "BEACON_CIPHER_PARAMETERS_PAIRWISE_SWAPPED", true
+		}
+	}
+	if pointee == any(o.VirusTotalInfo) {
+		return "VIRUSTOTAL", true
+	}
+	if pointee == any(&o.VirusTotalInfo) {
+		return "VIRUSTOTAL", true
+	}
+	if o.VirusTotalInfo != nil {
+		if pointee == any(&o.VirusTotalInfo.LookupResult) {
+			return "VIRUSTOTAL_RESULT", true
+		}
+		if pointee == any(&o.VirusTotalInfo.PositiveVerdicts) {
+			return "VIRUSTOTAL_VERDICTS", true
+		}
+		if pointee == any(&o.VirusTotalInfo.TotalVerdicts) {
+			return "VIRUSTOTAL", true
+		}
+		if pointee == any(o.VirusTotalInfo.History) {
+			return "VIRUSTOTAL", true
+		}
+		if pointee == any(&o.VirusTotalInfo.History) {
+			return "VIRUSTOTAL", true
+		}
+		if o.VirusTotalInfo.History != nil {
+			if pointee == any(&o.VirusTotalInfo.History.Names) {
+				return "VIRUSTOTAL_NAMES", true
+			}
+			if label, ok := o.VirusTotalInfo.History.Names.RelativeTextPointer(pointee); ok {
+				return jsonlog.ConcatTextLabels("VIRUSTOTAL", textLabelOr(label, "NAMES")), true
+			}
+			if pointee == any(&o.VirusTotalInfo.History.Tags) {
+				return "VIRUSTOTAL_TAGS", true
+			}
+			if label, ok := o.VirusTotalInfo.History.Tags.RelativeTextPointer(pointee); ok {
+				return jsonlog.ConcatTextLabels("VIRUSTOTAL", textLabelOr(label, "TAGS")), true
+			}
+			if pointee == any(&o.VirusTotalInfo.History.Submissions) {
+				return "VIRUSTOTAL_SUBMISSIONS", true
+			}
+			if pointee == any(o.VirusTotalInfo.History.FirstSubmission) {
+				return "VIRUSTOTAL_FIRST_SUBMISSION", true
+			}
+			if pointee == any(&o.VirusTotalInfo.History.FirstSubmission) {
+				return "VIRUSTOTAL_FIRST_SUBMISSION", true
+			}
+			if pointee == any(o.VirusTotalInfo.History.LastSubmission) {
+				return "VIRUSTOTAL_LAST_SUBMISSION", true
+			}
+			if pointee == any(&o.VirusTotalInfo.History.LastSubmission) {
+				return "VIRUSTOTAL_LAST_SUBMISSION", true
+			}
+		}
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *FirewallRule) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"path":`...)
+	buf = appendJSONString(buf, o.Path)
+	buf = append(buf, `,"local_ports":`...)
+	if o.LocalPorts == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i1 := range o.LocalPorts {
+			if i1 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.LocalPorts[i1])
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"remote_ports":`...)
+	if o.RemotePorts == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i2 := range o.RemotePorts {
+			if i2 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.RemotePorts[i2])
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"local_ips":`...)
+	if o.LocalAddresses == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i3 := range o.LocalAddresses {
+			if i3 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.LocalAddresses[i3])
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"remote_ips":`...)
+	if o.RemoteAddresses == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i4 := range o.RemoteAddresses {
+			if i4 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.RemoteAddresses[i4])
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"name":`...)
+	buf = appendJSONString(buf, o.Name)
+	buf = append(buf, `,"allow":`...)
+	buf = strconv.AppendBool(buf, o.Allow)
+	buf = append(buf, `,"enabled":`...)
+	buf = strconv.AppendBool(buf, o.Enabled)
+	buf = append(buf, `,"inbound":`...)
+	buf = strconv.AppendBool(buf, o.Inbound)
+	buf = append(buf, `,"protocol":`...)
+	buf = appendJSONString(buf, o.Protocol)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *FirewallRule) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Path) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PATH", Value: t.FormatField(o.Path, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LocalPorts) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LPORT", Value: t.FormatField(o.LocalPorts, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.RemotePorts) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "RPORT", Value: t.FormatField(o.RemotePorts, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LocalAddresses) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LIP", Value: t.FormatField(o.LocalAddresses, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.RemoteAddresses) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "RIP", Value: t.FormatField(o.RemoteAddresses, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Name) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NAME", Value: t.FormatField(o.Name, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Allow) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ALLOW", Value: t.FormatField(o.Allow, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Enabled) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ENABLED", Value: t.FormatField(o.Enabled, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Inbound) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "INBOUND", Value: t.FormatField(o.Inbound, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Protocol) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PROTOCOL", Value: t.FormatField(o.Protocol, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *FirewallRule) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Path) {
+		return jsonpointer.Pointer{"path"}
+	}
+	if pointee == any(&o.LocalPorts) {
+		return jsonpointer.Pointer{"local_ports"}
+	}
+	if p := o.LocalPorts.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"local_ports"}, p...)
+	}
+	if pointee == any(&o.RemotePorts) {
+		return jsonpointer.Pointer{"remote_ports"}
+	}
+	if p := o.RemotePorts.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"remote_ports"}, p...)
+	}
+	if pointee == any(&o.LocalAddresses) {
+		return jsonpointer.Pointer{"local_ips"}
+	}
+	if p := o.LocalAddresses.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"local_ips"}, p...)
+	}
+	if pointee == any(&o.RemoteAddresses) {
+		return jsonpointer.Pointer{"remote_ips"}
+	}
+	if p := o.RemoteAddresses.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"remote_ips"}, p...)
+	}
+	if pointee == any(&o.Name) {
+		return jsonpointer.Pointer{"name"}
+	}
+	if pointee == any(&o.Allow) {
+		return jsonpointer.Pointer{"allow"}
+	}
+	if pointee == any(&o.Enabled) {
+		return jsonpointer.Pointer{"enabled"}
+	}
+	if pointee == any(&o.Inbound) {
+		return jsonpointer.Pointer{"inbound"}
+	}
+	if pointee == any(&o.Protocol) {
+		return jsonpointer.Pointer{"protocol"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *FirewallRule) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Path) {
+		return "PATH", true
+	}
+	if pointee == any(&o.LocalPorts) {
+		return "LPORT", true
+	}
+	if label, ok := o.LocalPorts.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "LPORT"), true
+	}
+	if pointee == any(&o.RemotePorts) {
+		return "RPORT", true
+	}
+	if label, ok := o.RemotePorts.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "RPORT"), true
+	}
+	if pointee == any(&o.LocalAddresses) {
+		return "LIP", true
+	}
+	if label, ok := o.LocalAddresses.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "LIP"), true
+	}
+	if pointee == any(&o.RemoteAddresses) {
+		return "RIP", true
+	}
+	if label, ok := o.RemoteAddresses.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "RIP"), true
+	}
+	if pointee == any(&o.Name) {
+		return "NAME", true
+	}
+	if pointee == any(&o.Allow) {
+		return "ALLOW", true
+	}
+	if pointee == any(&o.Enabled) {
+		return "ENABLED", true
+	}
+	if pointee == any(&o.Inbound) {
+		return "INBOUND", true
+	}
+	if pointee == any(&o.Protocol) {
+		return "PROTOCOL", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *GroupsXmlUser) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"user":`...)
+	buf = appendJSONString(buf, o.User)
+	buf = append(buf, `,"password":`...)
+	buf = appendJSONString(buf, o.Password)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *GroupsXmlUser) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.User) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "USER", Value: t.FormatField(o.User, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Password) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PASSWORD", Value: t.FormatField(o.Password, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *GroupsXmlUser) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.User) {
+		return jsonpointer.Pointer{"user"}
+	}
+	if pointee == any(&o.Password) {
+		return jsonpointer.Pointer{"password"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *GroupsXmlUser) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.User) {
+		return "USER", true
+	}
+	if pointee == any(&o.Password) {
+		return "PASSWORD", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *HostInfo) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"hostname":`...)
+	buf = appendJSONString(buf, o.Hostname)
+	buf = append(buf, `,"domain":`...)
+	buf = appendJSONString(buf, o.Domain)
+	buf = append(buf, `,"platform":`...)
+	if o.Platform == nil {
+		buf = append(buf, "null"...)
+	} else {
+		if buf, err = jsonlog.AppendJSON(buf, o.Platform); err != nil {
+			return nil, err
+		}
+	}
+	buf = append(buf, `,"uptime":`...)
+	buf = strconv.AppendInt(buf, int64(o.Uptime), 10)
+	buf = append(buf, `,"cpu_count":`...)
+	buf = strconv.AppendInt(buf, int64(o.Cpus), 10)
+	buf = append(buf, `,"memory":`...)
+	buf = strconv.AppendUint(buf, uint64(o.Memory), 10)
+	buf = append(buf, `,"timezone":`...)
+	buf = appendJSONString(buf, o.Timezone)
+	buf = append(buf, `,"language":`...)
+	buf = appendJSONString(buf, o.Language)
+	buf = append(buf, `,"interfaces":`...)
+	if o.Interfaces == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i1 := range o.Interfaces {
+			if i1 > 0 {
+				buf = append(buf, ',')
+			}
+			start2 := len(buf)
+			buf = append(buf, `,"name":`...)
+			buf = appendJSONString(buf, o.Interfaces[i1].Name)
+			buf = append(buf, `,"ip_address":`...)
+			buf = appendJSONString(buf, o.Interfaces[i1].IpAddress)
+			if len(o.Interfaces[i1].Ipv6Address) != 0 {
+				buf = append(buf, `,"ipv6_address":`...)
+				buf = appendJSONString(buf, o.Interfaces[i1].Ipv6Address)
+			}
+			if len(o.Interfaces[i1].MacAddress) != 0 {
+				buf = append(buf, `,"mac_address":`...)
+				buf = appendJSONString(buf, o.Interfaces[i1].MacAddress)
+			}
+			buf = closeJSONObject(buf, start2)
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"system_type":`...)
+	buf = appendJSONString(buf, string(o.SystemType))
+	buf = append(buf, `,"mount_points":`...)
+	if o.MountPoints == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i3 := range o.MountPoints {
+			if i3 > 0 {
+				buf = append(buf, ',')
+			}
+			start4 := len(buf)
+			buf = append(buf, `,"fs_type":`...)
+			buf = appendJSONString(buf, o.MountPoints[i3].FSType)
+			buf = append(buf, `,"source":`...)
+			buf = appendJSONString(buf, o.MountPoints[i3].Source)
+			buf = append(buf, `,"target":`...)
+			buf = appendJSONString(buf, o.MountPoints[i3].Target)
+			buf = append(buf, `,"class":`...)
+			buf = appendJSONString(buf, o.MountPoints[i3].Class)
+			buf = closeJSONObject(buf, start4)
+		}
+		buf = append(buf, ']')
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *HostInfo) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Hostname) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "HOSTNAME", Value: t.FormatField(o.Hostname, textlogModifiersNone)})
+	}
+	if !(o.Domain == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Domain)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "DOMAIN", Value: t.FormatField(o.Domain, textlogModifiersOmitempty)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.Platform) {
+		for _, pair := range t.FormatEntry(o.Platform) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: pair.Key, Value: pair.Value})
+		}
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Uptime) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "UPTIME", Value: t.FormatField(o.Uptime, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Cpus) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "CPU_COUNT", Value: t.FormatField(o.Cpus, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Memory) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "MEMORY", Value: t.FormatField(o.Memory, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Timezone) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TIMEZONE", Value: t.FormatField(o.Timezone, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Language) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LANGUAGE", Value: t.FormatField(o.Language, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.Interfaces) {
+		for i0 := range o.Interfaces {
+			index1 := strconv.Itoa(i0 + 1)
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Interfaces[i0].IpAddress) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("IP_ADDRESS", index1), Value: t.FormatField(o.Interfaces[i0].IpAddress, textlogModifiersNone)})
+			}
+		}
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.SystemType) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SYSTEM_TYPE", Value: t.FormatField(o.SystemType, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *HostInfo) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Hostname) {
+		return jsonpointer.Pointer{"hostname"}
+	}
+	if pointee == any(&o.Domain) {
+		return jsonpointer.Pointer{"domain"}
+	}
+	if pointee == any(&o.Platform) {
+		return jsonpointer.Pointer{"platform"}
+	}
+	if p := jsonlog.RelativeJsonPointer(o.Platform, pointee); p != nil {
+		return append(jsonpointer.Pointer{"platform"}, p...)
+	}
+	if pointee == any(&o.Uptime) {
+		return jsonpointer.Pointer{"uptime"}
+	}
+	if pointee == any(&o.Cpus) {
+		return jsonpointer.Pointer{"cpu_count"}
+	}
+	if pointee == any(&o.Memory) {
+		return jsonpointer.Pointer{"memory"}
+	}
+	if pointee == any(&o.Timezone) {
+		return jsonpointer.Pointer{"timezone"}
+	}
+	if pointee == any(&o.Language) {
+		return jsonpointer.Pointer{"language"}
+	}
+	if pointee == any(&o.Interfaces) {
+		return jsonpointer.Pointer{"interfaces"}
+	}
+	for i0 := range o.Interfaces {
+		if pointee == any(&o.Interfaces[i0]) {
+			return jsonpointer.Pointer{"interfaces", strconv.Itoa(i0)}
+		}
+		if pointee == any(&o.Interfaces[i0].Name) {
+			return jsonpointer.Pointer{"interfaces", strconv.Itoa(i0), "name"}
+		}
+		if pointee == any(&o.Interfaces[i0].IpAddress) {
+			return jsonpointer.Pointer{"interfaces", strconv.Itoa(i0), "ip_address"}
+		}
+		if pointee == any(&o.Interfaces[i0].Ipv6Address) {
+			return jsonpointer.Pointer{"interfaces", strconv.Itoa(i0), "ipv6_address"}
+		}
+		if pointee == any(&o.Interfaces[i0].MacAddress) {
+			return jsonpointer.Pointer{"interfaces", strconv.Itoa(i0), "mac_address"}
+		}
+	}
+	if pointee == any(&o.SystemType) {
+		return jsonpointer.Pointer{"system_type"}
+	}
+	if pointee == any(&o.MountPoints) {
+		return jsonpointer.Pointer{"mount_points"}
+	}
+	for i1 := range o.MountPoints {
+		if pointee == any(&o.MountPoints[i1]) {
+			return jsonpointer.Pointer{"mount_points", strconv.Itoa(i1)}
+		}
+		if pointee == any(&o.MountPoints[i1].FSType) {
+			return jsonpointer.Pointer{"mount_points", strconv.Itoa(i1), "fs_type"}
+		}
+		if pointee == any(&o.MountPoints[i1].Source) {
+			return jsonpointer.Pointer{"mount_points", strconv.Itoa(i1), "source"}
+		}
+		if pointee == any(&o.MountPoints[i1].Target) {
+			return jsonpointer.Pointer{"mount_points", strconv.Itoa(i1), "target"}
+		}
+		if pointee == any(&o.MountPoints[i1].Class) {
+			return jsonpointer.Pointer{"mount_points", strconv.Itoa(i1), "class"}
+		}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *HostInfo) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Hostname) {
+		return "HOSTNAME", true
+	}
+	if pointee == any(&o.Domain) {
+		return "DOMAIN", true
+	}
+	if pointee == any(&o.Platform) {
+		return "", true
+	}
+	if label, ok := jsonlog.RelativeTextLabel(&o.Platform, pointee); ok {
+		return label, true
+	}
+	if pointee == any(&o.Uptime) {
+		return "UPTIME", true
+	}
+	if pointee == any(&o.Cpus) {
+		return "CPU_COUNT", true
+	}
+	if pointee == any(&o.Memory) {
+		return "MEMORY", true
+	}
+	if pointee == any(&o.Timezone) {
+		return "TIMEZONE", true
+	}
+	if pointee == any(&o.Language) {
+		return "LANGUAGE", true
+	}
+	if pointee == any(&o.Interfaces) {
+		return "", true
+	}
+	if pointee == any(&o.SystemType) {
+		return "SYSTEM_TYPE", true
+	}
+	if pointee == any(&o.MountPoints) {
+		return "", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *HostsFileEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"host":`...)
+	buf = appendJSONString(buf, o.Host)
+	buf = append(buf, `,"ip":`...)
+	buf = appendJSONString(buf, o.IP)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *HostsFileEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Host) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "HOST", Value: t.FormatField(o.Host, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.IP) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "IP", Value: t.FormatField(o.IP, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *HostsFileEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Host) {
+		return jsonpointer.Pointer{"host"}
+	}
+	if pointee == any(&o.IP) {
+		return jsonpointer.Pointer{"ip"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *HostsFileEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Host) {
+		return "HOST", true
+	}
+	if pointee == any(&o.IP) {
+		return "IP", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *HotfixSummary) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"last_hotfix":`...)
+	if buf, err = appendJSONTime(buf, o.LastHotfix); err != nil {
+		return nil, err
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *HotfixSummary) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LastHotfix) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LAST_HOTFIX", Value: t.FormatField(o.LastHotfix, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *HotfixSummary) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.LastHotfix) {
+		return jsonpointer.Pointer{"last_hotfix"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *HotfixSummary) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.LastHotfix) {
+		return "LAST_HOTFIX", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *InitdService) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"file":`...)
+	if buf, err = o.File.appendJSON(buf); err != nil {
+		return nil, err
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *InitdService) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.File) {
+		for _, pair := range o.File.marshalTextLog(t) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("FILE", pair.Key), Value: pair.Value})
+		}
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *InitdService) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.File) {
+		return jsonpointer.Pointer{"file"}
+	}
+	if pointee == any(o.File) {
+		return jsonpointer.Pointer{"file"}
+	}
+	if p := o.File.relativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"file"}, p...)
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *InitdService) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(o.File) {
+		return "FILE", true
+	}
+	if pointee == any(&o.File) {
+		return "FILE", true
+	}
+	if label, ok := o.File.relativeTextPointer(pointee); ok {
+		return jsonlog.ConcatTextLabels("FILE", label), true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *JournaldEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"time":`...)
+	if buf, err = appendJSONTime(buf, o.Time); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"details":`...)
+	if buf, err = appendJSONValue(buf, &o.Details); err != nil {
+		return nil, err
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *JournaldEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Time) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TIME", Value: t.FormatField(o.Time, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Details) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ENTRY", Value: t.FormatField(o.Details, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *JournaldEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Time) {
+		return jsonpointer.Pointer{"time"}
+	}
+	if pointee == any(&o.Details) {
+		return jsonpointer.Pointer{"details"}
+	}
+	if p := o.Details.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"details"}, p...)
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *JournaldEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Time) {
+		return "TIME", true
+	}
+	if pointee == any(&o.Details) {
+		return "ENTRY", true
+	}
+	if label, ok := o.Details.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "ENTRY"), true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *JumplistEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"path":`...)
+	buf = appendJSONString(buf, o.Path)
+	buf = append(buf, `,"pinned":`...)
+	buf = strconv.AppendBool(buf, o.Pinned)
+	buf = append(buf, `,"last_access":`...)
+	if buf, err = appendJSONTime(buf, o.LastAccess); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"access_count":`...)
+	buf = strconv.AppendInt(buf, int64(o.AccessCount), 10)
+	buf = append(buf, `,"netbios_name":`...)
+	buf = appendJSONString(buf, o.NetbiosName)
+	buf = append(buf, `,"object_id":`...)
+	if buf, err = appendJSONValue(buf, &o.ObjectID); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"volume_id":`...)
+	if buf, err = appendJSONValue(buf, &o.VolumeID); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"birth_volume_id":`...)
+	if buf, err = appendJSONValue(buf, &o.BirthVolumeID); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"entry_id":`...)
+	buf = strconv.AppendUint(buf, o.EntryID, 10)
+	buf = append(buf, `,"checksum":`...)
+	buf = strconv.AppendUint(buf, o.Checksum, 10)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *JumplistEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Path) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PATH", Value: t.FormatField(o.Path, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Pinned) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PINNED", Value: t.FormatField(o.Pinned, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LastAccess) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LAST_ACCESS", Value: t.FormatField(o.LastAccess, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.AccessCount) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ACCESS_COUNT", Value: t.FormatField(o.AccessCount, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.NetbiosName) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NETBIOS_NAME", Value: t.FormatField(o.NetbiosName, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ObjectID) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "OBJECT_ID", Value: t.FormatField(o.ObjectID, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.VolumeID) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "VOLUME_ID", Value: t.FormatField(o.VolumeID, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BirthVolumeID) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "BIRTH_VOLUME_ID", Value: t.FormatField(o.BirthVolumeID, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.EntryID) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ENTRY_ID", Value: t.FormatField(o.EntryID, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Checksum) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "CHECKSUM", Value: t.FormatField(o.Checksum, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *JumplistEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Path) {
+		return jsonpointer.Pointer{"path"}
+	}
+	if pointee == any(&o.Pinned) {
+		return jsonpointer.Pointer{"pinned"}
+	}
+	if pointee == any(&o.LastAccess) {
+		return jsonpointer.Pointer{"last_access"}
+	}
+	if pointee == any(&o.AccessCount) {
+		return jsonpointer.Pointer{"access_count"}
+	}
+	if pointee == any(&o.NetbiosName) {
+		return jsonpointer.Pointer{"netbios_name"}
+	}
+	if pointee == any(&o.ObjectID) {
+		return jsonpointer.Pointer{"object_id"}
+	}
+	for i0 := range o.ObjectID {
+		if pointee == any(&o.ObjectID[i0]) {
+			return jsonpointer.Pointer{"object_id", strconv.Itoa(i0)}
+		}
+	}
+	if pointee == any(&o.VolumeID) {
+		return jsonpointer.Pointer{"volume_id"}
+	}
+	for i1 := range o.VolumeID {
+		if pointee == any(&o.VolumeID[i1]) {
+			return jsonpointer.Pointer{"volume_id", strconv.Itoa(i1)}
+		}
+	}
+	if pointee == any(&o.BirthVolumeID) {
+		return jsonpointer.Pointer{"birth_volume_id"}
+	}
+	for i2 := range o.BirthVolumeID {
+		if pointee == any(&o.BirthVolumeID[i2]) {
+			return jsonpointer.Pointer{"birth_volume_id", strconv.Itoa(i2)}
+		}
+	}
+	if pointee == any(&o.EntryID) {
+		return jsonpointer.Pointer{"entry_id"}
+	}
+	if pointee == any(&o.Checksum) {
+		return jsonpointer.Pointer{"checksum"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *JumplistEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Path) {
+		return "PATH", true
+	}
+	if pointee == any(&o.Pinned) {
+		return "PINNED", true
+	}
+	if pointee == any(&o.LastAccess) {
+		return "LAST_ACCESS", true
+	}
+	if pointee == any(&o.AccessCount) {
+		return "ACCESS_COUNT", true
+	}
+	if pointee == any(&o.NetbiosName) {
+		return "NETBIOS_NAME", true
+	}
+	if pointee == any(&o.ObjectID) {
+		return "OBJECT_ID", true
+	}
+	if pointee == any(&o.VolumeID) {
+		return "VOLUME_ID", true
+	}
+	if pointee == any(&o.BirthVolumeID) {
+		return "BIRTH_VOLUME_ID", true
+	}
+	if pointee == any(&o.EntryID) {
+		return "ENTRY_ID", true
+	}
+	if pointee == any(&o.Checksum) {
+		return "CHECKSUM", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *KnowledgeDBEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"entry":`...)
+	buf = appendJSONString(buf, o.Entry)
+	buf = append(buf, `,"created":`...)
+	if buf, err = appendJSONTime(buf, o.Created); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"started":`...)
+	if buf, err = appendJSONTime(buf, o.Started); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"duration":`...)
+	buf = strconv.AppendInt(buf, int64(o.Duration), 10)
+	buf = append(buf, `,"primary_key":`...)
+	buf = strconv.AppendInt(buf, o.PrimaryKey, 10)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *KnowledgeDBEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Entry) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ENTRY", Value: t.FormatField(o.Entry, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Created) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "CREATED", Value: t.FormatField(o.Created, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Started) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "STARTED", Value: t.FormatField(o.Started, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Duration) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "DURATION", Value: t.FormatField(o.Duration, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.PrimaryKey) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PRIMARY_KEY", Value: t.FormatField(o.PrimaryKey, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *KnowledgeDBEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Entry) {
+		return jsonpointer.Pointer{"entry"}
+	}
+	if pointee == any(&o.Created) {
+		return jsonpointer.Pointer{"created"}
+	}
+	if pointee == any(&o.Started) {
+		return jsonpointer.Pointer{"started"}
+	}
+	if pointee == any(&o.Duration) {
+		return jsonpointer.Pointer{"duration"}
+	}
+	if pointee == any(&o.PrimaryKey) {
+		return jsonpointer.Pointer{"primary_key"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *KnowledgeDBEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Entry) {
+		return "ENTRY", true
+	}
+	if pointee == any(&o.Created) {
+		return "CREATED", true
+	}
+	if pointee == any(&o.Started) {
+		return "STARTED", true
+	}
+	if pointee == any(&o.Duration) {
+		return "DURATION", true
+	}
+	if pointee == any(&o.PrimaryKey) {
+		return "PRIMARY_KEY", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *LinuxKernelModule) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"name":`...)
+	buf = appendJSONString(buf, o.Name)
+	if o.Size != 0 {
+		buf = append(buf, `,"size":`...)
+		buf = strconv.AppendInt(buf, int64(o.Size), 10)
+	}
+	buf = append(buf, `,"included_in_kernel":`...)
+	buf = strconv.AppendBool(buf, o.IncludedInKernel)
+	buf = append(buf, `,"ref_count":`...)
+	buf = strconv.AppendInt(buf, int64(o.Refcount), 10)
+	buf = append(buf, `,"used_by":`...)
+	if o.UsedBy == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i1 := range o.UsedBy {
+			if i1 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.UsedBy[i1])
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"version":`...)
+	buf = appendJSONString(buf, o.Version)
+	if len(o.Parameters) != 0 {
+		buf = append(buf, `,"parameters":`...)
+		if buf, err = appendJSONValue(buf, &o.Parameters); err != nil {
+			return nil, err
+		}
+	}
+	if len(o.LoadState) != 0 {
+		buf = append(buf, `,"load_state":`...)
+		buf = appendJSONString(buf, o.LoadState)
+	}
+	buf = append(buf, `,"file":`...)
+	if buf, err = o.File.appendJSON(buf); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"description":`...)
+	if o.Description == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i2 := range o.Description {
+			if i2 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.Description[i2])
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"author":`...)
+	buf = appendJSONString(buf, o.Author)
+	buf = append(buf, `,"in_proc_modules":`...)
+	buf = strconv.AppendBool(buf, o.InProcModules)
+	buf = append(buf, `,"in_sys_module":`...)
+	buf = strconv.AppendBool(buf, o.InSysModule)
+	buf = append(buf, `,"in_vmallocinfo":`...)
+	buf = strconv.AppendBool(buf, o.InVmallocinfo)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *LinuxKernelModule) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Name) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NAME", Value: t.FormatField(o.Name, textlogModifiersNone)})
+	}
+	if !(o.Size == 0) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Size)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SIZE", Value: t.FormatField(o.Size, textlogModifiersOmitempty)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.IncludedInKernel) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "INCLUDED_IN_KERNEL", Value: t.FormatField(o.IncludedInKernel, textlogModifiersNone)})
+	}
+	if !(o.Parameters == nil) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Parameters)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PARAMETERS", Value: t.FormatField(o.Parameters, textlogModifiersOmitempty)})
+	}
+	if !(o.File == nil) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.File)) {
+		for _, pair := range o.File.marshalTextLog(t) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("FILE", pair.Key), Value: pair.Value})
+		}
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Description) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "DESCRIPTION", Value: t.FormatField(o.Description, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Author) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "AUTHOR", Value: t.FormatField(o.Author, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.InProcModules) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "IN_PROC_MODULES", Value: t.FormatField(o.InProcModules, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.InSysModule) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "IN_SYS_MODULE", Value: t.FormatField(o.InSysModule, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.InVmallocinfo) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "IN_VMALLOCINFO", Value: t.FormatField(o.InVmallocinfo, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *LinuxKernelModule) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Name) {
+		return jsonpointer.Pointer{"name"}
+	}
+	if pointee == any(&o.Size) {
+		return jsonpointer.Pointer{"size"}
+	}
+	if pointee == any(&o.IncludedInKernel) {
+		return jsonpointer.Pointer{"included_in_kernel"}
+	}
+	if pointee == any(&o.Refcount) {
+		return jsonpointer.Pointer{"ref_count"}
+	}
+	if pointee == any(&o.UsedBy) {
+		return jsonpointer.Pointer{"used_by"}
+	}
+	if p := o.UsedBy.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"used_by"}, p...)
+	}
+	if pointee == any(&o.Version) {
+		return jsonpointer.Pointer{"version"}
+	}
+	if pointee == any(&o.Parameters) {
+		return jsonpointer.Pointer{"parameters"}
+	}
+	if p := o.Parameters.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"parameters"}, p...)
+	}
+	if pointee == any(&o.LoadState) {
+		return jsonpointer.Pointer{"load_state"}
+	}
+	if pointee == any(&o.File) {
+		return jsonpointer.Pointer{"file"}
+	}
+	if pointee == any(o.File) {
+		return jsonpointer.Pointer{"file"}
+	}
+	if p := o.File.relativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"file"}, p...)
+	}
+	if pointee == any(&o.Description) {
+		return jsonpointer.Pointer{"description"}
+	}
+	if p := o.Description.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"description"}, p...)
+	}
+	if pointee == any(&o.Author) {
+		return jsonpointer.Pointer{"author"}
+	}
+	if pointee == any(&o.InProcModules) {
+		return jsonpointer.Pointer{"in_proc_modules"}
+	}
+	if pointee == any(&o.InSysModule) {
+		return jsonpointer.Pointer{"in_sys_module"}
+	}
+	if pointee == any(&o.InVmallocinfo) {
+		return jsonpointer.Pointer{"in_vmallocinfo"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *LinuxKernelModule) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Name) {
+		return "NAME", true
+	}
+	if pointee == any(&o.Size) {
+		return "SIZE", true
+	}
+	if pointee == any(&o.IncludedInKernel) {
+		return "INCLUDED_IN_KERNEL", true
+	}
+	if pointee == any(&o.Refcount) {
+		return "", true
+	}
+	if pointee == any(&o.UsedBy) {
+		return "", true
+	}
+	if label, ok := o.UsedBy.RelativeTextPointer(pointee); ok {
+		return label, true
+	}
+	if pointee == any(&o.Version) {
+		return "", true
+	}
+	if pointee == any(&o.Parameters) {
+		return "PARAMETERS", true
+	}
+	if label, ok := o.Parameters.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "PARAMETERS"), true
+	}
+	if pointee == any(&o.LoadState) {
+		return "", true
+	}
+	if pointee == any(o.File) {
+		return "FILE", true
+	}
+	if pointee == any(&o.File) {
+		return "FILE", true
+	}
+	if label, ok := o.File.relativeTextPointer(pointee); ok {
+		return jsonlog.ConcatTextLabels("FILE", label), true
+	}
+	if pointee == any(&o.Description) {
+		return "DESCRIPTION", true
+	}
+	if label, ok := o.Description.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "DESCRIPTION"), true
+	}
+	if pointee == any(&o.Author) {
+		return "AUTHOR", true
+	}
+	if pointee == any(&o.InProcModules) {
+		return "IN_PROC_MODULES", true
+	}
+	if pointee == any(&o.InSysModule) {
+		return "IN_SYS_MODULE", true
+	}
+	if pointee == any(&o.InVmallocinfo) {
+		return "IN_VMALLOCINFO", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *LogLine) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"line_index":`...)
+	buf = strconv.AppendUint(buf, o.LineIndex, 10)
+	buf = append(buf, `,"line":`...)
+	buf = appendJSONString(buf, o.Line)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *LogLine) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Line) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LINE", Value: t.FormatField(o.Line, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *LogLine) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.LineIndex) {
+		return jsonpointer.Pointer{"line_index"}
+	}
+	if pointee == any(&o.Line) {
+		return jsonpointer.Pointer{"line"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *LogLine) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.LineIndex) {
+		return "-", true
+	}
+	if pointee == any(&o.Line) {
+		return "LINE", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *LoggedInUser) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"user":`...)
+	buf = appendJSONString(buf, o.User)
+	if len(o.Server) != 0 {
+		buf = append(buf, `,"server":`...)
+		buf = appendJSONString(buf, o.Server)
+	}
+	if len(o.Domain) != 0 {
+		buf = append(buf, `,"domain":`...)
+		buf = appendJSONString(buf, o.Domain)
+	}
+	if len(o.OtherDomains) != 0 {
+		buf = append(buf, `,"other_domains":`...)
+		buf = appendJSONString(buf, o.OtherDomains)
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *LoggedInUser) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.User) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "USER", Value: t.FormatField(o.User, textlogModifiersNone)})
+	}
+	if !(o.Server == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Server)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SERVER", Value: t.FormatField(o.Server, textlogModifiersOmitempty)})
+	}
+	if !(o.Domain == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Domain)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "DOMAIN", Value: t.FormatField(o.Domain, textlogModifiersOmitempty)})
+	}
+	if !(o.OtherDomains == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.OtherDomains)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "OTHER_DOMAINS", Value: t.FormatField(o.OtherDomains, textlogModifiersOmitempty)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *LoggedInUser) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.User) {
+		return jsonpointer.Pointer{"user"}
+	}
+	if pointee == any(&o.Server) {
+		return jsonpointer.Pointer{"server"}
+	}
+	if pointee == any(&o.Domain) {
+		return jsonpointer.Pointer{"domain"}
+	}
+	if pointee == any(&o.OtherDomains) {
+		return jsonpointer.Pointer{"other_domains"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *LoggedInUser) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.User) {
+		return "USER", true
+	}
+	if pointee == any(&o.Server) {
+		return "SERVER", true
+	}
+	if pointee == any(&o.Domain) {
+		return "DOMAIN", true
+	}
+	if pointee == any(&o.OtherDomains) {
+		return "OTHER_DOMAINS", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *LsaSession) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"lsa_session":`...)
+	buf = appendJSONString(buf, o.LsaSession)
+	buf = append(buf, `,"user":`...)
+	buf = appendJSONString(buf, o.User)
+	buf = append(buf, `,"auth_package":`...)
+	buf = appendJSONString(buf, o.AuthPackage)
+	buf = append(buf, `,"session_type":`...)
+	buf = appendJSONString(buf, o.Type)
+	buf = append(buf, `,"logon_time":`...)
+	if buf, err = appendJSONTime(buf, o.LogonTime); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"domain":`...)
+	buf = appendJSONString(buf, o.Domain)
+	buf = append(buf, `,"server":`...)
+	buf = appendJSONString(buf, o.Server)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *LsaSession) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LsaSession) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LSA_SESSION", Value: t.FormatField(o.LsaSession, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.User) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "USER", Value: t.FormatField(o.User, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.AuthPackage) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "AUTH_PACKAGE", Value: t.FormatField(o.AuthPackage, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Type) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TYPE", Value: t.FormatField(o.Type, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LogonTime) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LOGON_TIME", Value: t.FormatField(o.LogonTime, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Domain) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "DOMAIN", Value: t.FormatField(o.Domain, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Server) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SERVER", Value: t.FormatField(o.Server, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *LsaSession) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.LsaSession) {
+		return jsonpointer.Pointer{"lsa_session"}
+	}
+	if pointee == any(&o.User) {
+		return jsonpointer.Pointer{"user"}
+	}
+	if pointee == any(&o.AuthPackage) {
+		return jsonpointer.Pointer{"auth_package"}
+	}
+	if pointee == any(&o.Type) {
+		return jsonpointer.Pointer{"session_type"}
+	}
+	if pointee == any(&o.LogonTime) {
+		return jsonpointer.Pointer{"logon_time"}
+	}
+	if pointee == any(&o.Domain) {
+		return jsonpointer.Pointer{"domain"}
+	}
+	if pointee == any(&o.Server) {
+		return jsonpointer.Pointer{"server"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *LsaSession) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.LsaSession) {
+		return "LSA_SESSION", true
+	}
+	if pointee == any(&o.User) {
+		return "USER", true
+	}
+	if pointee == any(&o.AuthPackage) {
+		return "AUTH_PACKAGE", true
+	}
+	if pointee == any(&o.Type) {
+		return "TYPE", true
+	}
+	if pointee == any(&o.LogonTime) {
+		return "LOGON_TIME", true
+	}
+	if pointee == any(&o.Domain) {
+		return "DOMAIN", true
+	}
+	if pointee == any(&o.Server) {
+		return "SERVER", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *Message) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"meta":`...)
+	start1 := len(buf)
+	buf = append(buf, `,"time":`...)
+	if buf, err = appendJSONTime(buf, o.Meta.Time); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"level":`...)
+	buf = appendJSONString(buf, string(o.Meta.Lvl))
+	buf = append(buf, `,"module":`...)
+	buf = appendJSONString(buf, o.Meta.Mod)
+	buf = append(buf, `,"scan_id":`...)
+	buf = appendJSONString(buf, o.Meta.ScanID)
+	if len(o.Meta.GenID) != 0 {
+		buf = append(buf, `,"event_id":`...)
+		buf = appendJSONString(buf, o.Meta.GenID)
+	}
+	buf = append(buf, `,"hostname":`...)
+	buf = appendJSONString(buf, o.Meta.Source)
+	buf = closeJSONObject(buf, start1)
+	buf = append(buf, `,"message":`...)
+	buf = appendJSONString(buf, o.Text)
+	buf = append(buf, `,"fields":`...)
+	if buf, err = appendJSONValue(buf, &o.Fields); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"log_version":`...)
+	buf = appendJSONString(buf, string(o.LogVersion))
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *Message) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.Meta) {
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Meta.Mod) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "MODULE", Value: t.FormatField(o.Meta.Mod, textlogModifiersNone)})
+		}
+		if !(o.Meta.ScanID == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Meta.ScanID)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "SCANID", Value: t.FormatField(o.Meta.ScanID, textlogModifiersOmitempty)})
+		}
+		if !(o.Meta.GenID == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Meta.GenID)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "UID", Value: t.FormatField(o.Meta.GenID, textlogModifiersOmitempty)})
+		}
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Text) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "MESSAGE", Value: t.FormatField(o.Text, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.Fields) {
+		for _, pair := range t.FormatEntry(o.Fields) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: pair.Key, Value: pair.Value})
+		}
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *Message) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Meta) {
+		return jsonpointer.Pointer{"meta"}
+	}
+	if pointee == any(&o.Meta.Time) {
+		return jsonpointer.Pointer{"meta", "time"}
+	}
+	if pointee == any(&o.Meta.Lvl) {
+		return jsonpointer.Pointer{"meta", "level"}
+	}
+	if pointee == any(&o.Meta.Mod) {
+		return jsonpointer.Pointer{"meta", "module"}
+	}
+	if pointee == any(&o.Meta.ScanID) {
+		return jsonpointer.Pointer{"meta", "scan_id"}
+	}
+	if pointee == any(&o.Meta.GenID) {
+		return jsonpointer.Pointer{"meta", "event_id"}
+	}
+	if pointee == any(&o.Meta.Source) {
+		return jsonpointer.Pointer{"meta", "hostname"}
+	}
+	if pointee == any(&o.Text) {
+		return jsonpointer.Pointer{"message"}
+	}
+	if pointee == any(&o.Fields) {
+		return jsonpointer.Pointer{"fields"}
+	}
+	for i0 := range o.Fields {
+		if pointee == any(&o.Fields[i0]) {
+			return jsonpointer.Pointer{"fields", strconv.Itoa(i0)}
+		}
+		if pointee == any(&o.Fields[i0].Key) {
+			return jsonpointer.Pointer{"fields", strconv.Itoa(i0), ""}
+		}
+		if pointee == any(&o.Fields[i0].Value) {
+			return jsonpointer.Pointer{"fields", strconv.Itoa(i0), ""}
+		}
+		if p := jsonlog.RelativeJsonPointer(o.Fields[i0].Value, pointee); p != nil {
+			return append(jsonpointer.Pointer{"fields", strconv.Itoa(i0), ""}, p...)
+		}
+	}
+	if pointee == any(&o.LogVersion) {
+		return jsonpointer.Pointer{"log_version"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *Message) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Meta) {
+		return "", true
+	}
+	if pointee == any(&o.Meta.Time) {
+		return "-", true
+	}
+	if pointee == any(&o.Meta.Lvl) {
+		return "-", true
+	}
+	if pointee == any(&o.Meta.Mod) {
+		return "MODULE", true
+	}
+	if pointee == any(&o.Meta.ScanID) {
+		return "SCANID", true
+	}
+	if pointee == any(&o.Meta.GenID) {
+		return "UID", true
+	}
+	if pointee == any(&o.Meta.Source) {
+		return "-", true
+	}
+	if pointee == any(&o.Text) {
+		return "MESSAGE", true
+	}
+	if pointee == any(&o.Fields) {
+		return "", true
+	}
+	if pointee == any(&o.LogVersion) {
+		return "", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *MftFileEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"path":`...)
+	buf = appendJSONString(buf, o.Path)
+	buf = append(buf, `,"size":`...)
+	buf = strconv.AppendInt(buf, o.Size, 10)
+	buf = append(buf, `,"dir":`...)
+	buf = strconv.AppendBool(buf, o.Dir)
+	buf = append(buf, `,"modified":`...)
+	if buf, err = appendJSONTime(buf, o.Modified); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"created":`...)
+	if buf, err = appendJSONTime(buf, o.Created); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"accessed":`...)
+	if buf, err = appendJSONTime(buf, o.Accessed); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"changed":`...)
+	if buf, err = appendJSONTime(buf, o.Changed); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"filename":`...)
+	buf = appendJSONString(buf, o.Filename)
+	if o.Deleted {
+		buf = append(buf, `,"deleted":`...)
+		buf = strconv.AppendBool(buf, o.Deleted)
+	}
+	if o.Flags != nil {
+		buf = append(buf, `,"flags":`...)
+		if o.Flags == nil {
+			buf = append(buf, "null"...)
+		} else {
+			buf = strconv.AppendUint(buf, (*o.Flags), 10)
+		}
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *MftFileEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Path) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PATH", Value: t.FormatField(o.Path, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Size) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SIZE", Value: t.FormatField(o.Size, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Dir) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "DIR", Value: t.FormatField(o.Dir, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Modified) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "MODIFIED", Value: t.FormatField(o.Modified, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Created) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "CREATED", Value: t.FormatField(o.Created, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Accessed) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ACCESSED", Value: t.FormatField(o.Accessed, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Changed) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "CHANGED", Value: t.FormatField(o.Changed, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Filename) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FILENAME", Value: t.FormatField(o.Filename, textlogModifiersNone)})
+	}
+	if !(!o.Deleted) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Deleted)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "DELETED", Value: t.FormatField(o.Deleted, textlogModifiersOmitempty)})
+	}
+	if !(o.Flags == nil) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Flags)) {
+		var value0 any = o.Flags
+		if o.Flags != nil {
+			value0 = (*o.Flags)
+		}
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FLAGS", Value: t.FormatField(value0, textlogModifiersOmitempty)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *MftFileEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Path) {
+		return jsonpointer.Pointer{"path"}
+	}
+	if pointee == any(&o.Size) {
+		return jsonpointer.Pointer{"size"}
+	}
+	if pointee == any(&o.Dir) {
+		return jsonpointer.Pointer{"dir"}
+	}
+	if pointee == any(&o.Modified) {
+		return jsonpointer.Pointer{"modified"}
+	}
+	if pointee == any(&o.Created) {
+		return jsonpointer.Pointer{"created"}
+	}
+	if pointee == any(&o.Accessed) {
+		return jsonpointer.Pointer{"accessed"}
+	}
+	if pointee == any(&o.Changed) {
+		return jsonpointer.Pointer{"changed"}
+	}
+	if pointee == any(&o.Filename) {
+		return jsonpointer.Pointer{"filename"}
+	}
+	if pointee == any(&o.Deleted) {
+		return jsonpointer.Pointer{"deleted"}
+	}
+	if pointee == any(&o.Flags) {
+		return jsonpointer.Pointer{"flags"}
+	}
+	if pointee == any(o.Flags) {
+		return jsonpointer.Pointer{"flags"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *MftFileEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Path) {
+		return "PATH", true
+	}
+	if pointee == any(&o.Size) {
+		return "SIZE", true
+	}
+	if pointee == any(&o.Dir) {
+		return "DIR", true
+	}
+	if pointee == any(&o.Modified) {
+		return "MODIFIED", true
+	}
+	if pointee == any(&o.Created) {
+		return "CREATED", true
+	}
+	if pointee == any(&o.Accessed) {
+		return "ACCESSED", true
+	}
+	if pointee == any(&o.Changed) {
+		return "CHANGED", true
+	}
+	if pointee == any(&o.Filename) {
+		return "FILENAME", true
+	}
+	if pointee == any(&o.Deleted) {
+		return "DELETED", true
+	}
+	if pointee == any(o.Flags) {
+		return "FLAGS", true
+	}
+	if pointee == any(&o.Flags) {
+		return "FLAGS", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *MsOfficeConnectionCacheEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"entry":`...)
+	buf = appendJSONString(buf, o.Entry)
+	buf = append(buf, `,"modified":`...)
+	if buf, err = appendJSONTime(buf, o.Modified); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"key":`...)
+	buf = appendJSONString(buf, o.Key)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *MsOfficeConnectionCacheEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Entry) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ENTRY", Value: t.FormatField(o.Entry, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Modified) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "MODIFIED", Value: t.FormatField(o.Modified, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Key) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "KEY", Value: t.FormatField(o.Key, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *MsOfficeConnectionCacheEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Entry) {
+		return jsonpointer.Pointer{"entry"}
+	}
+	if pointee == any(&o.Modified) {
+		return jsonpointer.Pointer{"modified"}
+	}
+	if pointee == any(&o.Key) {
+		return jsonpointer.Pointer{"key"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *MsOfficeConnectionCacheEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Entry) {
+		return "ENTRY", true
+	}
+	if pointee == any(&o.Modified) {
+		return "MODIFIED", true
+	}
+	if pointee == any(&o.Key) {
+		return "KEY", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *NetworkConnectingThread) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"thread_id":`...)
+	buf = strconv.AppendUint(buf, uint64(o.ThreadId), 10)
+	buf = append(buf, `,"process":`...)
+	if buf, err = o.Process.appendJSON(buf); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"callback_interval":`...)
+	buf = strconv.AppendInt(buf, int64(o.CallbackInterval), 10)
+	buf = append(buf, `,"connections":`...)
+	if o.Connections == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i1 := range o.Connections {
+			if i1 > 0 {
+				buf = append(buf, ',')
+			}
+			start2 := len(buf)
+			buf = append(buf, `,"protocol":`...)
+			buf = appendJSONString(buf, o.Connections[i1].Protocol)
+			buf = append(buf, `,"server":`...)
+			buf = appendJSONString(buf, o.Connections[i1].Server)
+			buf = closeJSONObject(buf, start2)
+		}
+		buf = append(buf, ']')
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *NetworkConnectingThread) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ThreadId) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "THREAD_ID", Value: t.FormatField(o.ThreadId, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.Process) {
+		for _, pair := range o.Process.marshalTextLog(t) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: pair.Key, Value: pair.Value})
+		}
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.CallbackInterval) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "CALLBACK_INTERVAL", Value: t.FormatField(o.CallbackInterval, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Connections) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "CONNECTIONS", Value: t.FormatField(o.Connections, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *NetworkConnectingThread) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.ThreadId) {
+		return jsonpointer.Pointer{"thread_id"}
+	}
+	if pointee == any(&o.Process) {
+		return jsonpointer.Pointer{"process"}
+	}
+	if pointee == any(o.Process) {
+		return jsonpointer.Pointer{"process"}
+	}
+	if p := o.Process.relativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"process"}, p...)
+	}
+	if pointee == any(&o.CallbackInterval) {
+		return jsonpointer.Pointer{"callback_interval"}
+	}
+	if pointee == any(&o.Connections) {
+		return jsonpointer.Pointer{"connections"}
+	}
+	for i0 := range o.Connections {
+		if pointee == any(&o.Connections[i0]) {
+			return jsonpointer.Pointer{"connections", strconv.Itoa(i0)}
+		}
+		if pointee == any(&o.Connections[i0].Protocol) {
+			return jsonpointer.Pointer{"connections", strconv.Itoa(i0), "protocol"}
+		}
+		if pointee == any(&o.Connections[i0].Server) {
+			return jsonpointer.Pointer{"connections", strconv.Itoa(i0), "server"}
+		}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *NetworkConnectingThread) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.ThreadId) {
+		return "THREAD_ID", true
+	}
+	if pointee == any(o.Process) {
+		return "", true
+	}
+	if pointee == any(&o.Process) {
+		return "", true
+	}
+	if label, ok := o.Process.relativeTextPointer(pointee); ok {
+		return label, true
+	}
+	if pointee == any(&o.CallbackInterval) {
+		return "CALLBACK_INTERVAL", true
+	}
+	if pointee == any(&o.Connections) {
+		return "CONNECTIONS", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *NetworkSession) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"client":`...)
+	buf = appendJSONString(buf, o.Client)
+	buf = append(buf, `,"user_name":`...)
+	buf = appendJSONString(buf, o.Username)
+	buf = append(buf, `,"client_type":`...)
+	buf = appendJSONString(buf, o.ClientType)
+	buf = append(buf, `,"active":`...)
+	buf = strconv.AppendInt(buf, int64(o.Active), 10)
+	buf = append(buf, `,"idle":`...)
+	buf = strconv.AppendInt(buf, int64(o.Idle), 10)
+	buf = append(buf, `,"num_opens":`...)
+	buf = strconv.AppendInt(buf, int64(o.NumOpens), 10)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *NetworkSession) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Client) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "CLIENT", Value: t.FormatField(o.Client, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Username) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "USERNAME", Value: t.FormatField(o.Username, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ClientType) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "CLIENT_TYPE", Value: t.FormatField(o.ClientType, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Active) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ACTIVE", Value: t.FormatField(o.Active, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Idle) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "IDLE", Value: t.FormatField(o.Idle, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.NumOpens) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NUM_OPENS", Value: t.FormatField(o.NumOpens, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *NetworkSession) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Client) {
+		return jsonpointer.Pointer{"client"}
+	}
+	if pointee == any(&o.Username) {
+		return jsonpointer.Pointer{"user_name"}
+	}
+	if pointee == any(&o.ClientType) {
+		return jsonpointer.Pointer{"client_type"}
+	}
+	if pointee == any(&o.Active) {
+		return jsonpointer.Pointer{"active"}
+	}
+	if pointee == any(&o.Idle) {
+		return jsonpointer.Pointer{"idle"}
+	}
+	if pointee == any(&o.NumOpens) {
+		return jsonpointer.Pointer{"num_opens"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *NetworkSession) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Client) {
+		return "CLIENT", true
+	}
+	if pointee == any(&o.Username) {
+		return "USERNAME", true
+	}
+	if pointee == any(&o.ClientType) {
+		return "CLIENT_TYPE", true
+	}
+	if pointee == any(&o.Active) {
+		return "ACTIVE", true
+	}
+	if pointee == any(&o.Idle) {
+		return "IDLE", true
+	}
+	if pointee == any(&o.NumOpens) {
+		return "NUM_OPENS", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *NetworkShare) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"name":`...)
+	buf = appendJSONString(buf, o.Name)
+	buf = append(buf, `,"path":`...)
+	buf = appendJSONString(buf, o.Path)
+	buf = append(buf, `,"permissions":`...)
+	if o.Permissions == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i1 := range o.Permissions {
+			if i1 > 0 {
+				buf = append(buf, ',')
+			}
+			start2 := len(buf)
+			buf = append(buf, `,"group":`...)
+			buf = appendJSONString(buf, o.Permissions[i1].Group)
+			buf = append(buf, `,"access":`...)
+			if buf, err = appendJSONValue(buf, &o.Permissions[i1].Access); err != nil {
+				return nil, err
+			}
+			buf = closeJSONObject(buf, start2)
+		}
+		buf = append(buf, ']')
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *NetworkShare) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Name) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SHARE_NAME", Value: t.FormatField(o.Name, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Path) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PATH", Value: t.FormatField(o.Path, textlogModifiersNone)})
+	}
+	if !(o.Permissions == nil) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Permissions)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SHARE_PERMS", Value: t.FormatField(o.Permissions, textlogModifiersOmitempty)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *NetworkShare) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Name) {
+		return jsonpointer.Pointer{"name"}
+	}
+	if pointee == any(&o.Path) {
+		return jsonpointer.Pointer{"path"}
+	}
+	if pointee == any(&o.Permissions) {
+		return jsonpointer.Pointer{"permissions"}
+	}
+	for i0 := range o.Permissions {
+		if pointee == any(&o.Permissions[i0]) {
+			return jsonpointer.Pointer{"permissions", strconv.Itoa(i0)}
+		}
+		if pointee == any(&o.Permissions[i0].Group) {
+			return jsonpointer.Pointer{"permissions", strconv.Itoa(i0), "group"}
+		}
+		if pointee == any(&o.Permissions[i0].Access) {
+			return jsonpointer.Pointer{"permissions", strconv.Itoa(i0), "access"}
+		}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *NetworkShare) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Name) {
+		return "SHARE_NAME", true
+	}
+	if pointee == any(&o.Path) {
+		return "PATH", true
+	}
+	if pointee == any(&o.Permissions) {
+		return "SHARE_PERMS", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *PSMacEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"path":`...)
+	buf = appendJSONString(buf, o.Path)
+	buf = append(buf, `,"command":`...)
+	buf = appendJSONString(buf, o.Command)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *PSMacEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Path) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PATH", Value: t.FormatField(o.Path, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Command) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "COMMAND", Value: t.FormatField(o.Command, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *PSMacEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Path) {
+		return jsonpointer.Pointer{"path"}
+	}
+	if pointee == any(&o.Command) {
+		return jsonpointer.Pointer{"command"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *PSMacEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Path) {
+		return "PATH", true
+	}
+	if pointee == any(&o.Command) {
+		return "COMMAND", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *PlatformInfoAIX) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"model":`...)
+	buf = appendJSONString(buf, o.Model)
+	buf = append(buf, `,"version":`...)
+	buf = appendJSONString(buf, o.Version)
+	buf = append(buf, `,"proc":`...)
+	buf = appendJSONString(buf, o.Proc)
+	buf = append(buf, `,"vcpus":`...)
+	buf = strconv.AppendInt(buf, int64(o.VCPUs), 10)
+	buf = append(buf, `,"os_build_time":`...)
+	if buf, err = appendJSONTime(buf, o.OSBuildTime); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"technology_level":`...)
+	buf = strconv.AppendInt(buf, int64(o.TechnologyLevel), 10)
+	buf = append(buf, `,"service_pack":`...)
+	buf = strconv.AppendInt(buf, int64(o.ServicePack), 10)
+	buf = append(buf, `,"build_sequence_id":`...)
+	buf = strconv.AppendInt(buf, int64(o.BuildSequenceID), 10)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *PlatformInfoAIX) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Model) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "MODEL", Value: t.FormatField(o.Model, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Version) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "VERSION", Value: t.FormatField(o.Version, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Proc) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PROC", Value: t.FormatField(o.Proc, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.VCPUs) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "VCPUS", Value: t.FormatField(o.VCPUs, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.OSBuildTime) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "OS_BUILD_TIME", Value: t.FormatField(o.OSBuildTime, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.TechnologyLevel) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TECHNOLOGY_LEVEL", Value: t.FormatField(o.TechnologyLevel, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ServicePack) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SERVICE_PACK", Value: t.FormatField(o.ServicePack, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BuildSequenceID) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "BUILD_SEQUENCE_ID", Value: t.FormatField(o.BuildSequenceID, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *PlatformInfoAIX) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Model) {
+		return jsonpointer.Pointer{"model"}
+	}
+	if pointee == any(&o.Version) {
+		return jsonpointer.Pointer{"version"}
+	}
+	if pointee == any(&o.Proc) {
+		return jsonpointer.Pointer{"proc"}
+	}
+	if pointee == any(&o.VCPUs) {
+		return jsonpointer.Pointer{"vcpus"}
+	}
+	if pointee == any(&o.OSBuildTime) {
+		return jsonpointer.Pointer{"os_build_time"}
+	}
+	if pointee == any(&o.TechnologyLevel) {
+		return jsonpointer.Pointer{"technology_level"}
+	}
+	if pointee == any(&o.ServicePack) {
+		return jsonpointer.Pointer{"service_pack"}
+	}
+	if pointee == any(&o.BuildSequenceID) {
+		return jsonpointer.Pointer{"build_sequence_id"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *PlatformInfoAIX) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Model) {
+		return "MODEL", true
+	}
+	if pointee == any(&o.Version) {
+		return "VERSION", true
+	}
+	if pointee == any(&o.Proc) {
+		return "PROC", true
+	}
+	if pointee == any(&o.VCPUs) {
+		return "VCPUS", true
+	}
+	if pointee == any(&o.OSBuildTime) {
+		return "OS_BUILD_TIME", true
+	}
+	if pointee == any(&o.TechnologyLevel) {
+		return "TECHNOLOGY_LEVEL", true
+	}
+	if pointee == any(&o.ServicePack) {
+		return "SERVICE_PACK", true
+	}
+	if pointee == any(&o.BuildSequenceID) {
+		return "BUILD_SEQUENCE_ID", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *PlatformInfoLinux) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"name":`...)
+	buf = appendJSONString(buf, o.Name)
+	buf = append(buf, `,"kernel_name":`...)
+	buf = appendJSONString(buf, o.KernelName)
+	buf = append(buf, `,"kernel_version":`...)
+	buf = appendJSONString(buf, o.KernelVersion)
+	buf = append(buf, `,"proc":`...)
+	buf = appendJSONString(buf, o.Proc)
+	buf = append(buf, `,"arch":`...)
+	buf = appendJSONString(buf, o.Arch)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *PlatformInfoLinux) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Name) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NAME", Value: t.FormatField(o.Name, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.KernelName) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "KERNEL_NAME", Value: t.FormatField(o.KernelName, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.KernelVersion) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "KERNEL_VERSION", Value: t.FormatField(o.KernelVersion, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Proc) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PROC", Value: t.FormatField(o.Proc, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Arch) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ARCH", Value: t.FormatField(o.Arch, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *PlatformInfoLinux) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Name) {
+		return jsonpointer.Pointer{"name"}
+	}
+	if pointee == any(&o.KernelName) {
+		return jsonpointer.Pointer{"kernel_name"}
+	}
+	if pointee == any(&o.KernelVersion) {
+		return jsonpointer.Pointer{"kernel_version"}
+	}
+	if pointee == any(&o.Proc) {
+		return jsonpointer.Pointer{"proc"}
+	}
+	if pointee == any(&o.Arch) {
+		return jsonpointer.Pointer{"arch"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *PlatformInfoLinux) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Name) {
+		return "NAME", true
+	}
+	if pointee == any(&o.KernelName) {
+		return "KERNEL_NAME", true
+	}
+	if pointee == any(&o.KernelVersion) {
+		return "KERNEL_VERSION", true
+	}
+	if pointee == any(&o.Proc) {
+		return "PROC", true
+	}
+	if pointee == any(&o.Arch) {
+		return "ARCH", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *PlatformInfoMacos) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"name":`...)
+	buf = appendJSONString(buf, o.Name)
+	buf = append(buf, `,"version":`...)
+	buf = appendJSONString(buf, o.Version)
+	buf = append(buf, `,"kernel_name":`...)
+	buf = appendJSONString(buf, o.KernelName)
+	buf = append(buf, `,"kernel_version":`...)
+	buf = appendJSONString(buf, o.KernelVersion)
+	buf = append(buf, `,"proc":`...)
+	buf = appendJSONString(buf, o.Proc)
+	buf = append(buf, `,"arch":`...)
+	buf = appendJSONString(buf, o.Arch)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *PlatformInfoMacos) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Name) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NAME", Value: t.FormatField(o.Name, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Version) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "VERSION", Value: t.FormatField(o.Version, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.KernelName) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "KERNEL_NAME", Value: t.FormatField(o.KernelName, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.KernelVersion) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "KERNEL_VERSION", Value: t.FormatField(o.KernelVersion, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Proc) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PROC", Value: t.FormatField(o.Proc, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Arch) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ARCH", Value: t.FormatField(o.Arch, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *PlatformInfoMacos) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Name) {
+		return jsonpointer.Pointer{"name"}
+	}
+	if pointee == any(&o.Version) {
+		return jsonpointer.Pointer{"version"}
+	}
+	if pointee == any(&o.KernelName) {
+		return jsonpointer.Pointer{"kernel_name"}
+	}
+	if pointee == any(&o.KernelVersion) {
+		return jsonpointer.Pointer{"kernel_version"}
+	}
+	if pointee == any(&o.Proc) {
+		return jsonpointer.Pointer{"proc"}
+	}
+	if pointee == any(&o.Arch) {
+		return jsonpointer.Pointer{"arch"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *PlatformInfoMacos) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Name) {
+		return "NAME", true
+	}
+	if pointee == any(&o.Version) {
+		return "VERSION", true
+	}
+	if pointee == any(&o.KernelName) {
+		return "KERNEL_NAME", true
+	}
+	if pointee == any(&o.KernelVersion) {
+		return "KERNEL_VERSION", true
+	}
+	if pointee == any(&o.Proc) {
+		return "PROC", true
+	}
+	if pointee == any(&o.Arch) {
+		return "ARCH", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *PlatformInfoWindows) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"name":`...)
+	buf = appendJSONString(buf, o.Name)
+	buf = append(buf, `,"os_type":`...)
+	buf = appendJSONString(buf, o.Type)
+	buf = append(buf, `,"version":`...)
+	buf = appendJSONString(buf, o.Version)
+	buf = append(buf, `,"proc":`...)
+	buf = appendJSONString(buf, o.Proc)
+	buf = append(buf, `,"arch":`...)
+	buf = appendJSONString(buf, o.Arch)
+	buf = append(buf, `,"installed_on":`...)
+	if buf, err = appendJSONTime(buf, o.InstalledOn); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"build_number":`...)
+	buf = appendJSONString(buf, o.BuildNumber)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *PlatformInfoWindows) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Name) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NAME", Value: t.FormatField(o.Name, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Type) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TYPE", Value: t.FormatField(o.Type, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Version) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "VERSION", Value: t.FormatField(o.Version, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Proc) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PROC", Value: t.FormatField(o.Proc, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Arch) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ARCH", Value: t.FormatField(o.Arch, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.InstalledOn) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "INSTALLED_ON", Value: t.FormatField(o.InstalledOn, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BuildNumber) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "BUILD_NUMBER", Value: t.FormatField(o.BuildNumber, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *PlatformInfoWindows) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Name) {
+		return jsonpointer.Pointer{"name"}
+	}
+	if pointee == any(&o.Type) {
+		return jsonpointer.Pointer{"os_type"}
+	}
+	if pointee == any(&o.Version) {
+		return jsonpointer.Pointer{"version"}
+	}
+	if pointee == any(&o.Proc) {
+		return jsonpointer.Pointer{"proc"}
+	}
+	if pointee == any(&o.Arch) {
+		return jsonpointer.Pointer{"arch"}
+	}
+	if pointee == any(&o.InstalledOn) {
+		return jsonpointer.Pointer{"installed_on"}
+	}
+	if pointee == any(&o.BuildNumber) {
+		return jsonpointer.Pointer{"build_number"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *PlatformInfoWindows) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Name) {
+		return "NAME", true
+	}
+	if pointee == any(&o.Type) {
+		return "TYPE", true
+	}
+	if pointee == any(&o.Version) {
+		return "VERSION", true
+	}
+	if pointee == any(&o.Proc) {
+		return "PROC", true
+	}
+	if pointee == any(&o.Arch) {
+		return "ARCH", true
+	}
+	if pointee == any(&o.InstalledOn) {
+		return "INSTALLED_ON", true
+	}
+	if pointee == any(&o.BuildNumber) {
+		return "BUILD_NUMBER", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *PluginStructuredData) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"plugin":`...)
+	buf = appendJSONString(buf, o.Plugin)
+	buf = append(buf, `,"data":`...)
+	if buf, err = appendJSONValue(buf, &o.Data); err != nil {
+		return nil, err
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *PluginStructuredData) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Data) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "DATA", Value: t.FormatField(o.Data, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *PluginStructuredData) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Plugin) {
+		return jsonpointer.Pointer{"plugin"}
+	}
+	if pointee == any(&o.Data) {
+		return jsonpointer.Pointer{"data"}
+	}
+	if p := o.Data.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"data"}, p...)
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *PluginStructuredData) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Plugin) {
+		return "-", true
+	}
+	if pointee == any(&o.Data) {
+		return "DATA", true
+	}
+	if label, ok := o.Data.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "DATA"), true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *PrefetchInfo) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"executable":`...)
+	if buf, err = o.Executable.appendJSON(buf); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"execution_times":`...)
+	if o.ExecutionTimes == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i1 := range o.ExecutionTimes {
+			if i1 > 0 {
+				buf = append(buf, ',')
+			}
+			if buf, err = appendJSONTime(buf, o.ExecutionTimes[i1]); err != nil {
+				return nil, err
+			}
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"execution_count":`...)
+	buf = strconv.AppendInt(buf, int64(o.ExecutionCount), 10)
+	buf = append(buf, `,"accessed_files":`...)
+	if o.AccessedFiles == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i2 := range o.AccessedFiles {
+			if i2 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.AccessedFiles[i2])
+		}
+		buf = append(buf, ']')
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *PrefetchInfo) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.Executable) {
+		for _, pair := range o.Executable.marshalTextLog(t) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("EXECUTABLE", pair.Key), Value: pair.Value})
+		}
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.ExecutionTimes) {
+		for _, pair := range t.FormatEntry(o.ExecutionTimes) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: pair.Key, Value: pair.Value})
+		}
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ExecutionCount) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "EXECUTION_COUNT", Value: t.FormatField(o.ExecutionCount, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *PrefetchInfo) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Executable) {
+		return jsonpointer.Pointer{"executable"}
+	}
+	if pointee == any(o.Executable) {
+		return jsonpointer.Pointer{"executable"}
+	}
+	if p := o.Executable.relativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"executable"}, p...)
+	}
+	if pointee == any(&o.ExecutionTimes) {
+		return jsonpointer.Pointer{"execution_times"}
+	}
+	for i0 := range o.ExecutionTimes {
+		if pointee == any(&o.ExecutionTimes[i0]) {
+			return jsonpointer.Pointer{"execution_times", strconv.Itoa(i0)}
+		}
+	}
+	if pointee == any(&o.ExecutionCount) {
+		return jsonpointer.Pointer{"execution_count"}
+	}
+	if pointee == any(&o.AccessedFiles) {
+		return jsonpointer.Pointer{"accessed_files"}
+	}
+	for i1 := range o.AccessedFiles {
+		if pointee == any(&o.AccessedFiles[i1]) {
+			return jsonpointer.Pointer{"accessed_files", strconv.Itoa(i1)}
+		}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *PrefetchInfo) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(o.Executable) {
+		return "EXECUTABLE", true
+	}
+	if pointee == any(&o.Executable) {
+		return "EXECUTABLE", true
+	}
+	if label, ok := o.Executable.relativeTextPointer(pointee); ok {
+		return jsonlog.ConcatTextLabels("EXECUTABLE", label), true
+	}
+	if pointee == any(&o.ExecutionTimes) {
+		return "", true
+	}
+	if pointee == any(&o.ExecutionCount) {
+		return "EXECUTION_COUNT", true
+	}
+	if pointee == any(&o.AccessedFiles) {
+		return "-", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *Process) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"pid":`...)
+	buf = strconv.AppendInt(buf, int64(o.Pid), 10)
+	if o.Dead {
+		buf = append(buf, `,"dead":`...)
+		buf = strconv.AppendBool(buf, o.Dead)
+	}
+	buf = append(buf, `,"name":`...)
+	buf = appendJSONString(buf, o.ProcessInfo.Name)
+	buf = append(buf, `,"command":`...)
+	buf = appendJSONString(buf, o.ProcessInfo.Cmdline)
+	buf = append(buf, `,"owner":`...)
+	buf = appendJSONString(buf, o.ProcessInfo.User)
+	buf = append(buf, `,"image":`...)
+	if buf, err = o.ProcessInfo.Image.appendJSON(buf); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"parent_info":`...)
+	start1 := len(buf)
+	buf = append(buf, `,"pid":`...)
+	buf = strconv.AppendInt(buf, int64(o.ProcessInfo.ParentInfo.Pid), 10)
+	buf = append(buf, `,"exe":`...)
+	buf = appendJSONString(buf, o.ProcessInfo.ParentInfo.Exe)
+	buf = append(buf, `,"command":`...)
+	buf = appendJSONString(buf, o.ProcessInfo.ParentInfo.CommandLine)
+	buf = closeJSONObject(buf, start1)
+	buf = append(buf, `,"tree":`...)
+	if o.ProcessInfo.ProcessTree == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i2 := range o.ProcessInfo.ProcessTree {
+			if i2 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.ProcessInfo.ProcessTree[i2])
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"created":`...)
+	if buf, err = appendJSONTime(buf, o.ProcessInfo.Created); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"session":`...)
+	buf = appendJSONString(buf, o.ProcessInfo.Session)
+	buf = append(buf, `,"listen_ports":`...)
+	if o.ProcessInfo.ProcessConnections.ListenPorts == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i3 := range o.ProcessInfo.ProcessConnections.ListenPorts {
+			if i3 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = strconv.AppendUint(buf, uint64(o.ProcessInfo.ProcessConnections.ListenPorts[i3]), 10)
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"connections":`...)
+	if o.ProcessInfo.ProcessConnections.Connections == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i4 := range o.ProcessInfo.ProcessConnections.Connections {
+			if i4 > 0 {
+				buf = append(buf, ',')
+			}
+			start5 := len(buf)
+			buf = append(buf, `,"status":`...)
+			buf = appendJSONString(buf, o.ProcessInfo.ProcessConnections.Connections[i4].Status)
+			buf = append(buf, `,"ip":`...)
+			buf = appendJSONString(buf, o.ProcessInfo.ProcessConnections.Connections[i4].Ip)
+			buf = append(buf, `,"port":`...)
+			buf = strconv.AppendUint(buf, uint64(o.ProcessInfo.ProcessConnections.Connections[i4].Port), 10)
+			if len(o.ProcessInfo.ProcessConnections.Connections[i4].RemoteIp) != 0 {
+				buf = append(buf, `,"remote_ip":`...)
+				buf = appendJSONString(buf, o.ProcessInfo.ProcessConnections.Connections[i4].RemoteIp)
+			}
+			if o.ProcessInfo.ProcessConnections.Connections[i4].RemotePort != 0 {
+				buf = append(buf, `,"remote_port":`...)
+				buf = strconv.AppendUint(buf, uint64(o.ProcessInfo.ProcessConnections.Connections[i4].RemotePort), 10)
+			}
+			if len(o.ProcessInfo.ProcessConnections.Connections[i4].Protocol) != 0 {
+				buf = append(buf, `,"protocol":`...)
+				buf = appendJSONString(buf, o.ProcessInfo.ProcessConnections.Connections[i4].Protocol)
+			}
+			buf = closeJSONObject(buf, start5)
+		}
+		buf = append(buf, ']')
+	}
+	if len(o.ProcessInfo.Sections) != 0 {
+		buf = append(buf, `,"sections":`...)
+		if o.ProcessInfo.Sections == nil {
+			buf = append(buf, "null"...)
+		} else {
+			buf = append(buf, '[')
+			for i6 := range o.ProcessInfo.Sections {
+				if i6 > 0 {
+					buf = append(buf, ',')
+				}
+				start7 := len(buf)
+				buf = append(buf, `,"name":`...)
+				buf = appendJSONString(buf, o.ProcessInfo.Sections[i6].Name)
+				buf = append(buf, `,"address":`...)
+				buf = strconv.AppendUint(buf, o.ProcessInfo.Sections[i6].Address, 10)
+				buf = append(buf, `,"size":`...)
+				buf = strconv.AppendUint(buf, o.ProcessInfo.Sections[i6].Size, 10)
+				if o.ProcessInfo.Sections[i6].Offset != 0 {
+					buf = append(buf, `,"offset":`...)
+					buf = strconv.AppendUint(buf, o.ProcessInfo.Sections[i6].Offset, 10)
+				}
+				if o.ProcessInfo.Sections[i6].SparseData != nil {
+					buf = append(buf, `,"sparse_data":`...)
+					if buf, err = o.ProcessInfo.Sections[i6].SparseData.appendJSON(buf); err != nil {
+						return nil, err
+					}
+				}
+				buf = append(buf, `,"permissions":`...)
+				start8 := len(buf)
+				buf = append(buf, `,"readable":`...)
+				buf = strconv.AppendBool(buf, o.ProcessInfo.Sections[i6].Permissions.Readable)
+				buf = append(buf, `,"writable":`...)
+				buf = strconv.AppendBool(buf, o.ProcessInfo.Sections[i6].Permissions.Writable)
+				buf = append(buf, `,"executable":`...)
+				buf = strconv.AppendBool(buf, o.ProcessInfo.Sections[i6].Permissions.Executable)
+				buf = closeJSONObject(buf, start8)
+				buf = closeJSONObject(buf, start7)
+			}
+			buf = append(buf, ']')
+		}
+	}
+	if o.BeaconConfig != nil {
+		buf = append(buf, `,"beacon_config":`...)
+		if o.BeaconConfig == nil {
+			buf = append(buf, "null"...)
+		} else {
+			start9 := len(buf)
+			buf = append(buf, `,"beacon_type":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.Type)
+			buf = append(buf, `,"c2":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.C2)
+			buf = append(buf, `,"port":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.Port)
+			buf = append(buf, `,"spawn_to":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.SpawnTo)
+			buf = append(buf, `,"injection_process":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.InjectionProcess)
+			buf = append(buf, `,"pipe_name":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.Pipename)
+			buf = append(buf, `,"user_agent":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.UserAgent)
+			buf = append(buf, `,"proxy":`...)
+			buf = appendJSONString(buf, o.BeaconConfig.Proxy)
+			buf = append(buf, `,"full_config":`...)
+			if buf, err = appendJSONValue(buf, &o.BeaconConfig.FullConfig); err != nil {
+				return nil, err
+			}
+			buf = append(buf, `,"cipher_parameters":`...)
+			start10 := len(buf)
+			buf = append(buf, `,"xaf_encoded":`...)
+			buf = strconv.AppendBool(buf, o.BeaconConfig.CipherParameters.XafEncoded)
+			buf = append(buf, `,"xaf_encoding_anchor":`...)
+			buf = strconv.AppendInt(buf, o.BeaconConfig.CipherParameters.XafEncodingAnchor, 10)
+			buf = append(buf, `,"xor_key":`...)
+			buf = strconv.AppendUint(buf, uint64(o.BeaconConfig.CipherParameters.XorKey), 10)
+			buf = append(buf, `,"beacon_offset":`...)
+			buf = strconv.AppendUint(buf, o.BeaconConfig.CipherParameters.BeaconOffset, 10)
+			buf = append(buf, `,"beacon_length":`...)
+			buf = strconv.AppendUint(buf, o.BeaconConfig.CipherParameters.BeaconLength, 10)
+			buf = append(buf, `,"block_start":`...)
+			if buf, err = appendJSONValue(buf, &o.BeaconConfig.CipherParameters.BlockStart); err != nil {
+				return nil, err
+			}
+			buf = append(buf, `,"pairwise_swapped":`...)
+			buf = strconv.AppendBool(buf, o.BeaconConfig.CipherParameters.PairwiseSwapped)
+			buf = closeJSONObject(buf, start10)
+			buf = closeJSONObject(buf, start9)
+		}
+	}
+	if o.PeSieveReport != nil {
+		buf = append(buf, `,"pe_sieve":`...)
+		if o.PeSieveReport == nil {
+			buf = append(buf, "null"...)
+		} else {
+			start11 := len(buf)
+			buf = append(buf, `,"suspicious_sections":`...)
+			buf = strconv.AppendInt(buf, int64(o.PeSieveReport.SuspiciousSections), 10)
+			buf = append(buf, `,"replaced":`...)
+			buf = strconv.AppendInt(buf, int64(o.PeSieveReport.Replaced), 10)
+			buf = append(buf, `,"hdr_mod":`...)
+			buf = strconv.AppendInt(buf, int64(o.PeSieveReport.HdrMod), 10)
+			buf = append(buf, `,"unreachable_file":`...)
+			buf = strconv.AppendInt(buf, int64(o.PeSieveReport.UnreachableFile), 10)
+			buf = append(buf, `,"patched":`...)
+			buf = strconv.AppendInt(buf, int64(o.PeSieveReport.Patched), 10)
+			buf = append(buf, `,"iat_hooked":`...)
+			buf = strconv.AppendInt(buf, int64(o.PeSieveReport.IatHooked), 10)
+			buf = append(buf, `,"implanted":`...)
+			buf = strconv.AppendInt(buf, int64(o.PeSieveReport.Implanted), 10)
+			buf = append(buf, `,"other":`...)
+			buf = strconv.AppendInt(buf, int64(o.PeSieveReport.Other), 10)
+			buf = append(buf, `,"skipped":`...)
+			buf = strconv.AppendInt(buf, int64(o.PeSieveReport.Skipped), 10)
+			buf = append(buf, `,"errors":`...)
+			buf = strconv.AppendInt(buf, int64(o.PeSieveReport.Errors), 10)
+			buf = closeJSONObject(buf, start11)
+		}
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *Process) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Pid) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PID", Value: t.FormatField(o.Pid, textlogModifiersNone)})
+	}
+	if !(!o.Dead) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Dead)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "DEAD", Value: t.FormatField(o.Dead, textlogModifiersOmitempty)})
+	}
+	if !(reflect.ValueOf(o.ProcessInfo).IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.ProcessInfo)) {
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ProcessInfo.Name) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "NAME", Value: t.FormatField(o.ProcessInfo.Name, textlogModifiersNone)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ProcessInfo.Cmdline) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "COMMAND", Value: t.FormatField(o.ProcessInfo.Cmdline, textlogModifiersNone)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ProcessInfo.User) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "OWNER", Value: t.FormatField(o.ProcessInfo.User, textlogModifiersNone)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.ProcessInfo.Image) {
+			for _, pair := range o.ProcessInfo.Image.marshalTextLog(t) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("IMAGE", pair.Key), Value: pair.Value})
+			}
+		}
+		if !(isComparableZero(o.ProcessInfo.ParentInfo)) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.ProcessInfo.ParentInfo)) {
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ProcessInfo.ParentInfo.Pid) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "PPID", Value: t.FormatField(o.ProcessInfo.ParentInfo.Pid, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ProcessInfo.ParentInfo.Exe) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "PARENT", Value: t.FormatField(o.ProcessInfo.ParentInfo.Exe, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ProcessInfo.ParentInfo.CommandLine) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "PARENT_COMMAND", Value: t.FormatField(o.ProcessInfo.ParentInfo.CommandLine, textlogModifiersNone)})
+			}
+		}
+		if !(o.ProcessInfo.ProcessTree == nil) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.ProcessInfo.ProcessTree)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "TREE", Value: t.FormatField(o.ProcessInfo.ProcessTree, textlogModifiersOmitempty)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ProcessInfo.Created) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "CREATED", Value: t.FormatField(o.ProcessInfo.Created, textlogModifiersNone)})
+		}
+		if !(o.ProcessInfo.Session == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.ProcessInfo.Session)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "SESSION", Value: t.FormatField(o.ProcessInfo.Session, textlogModifiersOmitempty)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.ProcessInfo.ProcessConnections) {
+			if !(o.ProcessInfo.ProcessConnections.ListenPorts == nil) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.ProcessInfo.ProcessConnections.ListenPorts)) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "LISTEN_PORTS", Value: t.FormatField(o.ProcessInfo.ProcessConnections.ListenPorts, textlogModifiersOmitempty)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ProcessInfo.ProcessConnections.ConnectionCount) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "CONNECTION_COUNT", Value: t.FormatField(o.ProcessInfo.ProcessConnections.ConnectionCount, textlogModifiersNone)})
+			}
+		}
+	}
+	if !(o.BeaconConfig == nil) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.BeaconConfig)) {
+		if o.BeaconConfig != nil {
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.Type) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_TYPE", Value: t.FormatField(o.BeaconConfig.Type, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.C2) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_C2", Value: t.FormatField(o.BeaconConfig.C2, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.Port) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_PORT", Value: t.FormatField(o.BeaconConfig.Port, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.SpawnTo) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_SPAWNTO", Value: t.FormatField(o.BeaconConfig.SpawnTo, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.InjectionProcess) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_INJECTION_PROCESS", Value: t.FormatField(o.BeaconConfig.InjectionProcess, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.Pipename) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_PIPENAME", Value: t.FormatField(o.BeaconConfig.Pipename, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.UserAgent) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_USER_AGENT", Value: t.FormatField(o.BeaconConfig.UserAgent, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.Proxy) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_PROXY", Value: t.FormatField(o.BeaconConfig.Proxy, textlogModifiersNone)})
+			}
+			if !(reflect.ValueOf(o.BeaconConfig.CipherParameters).IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.BeaconConfig.CipherParameters)) {
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.XafEncoded) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_XAF_ENCODED", Value: t.FormatField(o.BeaconConfig.CipherParameters.XafEncoded, textlogModifiersNone)})
+				}
+				if !(o.BeaconConfig.CipherParameters.XafEncodingAnchor == 0) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.BeaconConfig.CipherParameters.XafEncodingAnchor)) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_XAF_ENCODING_ANCHOR", Value: t.FormatField(o.BeaconConfig.CipherParameters.XafEncodingAnchor, textlogModifiersOmitempty)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.XorKey) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_XOR_KEY", Value: t.FormatField(o.BeaconConfig.CipherParameters.XorKey, textlogModifiersNone)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.BeaconOffset) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_BEACON_OFFSET", Value: t.FormatField(o.BeaconConfig.CipherParameters.BeaconOffset, textlogModifiersNone)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.BeaconLength) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_BEACON_LENGTH", Value: t.FormatField(o.BeaconConfig.CipherParameters.BeaconLength, textlogModifiersNone)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.BlockStart) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_BLOCK_START", Value: t.FormatField(o.BeaconConfig.CipherParameters.BlockStart, textlogModifiersNone)})
+				}
+				if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BeaconConfig.CipherParameters.PairwiseSwapped) {
+					entry = append(entry, jsonlog.TextlogValuePair{Key: "BEACON_CIPHER_PARAMETERS_PAIRWISE_SWAPPED", Value: t.FormatField(o.BeaconConfig.CipherParameters.PairwiseSwapped, textlogModifiersNone)})
+				}
+			}
+		}
+	}
+	if !(o.PeSieveReport == nil) && (t.Omit == nil || !t.Omit(textlogModifiersExpandOmitempty, o.PeSieveReport)) {
+		if o.PeSieveReport != nil {
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.PeSieveReport.SuspiciousSections) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "PE_SIEVE_SUSPICIOUS_SECTIONS", Value: t.FormatField(o.PeSieveReport.SuspiciousSections, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.PeSieveReport.Replaced) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "PE_SIEVE_REPLACED", Value: t.FormatField(o.PeSieveReport.Replaced, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.PeSieveReport.HdrMod) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "PE_SIEVE_HDR_MOD", Value: t.FormatField(o.PeSieveReport.HdrMod, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.PeSieveReport.UnreachableFile) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "PE_SIEVE_UNREACHABLE_FILE", Value: t.FormatField(o.PeSieveReport.UnreachableFile, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.PeSieveReport.Patched) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "PE_SIEVE_PATCHED", Value: t.FormatField(o.PeSieveReport.Patched, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.PeSieveReport.IatHooked) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "PE_SIEVE_IAT_HOOKED", Value: t.FormatField(o.PeSieveReport.IatHooked, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.PeSieveReport.Implanted) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "PE_SIEVE_IMPLANTED", Value: t.FormatField(o.PeSieveReport.Implanted, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.PeSieveReport.Other) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "PE_SIEVE_OTHER", Value: t.FormatField(o.PeSieveReport.Other, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.PeSieveReport.Skipped) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "PE_SIEVE_SKIPPED", Value: t.FormatField(o.PeSieveReport.Skipped, textlogModifiersNone)})
+			}
+			if t.Omit == nil || !t.Omit(textlogModifiersNone, o.PeSieveReport.Errors) {
+				entry = append(entry, jsonlog.TextlogValuePair{Key: "PE_SIEVE_ERRORS", Value: t.FormatField(o.PeSieveReport.Errors, textlogModifiersNone)})
+			}
+		}
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *Process) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Pid) {
+		return jsonpointer.Pointer{"pid"}
+	}
+	if pointee == any(&o.Dead) {
+		return jsonpointer.Pointer{"dead"}
+	}
+	if pointee == any(&o.ProcessInfo) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ProcessInfo.Name) {
+		return jsonpointer.Pointer{"name"}
+	}
+	if pointee == any(&o.ProcessInfo.Cmdline) {
+		return jsonpointer.Pointer{"command"}
+	}
+	if pointee == any(&o.ProcessInfo.User) {
+		return jsonpointer.Pointer{"owner"}
+	}
+	if pointee == any(&o.ProcessInfo.Image) {
+		return jsonpointer.Pointer{"image"}
+	}
+	if pointee == any(o.ProcessInfo.Image) {
+		return jsonpointer.Pointer{"image"}
+	}
+	if p := o.ProcessInfo.Image.relativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"image"}, p...)
+	}
+	if pointee == any(&o.ProcessInfo.ParentInfo) {
+		return jsonpointer.Pointer{"parent_info"}
+	}
+	if pointee == any(&o.ProcessInfo.ParentInfo.Pid) {
+		return jsonpointer.Pointer{"parent_info", "pid"}
+	}
+	if pointee == any(&o.ProcessInfo.ParentInfo.Exe) {
+		return jsonpointer.Pointer{"parent_info", "exe"}
+	}
+	if pointee == any(&o.ProcessInfo.ParentInfo.CommandLine) {
+		return jsonpointer.Pointer{"parent_info", "command"}
+	}
+	if pointee == any(&o.ProcessInfo.ProcessTree) {
+		return jsonpointer.Pointer{"tree"}
+	}
+	if p := o.ProcessInfo.ProcessTree.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"tree"}, p...)
+	}
+	if pointee == any(&o.ProcessInfo.Created) {
+		return jsonpointer.Pointer{"created"}
+	}
+	if pointee == any(&o.ProcessInfo.Session) {
+		return jsonpointer.Pointer{"session"}
+	}
+	if pointee == any(&o.ProcessInfo.ProcessConnections) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ProcessInfo.ProcessConnections.ListenPorts) {
+		return jsonpointer.Pointer{"listen_ports"}
+	}
+	for i0 := range o.ProcessInfo.ProcessConnections.ListenPorts {
+		if pointee == any(&o.ProcessInfo.ProcessConnections.ListenPorts[i0]) {
+			return jsonpointer.Pointer{"listen_ports", strconv.Itoa(i0)}
+		}
+	}
+	if pointee == any(&o.ProcessInfo.ProcessConnections.Connections) {
+		return jsonpointer.Pointer{"connections"}
+	}
+	for i1 := range o.ProcessInfo.ProcessConnections.Connections {
+		if pointee == any(&o.ProcessInfo.ProcessConnections.Connections[i1]) {
+			return jsonpointer.Pointer{"connections", strconv.Itoa(i1)}
+		}
+		if pointee == any(&o.ProcessInfo.ProcessConnections.Connections[i1].Fd) {
+			return jsonpointer.Pointer{"connections", strconv.Itoa(i1), "-"}
+		}
+		if pointee == any(&o.ProcessInfo.ProcessConnections.Connections[i1].Status) {
+			return jsonpointer.Pointer{"connections", strconv.Itoa(i1), "status"}
+		}
+		if pointee == any(&o.ProcessInfo.ProcessConnections.Connections[i1].Ip) {
+			return jsonpointer.Pointer{"connections", strconv.Itoa(i1), "ip"}
+		}
+		if pointee == any(&o.ProcessInfo.ProcessConnections.Connections[i1].Port) {
+			return jsonpointer.Pointer{"connections", strconv.Itoa(i1), "port"}
+		}
+		if pointee == any(&o.ProcessInfo.ProcessConnections.Connections[i1].RemoteIp) {
+			return jsonpointer.Pointer{"connections", strconv.Itoa(i1), "remote_ip"}
+		}
+		if pointee == any(&o.ProcessInfo.ProcessConnections.Connections[i1].RemotePort) {
+			return jsonpointer.Pointer{"connections", strconv.Itoa(i1), "remote_port"}
+		}
+		if pointee == any(&o.ProcessInfo.ProcessConnections.Connections[i1].Protocol) {
+			return jsonpointer.Pointer{"connections", strconv.Itoa(i1), "protocol"}
+		}
+	}
+	if pointee == any(&o.ProcessInfo.ProcessConnections.ConnectionCount) {
+		return jsonpointer.Pointer{"-"}
+	}
+	if pointee == any(&o.ProcessInfo.Sections) {
+		return jsonpointer.Pointer{"sections"}
+	}
+	for i2 := range o.ProcessInfo.Sections {
+		if pointee == any(&o.ProcessInfo.Sections[i2]) {
+			return jsonpointer.Pointer{"sections", strconv.Itoa(i2)}
+		}
+		if pointee == any(&o.ProcessInfo.Sections[i2].Name) {
+			return jsonpointer.Pointer{"sections", strconv.Itoa(i2), "name"}
+		}
+		if pointee == any(&o.ProcessInfo.Sections[i2].Address) {
+			return jsonpointer.Pointer{"sections", strconv.Itoa(i2), "address"}
+		}
+		if pointee == any(&o.ProcessInfo.Sections[i2].Size) {
+			return jsonpointer.Pointer{"sections", strconv.Itoa(i2), "size"}
+		}
+		if pointee == any(&o.ProcessInfo.Sections[i2].Offset) {
+			return jsonpointer.Pointer{"sections", strconv.Itoa(i2), "offset"}
+		}
+		if pointee == any(&o.ProcessInfo.Sections[i2].SparseData) {
+			return jsonpointer.Pointer{"sections", strconv.Itoa(i2), "sparse_data"}
+		}
+		if pointee == any(o.ProcessInfo.Sections[i2].SparseData) {
+			return jsonpointer.Pointer{"sections", strconv.Itoa(i2), "sparse_data"}
+		}
+		if p := o.ProcessInfo.Sections[i2].SparseData.relativeJsonPointer(pointee); p != nil {
+			return append(jsonpointer.Pointer{"sections", strconv.Itoa(i2), "sparse_data"}, p...)
+		}
+		if pointee == any(&o.ProcessInfo.Sections[i2].Permissions) {
+			return jsonpointer.Pointer{"sections", strconv.Itoa(i2), "permissions"}
+		}
+		if pointee == any(&o.ProcessInfo.Sections[i2].Permissions.Readable) {
+			return jsonpointer.Pointer{"sections", strconv.Itoa(i2), "permissions", "readable"}
+		}
+		if pointee == any(&o.ProcessInfo.Sections[i2].Permissions.Writable) {
+			return jsonpointer.Pointer{"sections", strconv.Itoa(i2), "permissions", "writable"}
+		}
+		if pointee == any(&o.ProcessInfo.Sections[i2].Permissions.Executable) {
+			return jsonpointer.Pointer{"sections", strconv.Itoa(i2), "permissions", "executable"}
+		}
+	}
+	if pointee == any(&o.BeaconConfig) {
+		return jsonpointer.Pointer{"beacon_config"}
+	}
+	if pointee == any(o.BeaconConfig) {
+		return jsonpointer.Pointer{"beacon_config"}
+	}
+	if o.BeaconConfig != nil {
+		if pointee == any(&o.BeaconConfig.Type) {
+			return jsonpointer.Pointer{"beacon_config", "beacon_type"}
+		}
+		if pointee == any(&o.BeaconConfig.C2) {
+			return jsonpointer.Pointer{"beacon_config", "c2"}
+		}
+		if pointee == any(&o.BeaconConfig.Port) {
+			return jsonpointer.Pointer{"beacon_config", "port"}
+		}
+		if pointee == any(&o.BeaconConfig.SpawnTo) {
+			return jsonpointer.Pointer{"beacon_config", "spawn_to"}
+		}
+		if pointee == any(&o.BeaconConfig.InjectionProcess) {
+			return jsonpointer.Pointer{"beacon_config", "injection_process"}
+		}
+		if pointee == any(&o.BeaconConfig.Pipename) {
+			return jsonpointer.Pointer{"beacon_config", "pipe_name"}
+		}
+		if pointee == any(&o.BeaconConfig.UserAgent) {
+			return jsonpointer.Pointer{"beacon_config", "user_agent"}
+		}
+		if pointee == any(&o.BeaconConfig.Proxy) {
+			return jsonpointer.Pointer{"beacon_config", "proxy"}
+		}
+		if pointee == any(&o.BeaconConfig.FullConfig) {
+			return jsonpointer.Pointer{"beacon_config", "full_config"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XafEncoded) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "xaf_encoded"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XafEncodingAnchor) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "xaf_encoding_anchor"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XorKey) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "xor_key"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BeaconOffset) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "beacon_offset"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BeaconLength) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "beacon_length"}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BlockStart) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "block_start"}
+		}
+		for i3 := range o.BeaconConfig.CipherParameters.BlockStart {
+			if pointee == any(&o.BeaconConfig.CipherParameters.BlockStart[i3]) {
+				return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "block_start", strconv.Itoa(i3)}
+			}
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.PairwiseSwapped) {
+			return jsonpointer.Pointer{"beacon_config", "cipher_parameters", "pairwise_swapped"}
+		}
+	}
+	if pointee == any(&o.PeSieveReport) {
+		return jsonpointer.Pointer{"pe_sieve"}
+	}
+	if pointee == any(o.PeSieveReport) {
+		return jsonpointer.Pointer{"pe_sieve"}
+	}
+	if o.PeSieveReport != nil {
+		if pointee == any(&o.PeSieveReport.SuspiciousSections) {
+			return jsonpointer.Pointer{"pe_sieve", "suspicious_sections"}
+		}
+		if pointee == any(&o.PeSieveReport.Replaced) {
+			return jsonpointer.Pointer{"pe_sieve", "replaced"}
+		}
+		if pointee == any(&o.PeSieveReport.HdrMod) {
+			return jsonpointer.Pointer{"pe_sieve", "hdr_mod"}
+		}
+		if pointee == any(&o.PeSieveReport.UnreachableFile) {
+			return jsonpointer.Pointer{"pe_sieve", "unreachable_file"}
+		}
+		if pointee == any(&o.PeSieveReport.Patched) {
+			return jsonpointer.Pointer{"pe_sieve", "patched"}
+		}
+		if pointee == any(&o.PeSieveReport.IatHooked) {
+			return jsonpointer.Pointer{"pe_sieve", "iat_hooked"}
+		}
+		if pointee == any(&o.PeSieveReport.Implanted) {
+			return jsonpointer.Pointer{"pe_sieve", "implanted"}
+		}
+		if pointee == any(&o.PeSieveReport.Other) {
+			return jsonpointer.Pointer{"pe_sieve", "other"}
+		}
+		if pointee == any(&o.PeSieveReport.Skipped) {
+			return jsonpointer.Pointer{"pe_sieve", "skipped"}
+		}
+		if pointee == any(&o.PeSieveReport.Errors) {
+			return jsonpointer.Pointer{"pe_sieve", "errors"}
+		}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *Process) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Pid) {
+		return "PID", true
+	}
+	if pointee == any(&o.Dead) {
+		return "DEAD", true
+	}
+	if pointee == any(&o.ProcessInfo) {
+		return "", true
+	}
+	if pointee == any(&o.ProcessInfo.Name) {
+		return "NAME", true
+	}
+	if pointee == any(&o.ProcessInfo.Cmdline) {
+		return "COMMAND", true
+	}
+	if pointee == any(&o.ProcessInfo.User) {
+		return "OWNER", true
+	}
+	if pointee == any(o.ProcessInfo.Image) {
+		return "IMAGE", true
+	}
+	if pointee == any(&o.ProcessInfo.Image) {
+		return "IMAGE", true
+	}
+	if label, ok := o.ProcessInfo.Image.relativeTextPointer(pointee); ok {
+		return jsonlog.ConcatTextLabels("IMAGE", label), true
+	}
+	if pointee == any(&o.ProcessInfo.ParentInfo) {
+		return "", true
+	}
+	if pointee == any(&o.ProcessInfo.ParentInfo.Pid) {
+		return "PPID", true
+	}
+	if pointee == any(&o.ProcessInfo.ParentInfo.Exe) {
+		return "PARENT", true
+	}
+	if pointee == any(&o.ProcessInfo.ParentInfo.CommandLine) {
+		return "PARENT_COMMAND", true
+	}
+	if pointee == any(&o.ProcessInfo.ProcessTree) {
+		return "TREE", true
+	}
+	if label, ok := o.ProcessInfo.ProcessTree.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "TREE"), true
+	}
+	if pointee == any(&o.ProcessInfo.Created) {
+		return "CREATED", true
+	}
+	if pointee == any(&o.ProcessInfo.Session) {
+		return "SESSION", true
+	}
+	if pointee == any(&o.ProcessInfo.ProcessConnections) {
+		return "", true
+	}
+	if pointee == any(&o.ProcessInfo.ProcessConnections.ListenPorts) {
+		return "LISTEN_PORTS", true
+	}
+	if pointee == any(&o.ProcessInfo.ProcessConnections.Connections) {
+		return "-", true
+	}
+	if pointee == any(&o.ProcessInfo.ProcessConnections.ConnectionCount) {
+		return "CONNECTION_COUNT", true
+	}
+	if pointee == any(&o.ProcessInfo.Sections) {
+		return "-", true
+	}
+	if label, ok := o.ProcessInfo.Sections.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "-"), true
+	}
+	if pointee == any(o.BeaconConfig) {
+		return "BEACON", true
+	}
+	if pointee == any(&o.BeaconConfig) {
+		return "BEACON", true
+	}
+	if o.BeaconConfig != nil {
+		if pointee == any(&o.BeaconConfig.Type) {
+			return "BEACON_TYPE", true
+		}
+		if pointee == any(&o.BeaconConfig.C2) {
+			return "BEACON_C2", true
+		}
+		if pointee == any(&o.BeaconConfig.Port) {
+			return "BEACON_PORT", true
+		}
+		if pointee == any(&o.BeaconConfig.SpawnTo) {
+			return "BEACON_SPAWNTO", true
+		}
+		if pointee == any(&o.BeaconConfig.InjectionProcess) {
+			return "BEACON_INJECTION_PROCESS", true
+		}
+		if pointee == any(&o.BeaconConfig.Pipename) {
+			return "BEACON_PIPENAME", true
+		}
+		if pointee == any(&o.BeaconConfig.UserAgent) {
+			return "BEACON_USER_AGENT", true
+		}
+		if pointee == any(&o.BeaconConfig.Proxy) {
+			return "BEACON_PROXY", true
+		}
+		if pointee == any(&o.BeaconConfig.FullConfig) {
+			return "BEACON_-", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters) {
+			return "BEACON_CIPHER_PARAMETERS", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XafEncoded) {
+			return "BEACON_CIPHER_PARAMETERS_XAF_ENCODED", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XafEncodingAnchor) {
+			return "BEACON_CIPHER_PARAMETERS_XAF_ENCODING_ANCHOR", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.XorKey) {
+			return "BEACON_CIPHER_PARAMETERS_XOR_KEY", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BeaconOffset) {
+			return "BEACON_CIPHER_PARAMETERS_BEACON_OFFSET", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BeaconLength) {
+			return "BEACON_CIPHER_PARAMETERS_BEACON_LENGTH", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.BlockStart) {
+			return "BEACON_CIPHER_PARAMETERS_BLOCK_START", true
+		}
+		if pointee == any(&o.BeaconConfig.CipherParameters.PairwiseSwapped) {
+			return "BEACON_CIPHER_PARAMETERS_PAIRWISE_SWAPPED", true
+		}
+	}
+	if pointee == any(o.PeSieveReport) {
+		return "PE_SIEVE", true
+	}
+	if pointee == any(&o.PeSieveReport) {
+		return "PE_SIEVE", true
+	}
+	if o.PeSieveReport != nil {
+		if pointee == any(&o.PeSieveReport.SuspiciousSections) {
+			return "PE_SIEVE_SUSPICIOUS_SECTIONS", true
+		}
+		if pointee == any(&o.PeSieveReport.Replaced) {
+			return "PE_SIEVE_REPLACED", true
+		}
+		if pointee == any(&o.PeSieveReport.HdrMod) {
+			return "PE_SIEVE_HDR_MOD", true
+		}
+		if pointee == any(&o.PeSieveReport.UnreachableFile) {
+			return "PE_SIEVE_UNREACHABLE_FILE", true
+		}
+		if pointee == any(&o.PeSieveReport.Patched) {
+			return "PE_SIEVE_PATCHED", true
+		}
+		if pointee == any(&o.PeSieveReport.IatHooked) {
+			return "PE_SIEVE_IAT_HOOKED", true
+		}
+		if pointee == any(&o.PeSieveReport.Implanted) {
+			return "PE_SIEVE_IMPLANTED", true
+		}
+		if pointee == any(&o.PeSieveReport.Other) {
+			return "PE_SIEVE_OTHER", true
+		}
+		if pointee == any(&o.PeSieveReport.Skipped) {
+			return "PE_SIEVE_SKIPPED", true
+		}
+		if pointee == any(&o.PeSieveReport.Errors) {
+			return "PE_SIEVE_ERRORS", true
+		}
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *ProcessConnectionObject) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"status":`...)
+	buf = appendJSONString(buf, o.ProcessConnection.Status)
+	buf = append(buf, `,"ip":`...)
+	buf = appendJSONString(buf, o.ProcessConnection.Ip)
+	buf = append(buf, `,"port":`...)
+	buf = strconv.AppendUint(buf, uint64(o.ProcessConnection.Port), 10)
+	if len(o.ProcessConnection.RemoteIp) != 0 {
+		buf = append(buf, `,"remote_ip":`...)
+		buf = appendJSONString(buf, o.ProcessConnection.RemoteIp)
+	}
+	if o.ProcessConnection.RemotePort != 0 {
+		buf = append(buf, `,"remote_port":`...)
+		buf = strconv.AppendUint(buf, uint64(o.ProcessConnection.RemotePort), 10)
+	}
+	if len(o.ProcessConnection.Protocol) != 0 {
+		buf = append(buf, `,"protocol":`...)
+		buf = appendJSONString(buf, o.ProcessConnection.Protocol)
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *ProcessConnectionObject) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ProcessConnection) {
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ProcessConnection.Ip) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "IP", Value: t.FormatField(o.ProcessConnection.Ip, textlogModifiersNone)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ProcessConnection.Port) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "PORT", Value: t.FormatField(o.ProcessConnection.Port, textlogModifiersNone)})
+		}
+		if !(o.ProcessConnection.RemoteIp == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.ProcessConnection.RemoteIp)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "RIP", Value: t.FormatField(o.ProcessConnection.RemoteIp, textlogModifiersOmitempty)})
+		}
+		if !(o.ProcessConnection.RemotePort == 0) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.ProcessConnection.RemotePort)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "RPORT", Value: t.FormatField(o.ProcessConnection.RemotePort, textlogModifiersOmitempty)})
+		}
+		if !(o.ProcessConnection.Protocol == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.ProcessConnection.Protocol)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "PROTOCOL", Value: t.FormatField(o.ProcessConnection.Protocol, textlogModifiersOmitempty)})
+		}
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *ProcessConnectionObject) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.ProcessConnection) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ProcessConnection.Fd) {
+		return jsonpointer.Pointer{"-"}
+	}
+	if pointee == any(&o.ProcessConnection.Status) {
+		return jsonpointer.Pointer{"status"}
+	}
+	if pointee == any(&o.ProcessConnection.Ip) {
+		return jsonpointer.Pointer{"ip"}
+	}
+	if pointee == any(&o.ProcessConnection.Port) {
+		return jsonpointer.Pointer{"port"}
+	}
+	if pointee == any(&o.ProcessConnection.RemoteIp) {
+		return jsonpointer.Pointer{"remote_ip"}
+	}
+	if pointee == any(&o.ProcessConnection.RemotePort) {
+		return jsonpointer.Pointer{"remote_port"}
+	}
+	if pointee == any(&o.ProcessConnection.Protocol) {
+		return jsonpointer.Pointer{"protocol"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *ProcessConnectionObject) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.ProcessConnection) {
+		return "", true
+	}
+	if pointee == any(&o.ProcessConnection.Fd) {
+		return "-", true
+	}
+	if pointee == any(&o.ProcessConnection.Status) {
+		return "-", true
+	}
+	if pointee == any(&o.ProcessConnection.Ip) {
+		return "IP", true
+	}
+	if pointee == any(&o.ProcessConnection.Port) {
+		return "PORT", true
+	}
+	if pointee == any(&o.ProcessConnection.RemoteIp) {
+		return "RIP", true
+	}
+	if pointee == any(&o.ProcessConnection.RemotePort) {
+		return "RPORT", true
+	}
+	if pointee == any(&o.ProcessConnection.Protocol) {
+		return "PROTOCOL", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *ProcessHandle) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"name":`...)
+	buf = appendJSONString(buf, o.Name)
+	buf = append(buf, `,"handle":`...)
+	buf = strconv.AppendUint(buf, o.Handle, 10)
+	if len(o.Type) != 0 {
+		buf = append(buf, `,"handle_type":`...)
+		buf = appendJSONString(buf, o.Type)
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *ProcessHandle) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Name) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NAME", Value: t.FormatField(o.Name, textlogModifiersNone)})
+	}
+	if !(o.Handle == 0) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Handle)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "HANDLE", Value: t.FormatField(o.Handle, textlogModifiersOmitempty)})
+	}
+	if !(o.Type == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Type)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TYPE", Value: t.FormatField(o.Type, textlogModifiersOmitempty)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *ProcessHandle) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Name) {
+		return jsonpointer.Pointer{"name"}
+	}
+	if pointee == any(&o.Handle) {
+		return jsonpointer.Pointer{"handle"}
+	}
+	if pointee == any(&o.Type) {
+		return jsonpointer.Pointer{"handle_type"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *ProcessHandle) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Name) {
+		return "NAME", true
+	}
+	if pointee == any(&o.Handle) {
+		return "HANDLE", true
+	}
+	if pointee == any(&o.Type) {
+		return "TYPE", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *ProfileFolder) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"user":`...)
+	buf = appendJSONString(buf, o.User)
+	buf = append(buf, `,"modified":`...)
+	if buf, err = appendJSONTime(buf, o.Modified); err != nil {
+		return nil, err
+	}
+	if o.Created != nil {
+		buf = append(buf, `,"created":`...)
+		if buf, err = appendJSONValue(buf, &o.Created); err != nil {
+			return nil, err
+		}
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *ProfileFolder) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.User) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "USER", Value: t.FormatField(o.User, textlogModifiersNone)})
+	}
+	if !(isComparableZero(o.Modified) || o.Modified.IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Modified)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "MODIFIED", Value: t.FormatField(o.Modified, textlogModifiersOmitempty)})
+	}
+	if !(o.Created == nil || o.Created.IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Created)) {
+		var value0 any = o.Created
+		if o.Created != nil {
+			value0 = (*o.Created)
+		}
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "CREATED", Value: t.FormatField(value0, textlogModifiersOmitempty)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *ProfileFolder) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.User) {
+		return jsonpointer.Pointer{"user"}
+	}
+	if pointee == any(&o.Modified) {
+		return jsonpointer.Pointer{"modified"}
+	}
+	if pointee == any(&o.Created) {
+		return jsonpointer.Pointer{"created"}
+	}
+	if pointee == any(o.Created) {
+		return jsonpointer.Pointer{"created"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *ProfileFolder) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.User) {
+		return "USER", true
+	}
+	if pointee == any(&o.Modified) {
+		return "MODIFIED", true
+	}
+	if pointee == any(o.Created) {
+		return "CREATED", true
+	}
+	if pointee == any(&o.Created) {
+		return "CREATED", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *QuarantineEvent) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"id":`...)
+	buf = appendJSONString(buf, o.Id)
+	buf = append(buf, `,"timestamp":`...)
+	if buf, err = appendJSONTime(buf, o.Timestamp); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"name":`...)
+	buf = appendJSONString(buf, o.Name)
+	buf = append(buf, `,"event_type":`...)
+	buf = appendJSONString(buf, o.Type)
+	buf = append(buf, `,"url":`...)
+	buf = appendJSONString(buf, o.Url)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *QuarantineEvent) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Id) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ID", Value: t.FormatField(o.Id, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Timestamp) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TIMESTAMP", Value: t.FormatField(o.Timestamp, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Name) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NAME", Value: t.FormatField(o.Name, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Type) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TYPE", Value: t.FormatField(o.Type, textlogModifiersNone)})
+	}
+	if !(o.Url == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Url)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "URL", Value: t.FormatField(o.Url, textlogModifiersOmitempty)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *QuarantineEvent) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Id) {
+		return jsonpointer.Pointer{"id"}
+	}
+	if pointee == any(&o.Timestamp) {
+		return jsonpointer.Pointer{"timestamp"}
+	}
+	if pointee == any(&o.Name) {
+		return jsonpointer.Pointer{"name"}
+	}
+	if pointee == any(&o.Type) {
+		return jsonpointer.Pointer{"event_type"}
+	}
+	if pointee == any(&o.Url) {
+		return jsonpointer.Pointer{"url"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *QuarantineEvent) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Id) {
+		return "ID", true
+	}
+	if pointee == any(&o.Timestamp) {
+		return "TIMESTAMP", true
+	}
+	if pointee == any(&o.Name) {
+		return "NAME", true
+	}
+	if pointee == any(&o.Type) {
+		return "TYPE", true
+	}
+	if pointee == any(&o.Url) {
+		return "URL", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *RawFirewallRule) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"rule":`...)
+	buf = appendJSONString(buf, o.Rule)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *RawFirewallRule) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Rule) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "RULE", Value: t.FormatField(o.Rule, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *RawFirewallRule) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Rule) {
+		return jsonpointer.Pointer{"rule"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *RawFirewallRule) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Rule) {
+		return "RULE", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *Reason) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"summary":`...)
+	buf = appendJSONString(buf, o.Summary)
+	buf = append(buf, `,"signature":`...)
+	start1 := len(buf)
+	buf = append(buf, `,"score":`...)
+	buf = strconv.AppendInt(buf, o.Signature.Score, 10)
+	buf = append(buf, `,"reference":`...)
+	if o.Signature.Ref == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i2 := range o.Signature.Ref {
+			if i2 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.Signature.Ref[i2])
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"origin":`...)
+	if buf, err = appendJSONValue(buf, &o.Signature.Type); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"kind":`...)
+	buf = appendJSONString(buf, string(o.Signature.Class))
+	if len(o.Signature.Date) != 0 {
+		buf = append(buf, `,"date":`...)
+		buf = appendJSONString(buf, o.Signature.Date)
+	}
+	if len(o.Signature.Tags) != 0 {
+		buf = append(buf, `,"tags":`...)
+		if o.Signature.Tags == nil {
+			buf = append(buf, "null"...)
+		} else {
+			buf = append(buf, '[')
+			for i3 := range o.Signature.Tags {
+				if i3 > 0 {
+					buf = append(buf, ',')
+				}
+				buf = appendJSONString(buf, o.Signature.Tags[i3])
+			}
+			buf = append(buf, ']')
+		}
+	}
+	if len(o.Signature.Rulename) != 0 {
+		buf = append(buf, `,"rule_name":`...)
+		buf = appendJSONString(buf, o.Signature.Rulename)
+	}
+	if len(o.Signature.LongDescription) != 0 {
+		buf = append(buf, `,"description":`...)
+		buf = appendJSONString(buf, o.Signature.LongDescription)
+	}
+	if len(o.Signature.Author) != 0 {
+		buf = append(buf, `,"author":`...)
+		buf = appendJSONString(buf, o.Signature.Author)
+	}
+	if len(o.Signature.RuleId) != 0 {
+		buf = append(buf, `,"id":`...)
+		buf = appendJSONString(buf, o.Signature.RuleId)
+	}
+	if len(o.Signature.FalsePositives) != 0 {
+		buf = append(buf, `,"false_positives":`...)
+		if o.Signature.FalsePositives == nil {
+			buf = append(buf, "null"...)
+		} else {
+			buf = append(buf, '[')
+			for i4 := range o.Signature.FalsePositives {
+				if i4 > 0 {
+					buf = append(buf, ',')
+				}
+				buf = appendJSONString(buf, o.Signature.FalsePositives[i4])
+			}
+			buf = append(buf, ']')
+		}
+	}
+	buf = closeJSONObject(buf, start1)
+	buf = append(buf, `,"matched":`...)
+	if o.StringMatches == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i5 := range o.StringMatches {
+			if i5 > 0 {
+				buf = append(buf, ',')
+			}
+			start6 := len(buf)
+			buf = append(buf, `,"data":`...)
+			start7 := len(buf)
+			buf = append(buf, `,"data":`...)
+			buf = appendJSONString(buf, o.StringMatches[i5].Match.EncodedData)
+			buf = append(buf, `,"encoding":`...)
+			buf = appendJSONString(buf, string(o.StringMatches[i5].Match.Encoding))
+			buf = closeJSONObject(buf, start7)
+			if o.StringMatches[i5].Context != nil {
+				buf = append(buf, `,"context":`...)
+				if o.StringMatches[i5].Context == nil {
+					buf = append(buf, "null"...)
+				} else {
+					start8 := len(buf)
+					buf = append(buf, `,"data":`...)
+					buf = appendJSONString(buf, o.StringMatches[i5].Context.EncodedData)
+					buf = append(buf, `,"encoding":`...)
+					buf = appendJSONString(buf, string(o.StringMatches[i5].Context.Encoding))
+					buf = closeJSONObject(buf, start8)
+				}
+			}
+			if o.StringMatches[i5].Offset != nil {
+				buf = append(buf, `,"offset":`...)
+				if o.StringMatches[i5].Offset == nil {
+					buf = append(buf, "null"...)
+				} else {
+					buf = strconv.AppendUint(buf, (*o.StringMatches[i5].Offset), 10)
+				}
+			}
+			if o.StringMatches[i5].Field != nil {
+				buf = append(buf, `,"field":`...)
+				if buf, err = appendJSONValue(buf, &o.StringMatches[i5].Field); err != nil {
+					return nil, err
+				}
+			}
+			buf = closeJSONObject(buf, start6)
+		}
+		buf = append(buf, ']')
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *Reason) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Summary) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "REASON", Value: t.FormatField(o.Summary, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersInline, o.Signature) {
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Signature.Score) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "SUBSCORE", Value: t.FormatField(o.Signature.Score, textlogModifiersNone)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Signature.Ref) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "REF", Value: t.FormatField(o.Signature.Ref, textlogModifiersNone)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Signature.Type) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "SIGTYPE", Value: t.FormatField(o.Signature.Type, textlogModifiersNone)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Signature.Class) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "SIGCLASS", Value: t.FormatField(o.Signature.Class, textlogModifiersNone)})
+		}
+		if !(o.Signature.Date == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Signature.Date)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "RULEDATE", Value: t.FormatField(o.Signature.Date, textlogModifiersOmitempty)})
+		}
+		if !(o.Signature.Tags == nil) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Signature.Tags)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "TAGS", Value: t.FormatField(o.Signature.Tags, textlogModifiersOmitempty)})
+		}
+		if !(o.Signature.Rulename == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Signature.Rulename)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "RULENAME", Value: t.FormatField(o.Signature.Rulename, textlogModifiersOmitempty)})
+		}
+		if !(o.Signature.LongDescription == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Signature.LongDescription)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "DESCRIPTION", Value: t.FormatField(o.Signature.LongDescription, textlogModifiersOmitempty)})
+		}
+		if !(o.Signature.Author == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Signature.Author)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "AUTHOR", Value: t.FormatField(o.Signature.Author, textlogModifiersOmitempty)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Signature.RuleId) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "ID", Value: t.FormatField(o.Signature.RuleId, textlogModifiersNone)})
+		}
+		if !(o.Signature.FalsePositives == nil) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Signature.FalsePositives)) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "FALSEPOSITIVES", Value: t.FormatField(o.Signature.FalsePositives, textlogModifiersOmitempty)})
+		}
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.StringMatches) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "MATCHED", Value: t.FormatField(o.StringMatches, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *Reason) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Summary) {
+		return jsonpointer.Pointer{"summary"}
+	}
+	if pointee == any(&o.Signature) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.Signature.Score) {
+		return jsonpointer.Pointer{"score"}
+	}
+	if pointee == any(&o.Signature.Ref) {
+		return jsonpointer.Pointer{"reference"}
+	}
+	if p := o.Signature.Ref.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"reference"}, p...)
+	}
+	if pointee == any(&o.Signature.Type) {
+		return jsonpointer.Pointer{"origin"}
+	}
+	if pointee == any(&o.Signature.Class) {
+		return jsonpointer.Pointer{"kind"}
+	}
+	if pointee == any(&o.Signature.Date) {
+		return jsonpointer.Pointer{"date"}
+	}
+	if pointee == any(&o.Signature.Tags) {
+		return jsonpointer.Pointer{"tags"}
+	}
+	if p := o.Signature.Tags.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"tags"}, p...)
+	}
+	if pointee == any(&o.Signature.Rulename) {
+		return jsonpointer.Pointer{"rule_name"}
+	}
+	if pointee == any(&o.Signature.LongDescription) {
+		return jsonpointer.Pointer{"description"}
+	}
+	if pointee == any(&o.Signature.Author) {
+		return jsonpointer.Pointer{"author"}
+	}
+	if pointee == any(&o.Signature.RuleId) {
+		return jsonpointer.Pointer{"id"}
+	}
+	if pointee == any(&o.Signature.FalsePositives) {
+		return jsonpointer.Pointer{"false_positives"}
+	}
+	if p := o.Signature.FalsePositives.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"false_positives"}, p...)
+	}
+	if pointee == any(&o.StringMatches) {
+		return jsonpointer.Pointer{"matched"}
+	}
+	for i0 := range o.StringMatches {
+		if pointee == any(&o.StringMatches[i0]) {
+			return jsonpointer.Pointer{"matched", strconv.Itoa(i0)}
+		}
+		if pointee == any(&o.StringMatches[i0].Match) {
+			return jsonpointer.Pointer{"matched", strconv.Itoa(i0), "data"}
+		}
+		if pointee == any(&o.StringMatches[i0].Match.EncodedData) {
+			return jsonpointer.Pointer{"matched", strconv.Itoa(i0), "data", "data"}
+		}
+		if pointee == any(&o.StringMatches[i0].Match.Encoding) {
+			return jsonpointer.Pointer{"matched", strconv.Itoa(i0), "data", "encoding"}
+		}
+		if pointee == any(&o.StringMatches[i0].Context) {
+			return jsonpointer.Pointer{"matched", strconv.Itoa(i0), "context"}
+		}
+		if pointee == any(o.StringMatches[i0].Context) {
+			return jsonpointer.Pointer{"matched", strconv.Itoa(i0), "context"}
+		}
+		if o.StringMatches[i0].Context != nil {
+			if pointee == any(&o.StringMatches[i0].Context.EncodedData) {
+				return jsonpointer.Pointer{"matched", strconv.Itoa(i0), "context", "data"}
+			}
+			if pointee == any(&o.StringMatches[i0].Context.Encoding) {
+				return jsonpointer.Pointer{"matched", strconv.Itoa(i0), "context", "encoding"}
+			}
+		}
+		if pointee == any(&o.StringMatches[i0].Offset) {
+			return jsonpointer.Pointer{"matched", strconv.Itoa(i0), "offset"}
+		}
+		if pointee == any(o.StringMatches[i0].Offset) {
+			return jsonpointer.Pointer{"matched", strconv.Itoa(i0), "offset"}
+		}
+		if pointee == any(&o.StringMatches[i0].Field) {
+			return jsonpointer.Pointer{"matched", strconv.Itoa(i0), "field"}
+		}
+		if pointee == any(o.StringMatches[i0].Field) {
+			return jsonpointer.Pointer{"matched", strconv.Itoa(i0), "field"}
+		}
+		if pointee == any(&o.StringMatches[i0].HideOffset) {
+			return jsonpointer.Pointer{"matched", strconv.Itoa(i0), "-"}
+		}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *Reason) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Summary) {
+		return "REASON", true
+	}
+	if pointee == any(&o.Signature) {
+		return "", true
+	}
+	if pointee == any(&o.Signature.Score) {
+		return "SUBSCORE", true
+	}
+	if pointee == any(&o.Signature.Ref) {
+		return "REF", true
+	}
+	if label, ok := o.Signature.Ref.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "REF"), true
+	}
+	if pointee == any(&o.Signature.Type) {
+		return "SIGTYPE", true
+	}
+	if pointee == any(&o.Signature.Class) {
+		return "SIGCLASS", true
+	}
+	if pointee == any(&o.Signature.Date) {
+		return "RULEDATE", true
+	}
+	if pointee == any(&o.Signature.Tags) {
+		return "TAGS", true
+	}
+	if label, ok := o.Signature.Tags.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "TAGS"), true
+	}
+	if pointee == any(&o.Signature.Rulename) {
+		return "RULENAME", true
+	}
+	if pointee == any(&o.Signature.LongDescription) {
+		return "DESCRIPTION", true
+	}
+	if pointee == any(&o.Signature.Author) {
+		return "AUTHOR", true
+	}
+	if pointee == any(&o.Signature.RuleId) {
+		return "ID", true
+	}
+	if pointee == any(&o.Signature.FalsePositives) {
+		return "FALSEPOSITIVES", true
+	}
+	if label, ok := o.Signature.FalsePositives.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "FALSEPOSITIVES"), true
+	}
+	if pointee == any(&o.StringMatches) {
+		return "MATCHED", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *RegisteredDebugger) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"executable":`...)
+	buf = appendJSONString(buf, o.Executable)
+	buf = append(buf, `,"debugger":`...)
+	buf = appendJSONString(buf, o.Debugger)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *RegisteredDebugger) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Executable) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FILE", Value: t.FormatField(o.Executable, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Debugger) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ELEMENT", Value: t.FormatField(o.Debugger, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *RegisteredDebugger) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Executable) {
+		return jsonpointer.Pointer{"executable"}
+	}
+	if pointee == any(&o.Debugger) {
+		return jsonpointer.Pointer{"debugger"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *RegisteredDebugger) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Executable) {
+		return "FILE", true
+	}
+	if pointee == any(&o.Debugger) {
+		return "ELEMENT", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *RegistryKey) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"key":`...)
+	buf = appendJSONString(buf, o.Key)
+	buf = append(buf, `,"modified":`...)
+	if buf, err = appendJSONTime(buf, o.Modified); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"values":`...)
+	buf = appendJSONString(buf, o.FormattedValues)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *RegistryKey) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Key) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "KEY", Value: t.FormatField(o.Key, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Modified) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "MODIFIED", Value: t.FormatField(o.Modified, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersOmitincontext, o.FormattedValues) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "VALUES", Value: t.FormatField(o.FormattedValues, textlogModifiersOmitincontext)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *RegistryKey) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Key) {
+		return jsonpointer.Pointer{"key"}
+	}
+	if pointee == any(&o.Modified) {
+		return jsonpointer.Pointer{"modified"}
+	}
+	if pointee == any(&o.FormattedValues) {
+		return jsonpointer.Pointer{"values"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *RegistryKey) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Key) {
+		return "KEY", true
+	}
+	if pointee == any(&o.Modified) {
+		return "MODIFIED", true
+	}
+	if pointee == any(&o.FormattedValues) {
+		return "VALUES", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *RegistryScheduledTask) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"guid":`...)
+	buf = appendJSONString(buf, o.Guid)
+	buf = append(buf, `,"path":`...)
+	buf = appendJSONString(buf, o.Path)
+	buf = append(buf, `,"version":`...)
+	buf = strconv.AppendInt(buf, int64(o.Version), 10)
+	buf = append(buf, `,"created":`...)
+	if buf, err = appendJSONTime(buf, o.Created); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"last_run":`...)
+	if buf, err = appendJSONTime(buf, o.LastRun); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"last_stopped":`...)
+	if buf, err = appendJSONTime(buf, o.LastStopped); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"status":`...)
+	buf = appendJSONString(buf, o.Status)
+	buf = append(buf, `,"last_result":`...)
+	buf = appendJSONString(buf, o.LastResult)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *RegistryScheduledTask) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Guid) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "GUID", Value: t.FormatField(o.Guid, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Path) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PATH", Value: t.FormatField(o.Path, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Version) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "VERSION", Value: t.FormatField(o.Version, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Created) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "CREATED", Value: t.FormatField(o.Created, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LastRun) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LAST_RUN", Value: t.FormatField(o.LastRun, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LastStopped) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LAST_STOPPED", Value: t.FormatField(o.LastStopped, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Status) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "STATUS", Value: t.FormatField(o.Status, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LastResult) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LAST_RESULT", Value: t.FormatField(o.LastResult, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *RegistryScheduledTask) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Guid) {
+		return jsonpointer.Pointer{"guid"}
+	}
+	if pointee == any(&o.Path) {
+		return jsonpointer.Pointer{"path"}
+	}
+	if pointee == any(&o.Version) {
+		return jsonpointer.Pointer{"version"}
+	}
+	if pointee == any(&o.Created) {
+		return jsonpointer.Pointer{"created"}
+	}
+	if pointee == any(&o.LastRun) {
+		return jsonpointer.Pointer{"last_run"}
+	}
+	if pointee == any(&o.LastStopped) {
+		return jsonpointer.Pointer{"last_stopped"}
+	}
+	if pointee == any(&o.Status) {
+		return jsonpointer.Pointer{"status"}
+	}
+	if pointee == any(&o.LastResult) {
+		return jsonpointer.Pointer{"last_result"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *RegistryScheduledTask) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Guid) {
+		return "GUID", true
+	}
+	if pointee == any(&o.Path) {
+		return "PATH", true
+	}
+	if pointee == any(&o.Version) {
+		return "VERSION", true
+	}
+	if pointee == any(&o.Created) {
+		return "CREATED", true
+	}
+	if pointee == any(&o.LastRun) {
+		return "LAST_RUN", true
+	}
+	if pointee == any(&o.LastStopped) {
+		return "LAST_STOPPED", true
+	}
+	if pointee == any(&o.Status) {
+		return "STATUS", true
+	}
+	if pointee == any(&o.LastResult) {
+		return "LAST_RESULT", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *RegistryValue) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"key":`...)
+	buf = appendJSONString(buf, o.Key)
+	buf = append(buf, `,"modified":`...)
+	if buf, err = appendJSONTime(buf, o.Modified); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"value":`...)
+	buf = appendJSONString(buf, o.ParsedValue)
+	buf = append(buf, `,"size":`...)
+	buf = strconv.AppendUint(buf, o.Size, 10)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *RegistryValue) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Key) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "KEY", Value: t.FormatField(o.Key, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Modified) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "MODIFIED", Value: t.FormatField(o.Modified, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersOmitincontext, o.ParsedValue) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "VALUE", Value: t.FormatField(o.ParsedValue, textlogModifiersOmitincontext)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Size) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SIZE", Value: t.FormatField(o.Size, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *RegistryValue) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Key) {
+		return jsonpointer.Pointer{"key"}
+	}
+	if pointee == any(&o.Modified) {
+		return jsonpointer.Pointer{"modified"}
+	}
+	if pointee == any(&o.ParsedValue) {
+		return jsonpointer.Pointer{"value"}
+	}
+	if pointee == any(&o.Size) {
+		return jsonpointer.Pointer{"size"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *RegistryValue) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Key) {
+		return "KEY", true
+	}
+	if pointee == any(&o.Modified) {
+		return "MODIFIED", true
+	}
+	if pointee == any(&o.ParsedValue) {
+		return "VALUE", true
+	}
+	if pointee == any(&o.Size) {
+		return "SIZE", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *Rootkit) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *Rootkit) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *Rootkit) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *Rootkit) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *SRUMResourceUsageEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"timestamp":`...)
+	if buf, err = appendJSONTime(buf, o.TimeStamp); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"app_info":`...)
+	buf = appendJSONString(buf, o.AppInfo)
+	buf = append(buf, `,"user_sid":`...)
+	buf = appendJSONString(buf, o.UserSID)
+	if len(o.UserName) != 0 {
+		buf = append(buf, `,"user_name":`...)
+		buf = appendJSONString(buf, o.UserName)
+	}
+	buf = append(buf, `,"face_time":`...)
+	buf = strconv.AppendInt(buf, int64(o.FaceTime), 10)
+	buf = append(buf, `,"foreground_bytes_read":`...)
+	buf = strconv.AppendUint(buf, o.ForegroundBytesRead, 10)
+	buf = append(buf, `,"foreground_bytes_written":`...)
+	buf = strconv.AppendUint(buf, o.ForegroundBytesWritten, 10)
+	buf = append(buf, `,"foreground_num_read_operations":`...)
+	buf = strconv.AppendUint(buf, o.ForegroundNumReadOperations, 10)
+	buf = append(buf, `,"foreground_num_write_operations":`...)
+	buf = strconv.AppendUint(buf, o.ForegroundNumWriteOperations, 10)
+	buf = append(buf, `,"background_bytes_read":`...)
+	buf = strconv.AppendUint(buf, o.BackgroundBytesRead, 10)
+	buf = append(buf, `,"background_bytes_written":`...)
+	buf = strconv.AppendUint(buf, o.BackgroundBytesWritten, 10)
+	buf = append(buf, `,"background_num_read_operations":`...)
+	buf = strconv.AppendUint(buf, o.BackgroundNumReadOperations, 10)
+	buf = append(buf, `,"background_num_write_operations":`...)
+	buf = strconv.AppendUint(buf, o.BackgroundNumWriteOperations, 10)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *SRUMResourceUsageEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.TimeStamp) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TIMESTAMP", Value: t.FormatField(o.TimeStamp, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.AppInfo) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "APP_INFO", Value: t.FormatField(o.AppInfo, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.UserSID) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "USER_SID", Value: t.FormatField(o.UserSID, textlogModifiersNone)})
+	}
+	if !(o.UserName == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.UserName)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "USER_NAME", Value: t.FormatField(o.UserName, textlogModifiersOmitempty)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.FaceTime) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FACE_TIME", Value: t.FormatField(o.FaceTime, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ForegroundBytesRead) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FOREGROUND_BYTES_READ", Value: t.FormatField(o.ForegroundBytesRead, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ForegroundBytesWritten) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FOREGROUND_BYTES_WRITTEN", Value: t.FormatField(o.ForegroundBytesWritten, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ForegroundNumReadOperations) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FOREGROUND_NUM_READ_OPERATIONS", Value: t.FormatField(o.ForegroundNumReadOperations, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ForegroundNumWriteOperations) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FOREGROUND_NUM_WRITE_OPERATIONS", Value: t.FormatField(o.ForegroundNumWriteOperations, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BackgroundBytesRead) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "BACKGROUND_BYTES_READ", Value: t.FormatField(o.BackgroundBytesRead, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BackgroundBytesWritten) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "BACKGROUND_BYTES_WRITTEN", Value: t.FormatField(o.BackgroundBytesWritten, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BackgroundNumReadOperations) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "BACKGROUND_NUM_READ_OPERATIONS", Value: t.FormatField(o.BackgroundNumReadOperations, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BackgroundNumWriteOperations) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "BACKGROUND_NUM_WRITE_OPERATIONS", Value: t.FormatField(o.BackgroundNumWriteOperations, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *SRUMResourceUsageEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.TimeStamp) {
+		return jsonpointer.Pointer{"timestamp"}
+	}
+	if pointee == any(&o.AppInfo) {
+		return jsonpointer.Pointer{"app_info"}
+	}
+	if pointee == any(&o.UserSID) {
+		return jsonpointer.Pointer{"user_sid"}
+	}
+	if pointee == any(&o.UserName) {
+		return jsonpointer.Pointer{"user_name"}
+	}
+	if pointee == any(&o.FaceTime) {
+		return jsonpointer.Pointer{"face_time"}
+	}
+	if pointee == any(&o.ForegroundBytesRead) {
+		return jsonpointer.Pointer{"foreground_bytes_read"}
+	}
+	if pointee == any(&o.ForegroundBytesWritten) {
+		return jsonpointer.Pointer{"foreground_bytes_written"}
+	}
+	if pointee == any(&o.ForegroundNumReadOperations) {
+		return jsonpointer.Pointer{"foreground_num_read_operations"}
+	}
+	if pointee == any(&o.ForegroundNumWriteOperations) {
+		return jsonpointer.Pointer{"foreground_num_write_operations"}
+	}
+	if pointee == any(&o.BackgroundBytesRead) {
+		return jsonpointer.Pointer{"background_bytes_read"}
+	}
+	if pointee == any(&o.BackgroundBytesWritten) {
+		return jsonpointer.Pointer{"background_bytes_written"}
+	}
+	if pointee == any(&o.BackgroundNumReadOperations) {
+		return jsonpointer.Pointer{"background_num_read_operations"}
+	}
+	if pointee == any(&o.BackgroundNumWriteOperations) {
+		return jsonpointer.Pointer{"background_num_write_operations"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *SRUMResourceUsageEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.TimeStamp) {
+		return "TIMESTAMP", true
+	}
+	if pointee == any(&o.AppInfo) {
+		return "APP_INFO", true
+	}
+	if pointee == any(&o.UserSID) {
+		return "USER_SID", true
+	}
+	if pointee == any(&o.UserName) {
+		return "USER_NAME", true
+	}
+	if pointee == any(&o.FaceTime) {
+		return "FACE_TIME", true
+	}
+	if pointee == any(&o.ForegroundBytesRead) {
+		return "FOREGROUND_BYTES_READ", true
+	}
+	if pointee == any(&o.ForegroundBytesWritten) {
+		return "FOREGROUND_BYTES_WRITTEN", true
+	}
+	if pointee == any(&o.ForegroundNumReadOperations) {
+		return "FOREGROUND_NUM_READ_OPERATIONS", true
+	}
+	if pointee == any(&o.ForegroundNumWriteOperations) {
+		return "FOREGROUND_NUM_WRITE_OPERATIONS", true
+	}
+	if pointee == any(&o.BackgroundBytesRead) {
+		return "BACKGROUND_BYTES_READ", true
+	}
+	if pointee == any(&o.BackgroundBytesWritten) {
+		return "BACKGROUND_BYTES_WRITTEN", true
+	}
+	if pointee == any(&o.BackgroundNumReadOperations) {
+		return "BACKGROUND_NUM_READ_OPERATIONS", true
+	}
+	if pointee == any(&o.BackgroundNumWriteOperations) {
+		return "BACKGROUND_NUM_WRITE_OPERATIONS", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *ScanInfo) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"versions":`...)
+	start1 := len(buf)
+	buf = append(buf, `,"thor":`...)
+	buf = appendJSONString(buf, o.Versions.Thor)
+	buf = append(buf, `,"build":`...)
+	buf = appendJSONString(buf, o.Versions.Build)
+	buf = append(buf, `,"signatures":`...)
+	buf = appendJSONString(buf, o.Versions.Signatures)
+	buf = append(buf, `,"sigma_rules":`...)
+	buf = appendJSONString(buf, o.Versions.Sigma)
+	buf = closeJSONObject(buf, start1)
+	buf = append(buf, `,"arguments":`...)
+	if o.Arguments == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i2 := range o.Arguments {
+			if i2 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.Arguments[i2])
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"scan_id":`...)
+	buf = appendJSONString(buf, o.ScanID)
+	buf = append(buf, `,"thor_dir":`...)
+	buf = appendJSONString(buf, o.ThorDir)
+	buf = append(buf, `,"user":`...)
+	buf = appendJSONString(buf, o.User)
+	buf = append(buf, `,"elevated":`...)
+	buf = strconv.AppendBool(buf, o.Elevated)
+	buf = append(buf, `,"outputs":`...)
+	if o.Outputs == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i3 := range o.Outputs {
+			if i3 > 0 {
+				buf = append(buf, ',')
+			}
+			start4 := len(buf)
+			buf = append(buf, `,"kind":`...)
+			buf = appendJSONString(buf, o.Outputs[i3].Kind)
+			buf = append(buf, `,"output":`...)
+			buf = appendJSONString(buf, o.Outputs[i3].Output)
+			buf = closeJSONObject(buf, start4)
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"active_modules":`...)
+	if o.ActiveModules == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i5 := range o.ActiveModules {
+			if i5 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.ActiveModules[i5])
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"active_features":`...)
+	if o.ActiveFeatures == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i6 := range o.ActiveFeatures {
+			if i6 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.ActiveFeatures[i6])
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"threads":`...)
+	buf = strconv.AppendInt(buf, int64(o.Threads), 10)
+	buf = append(buf, `,"timeout":`...)
+	buf = strconv.AppendInt(buf, int64(o.Timeout), 10)
+	buf = append(buf, `,"cpu_limit":`...)
+	buf = strconv.AppendInt(buf, int64(o.CPULimit), 10)
+	buf = append(buf, `,"free_memory_limit":`...)
+	buf = strconv.AppendUint(buf, uint64(o.FreeMemoryLimit), 10)
+	buf = append(buf, `,"file_size_limit":`...)
+	buf = strconv.AppendUint(buf, uint64(o.FileSizeLimit), 10)
+	buf = append(buf, `,"license":`...)
+	start7 := len(buf)
+	buf = append(buf, `,"owner":`...)
+	buf = appendJSONString(buf, o.License.Owner)
+	buf = append(buf, `,"license_type":`...)
+	buf = appendJSONString(buf, o.License.Type)
+	buf = append(buf, `,"starts":`...)
+	buf = appendJSONString(buf, o.License.Starts)
+	buf = append(buf, `,"expires":`...)
+	buf = appendJSONString(buf, o.License.Expires)
+	buf = append(buf, `,"scanner":`...)
+	buf = appendJSONString(buf, o.License.Scanner)
+	buf = append(buf, `,"hash":`...)
+	buf = appendJSONString(buf, o.License.Hash)
+	buf = closeJSONObject(buf, start7)
+	buf = append(buf, `,"fp_filters":`...)
+	if o.FpFilters == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i8 := range o.FpFilters {
+			if i8 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.FpFilters[i8])
+		}
+		buf = append(buf, ']')
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *ScanInfo) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.Versions) {
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Versions.Thor) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "VERSION", Value: t.FormatField(o.Versions.Thor, textlogModifiersNone)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Versions.Build) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "BUILD", Value: t.FormatField(o.Versions.Build, textlogModifiersNone)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Versions.Signatures) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "SIGNATURE_VERSION", Value: t.FormatField(o.Versions.Signatures, textlogModifiersNone)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Versions.Sigma) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "SIGMA_VERSION", Value: t.FormatField(o.Versions.Sigma, textlogModifiersNone)})
+		}
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Arguments) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ARGUMENTS", Value: t.FormatField(o.Arguments, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ScanID) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SCAN_ID", Value: t.FormatField(o.ScanID, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ThorDir) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "THOR_DIR", Value: t.FormatField(o.ThorDir, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.User) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "USER", Value: t.FormatField(o.User, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Elevated) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ELEVATED", Value: t.FormatField(o.Elevated, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ActiveModules) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ACTIVE_MODULES", Value: t.FormatField(o.ActiveModules, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ActiveFeatures) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ACTIVE_FEATURES", Value: t.FormatField(o.ActiveFeatures, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Threads) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "THREADS", Value: t.FormatField(o.Threads, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Timeout) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TIMEOUT", Value: t.FormatField(o.Timeout, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.CPULimit) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "CPU_LIMIT", Value: t.FormatField(o.CPULimit, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.FreeMemoryLimit) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FREE_MEMORY_LIMIT", Value: t.FormatField(o.FreeMemoryLimit, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.FileSizeLimit) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FILE_SIZE_LIMIT", Value: t.FormatField(o.FileSizeLimit, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.License) {
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.License.Owner) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "LICENSE_OWNER", Value: t.FormatField(o.License.Owner, textlogModifiersNone)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.License.Type) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "LICENSE_TYPE", Value: t.FormatField(o.License.Type, textlogModifiersNone)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.License.Starts) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "LICENSE_STARTS", Value: t.FormatField(o.License.Starts, textlogModifiersNone)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.License.Expires) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "LICENSE_EXPIRES", Value: t.FormatField(o.License.Expires, textlogModifiersNone)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.License.Scanner) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "LICENSE_SCANNER", Value: t.FormatField(o.License.Scanner, textlogModifiersNone)})
+		}
+		if t.Omit == nil || !t.Omit(textlogModifiersNone, o.License.Hash) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: "LICENSE_HASH", Value: t.FormatField(o.License.Hash, textlogModifiersNone)})
+		}
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *ScanInfo) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Versions) {
+		return jsonpointer.Pointer{"versions"}
+	}
+	if pointee == any(&o.Versions.Thor) {
+		return jsonpointer.Pointer{"versions", "thor"}
+	}
+	if pointee == any(&o.Versions.Build) {
+		return jsonpointer.Pointer{"versions", "build"}
+	}
+	if pointee == any(&o.Versions.Signatures) {
+		return jsonpointer.Pointer{"versions", "signatures"}
+	}
+	if pointee == any(&o.Versions.Sigma) {
+		return jsonpointer.Pointer{"versions", "sigma_rules"}
+	}
+	if pointee == any(&o.Arguments) {
+		return jsonpointer.Pointer{"arguments"}
+	}
+	for i0 := range o.Arguments {
+		if pointee == any(&o.Arguments[i0]) {
+			return jsonpointer.Pointer{"arguments", strconv.Itoa(i0)}
+		}
+	}
+	if pointee == any(&o.ScanID) {
+		return jsonpointer.Pointer{"scan_id"}
+	}
+	if pointee == any(&o.ThorDir) {
+		return jsonpointer.Pointer{"thor_dir"}
+	}
+	if pointee == any(&o.User) {
+		return jsonpointer.Pointer{"user"}
+	}
+	if pointee == any(&o.Elevated) {
+		return jsonpointer.Pointer{"elevated"}
+	}
+	if pointee == any(&o.Outputs) {
+		return jsonpointer.Pointer{"outputs"}
+	}
+	for i1 := range o.Outputs {
+		if pointee == any(&o.Outputs[i1]) {
+			return jsonpointer.Pointer{"outputs", strconv.Itoa(i1)}
+		}
+		if pointee == any(&o.Outputs[i1].Kind) {
+			return jsonpointer.Pointer{"outputs", strconv.Itoa(i1), "kind"}
+		}
+		if pointee == any(&o.Outputs[i1].Output) {
+			return jsonpointer.Pointer{"outputs", strconv.Itoa(i1), "output"}
+		}
+	}
+	if pointee == any(&o.ActiveModules) {
+		return jsonpointer.Pointer{"active_modules"}
+	}
+	if p := o.ActiveModules.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"active_modules"}, p...)
+	}
+	if pointee == any(&o.ActiveFeatures) {
+		return jsonpointer.Pointer{"active_features"}
+	}
+	if p := o.ActiveFeatures.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"active_features"}, p...)
+	}
+	if pointee == any(&o.Threads) {
+		return jsonpointer.Pointer{"threads"}
+	}
+	if pointee == any(&o.Timeout) {
+		return jsonpointer.Pointer{"timeout"}
+	}
+	if pointee == any(&o.CPULimit) {
+		return jsonpointer.Pointer{"cpu_limit"}
+	}
+	if pointee == any(&o.FreeMemoryLimit) {
+		return jsonpointer.Pointer{"free_memory_limit"}
+	}
+	if pointee == any(&o.FileSizeLimit) {
+		return jsonpointer.Pointer{"file_size_limit"}
+	}
+	if pointee == any(&o.License) {
+		return jsonpointer.Pointer{"license"}
+	}
+	if pointee == any(&o.License.Owner) {
+		return jsonpointer.Pointer{"license", "owner"}
+	}
+	if pointee == any(&o.License.Type) {
+		return jsonpointer.Pointer{"license", "license_type"}
+	}
+	if pointee == any(&o.License.Starts) {
+		return jsonpointer.Pointer{"license", "starts"}
+	}
+	if pointee == any(&o.License.Expires) {
+		return jsonpointer.Pointer{"license", "expires"}
+	}
+	if pointee == any(&o.License.Scanner) {
+		return jsonpointer.Pointer{"license", "scanner"}
+	}
+	if pointee == any(&o.License.Hash) {
+		return jsonpointer.Pointer{"license", "hash"}
+	}
+	if pointee == any(&o.FpFilters) {
+		return jsonpointer.Pointer{"fp_filters"}
+	}
+	for i2 := range o.FpFilters {
+		if pointee == any(&o.FpFilters[i2]) {
+			return jsonpointer.Pointer{"fp_filters", strconv.Itoa(i2)}
+		}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *ScanInfo) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Versions) {
+		return "", true
+	}
+	if pointee == any(&o.Versions.Thor) {
+		return "VERSION", true
+	}
+	if pointee == any(&o.Versions.Build) {
+		return "BUILD", true
+	}
+	if pointee == any(&o.Versions.Signatures) {
+		return "SIGNATURE_VERSION", true
+	}
+	if pointee == any(&o.Versions.Sigma) {
+		return "SIGMA_VERSION", true
+	}
+	if pointee == any(&o.Arguments) {
+		return "ARGUMENTS", true
+	}
+	if pointee == any(&o.ScanID) {
+		return "SCAN_ID", true
+	}
+	if pointee == any(&o.ThorDir) {
+		return "THOR_DIR", true
+	}
+	if pointee == any(&o.User) {
+		return "USER", true
+	}
+	if pointee == any(&o.Elevated) {
+		return "ELEVATED", true
+	}
+	if pointee == any(&o.Outputs) {
+		return "", true
+	}
+	if pointee == any(&o.ActiveModules) {
+		return "ACTIVE_MODULES", true
+	}
+	if label, ok := o.ActiveModules.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "ACTIVE_MODULES"), true
+	}
+	if pointee == any(&o.ActiveFeatures) {
+		return "ACTIVE_FEATURES", true
+	}
+	if label, ok := o.ActiveFeatures.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "ACTIVE_FEATURES"), true
+	}
+	if pointee == any(&o.Threads) {
+		return "THREADS", true
+	}
+	if pointee == any(&o.Timeout) {
+		return "TIMEOUT", true
+	}
+	if pointee == any(&o.CPULimit) {
+		return "CPU_LIMIT", true
+	}
+	if pointee == any(&o.FreeMemoryLimit) {
+		return "FREE_MEMORY_LIMIT", true
+	}
+	if pointee == any(&o.FileSizeLimit) {
+		return "FILE_SIZE_LIMIT", true
+	}
+	if pointee == any(&o.License) {
+		return "LICENSE", true
+	}
+	if pointee == any(&o.License.Owner) {
+		return "LICENSE_OWNER", true
+	}
+	if pointee == any(&o.License.Type) {
+		return "LICENSE_TYPE", true
+	}
+	if pointee == any(&o.License.Starts) {
+		return "LICENSE_STARTS", true
+	}
+	if pointee == any(&o.License.Expires) {
+		return "LICENSE_EXPIRES", true
+	}
+	if pointee == any(&o.License.Scanner) {
+		return "LICENSE_SCANNER", true
+	}
+	if pointee == any(&o.License.Hash) {
+		return "LICENSE_HASH", true
+	}
+	if pointee == any(&o.FpFilters) {
+		return "", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *ScheduledTask) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"name":`...)
+	buf = appendJSONString(buf, o.Name)
+	buf = append(buf, `,"path":`...)
+	buf = appendJSONString(buf, o.Path)
+	buf = append(buf, `,"commands":`...)
+	if o.Commands == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i1 := range o.Commands {
+			if i1 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.Commands[i1])
+		}
+		buf = append(buf, ']')
+	}
+	if len(o.ComHandlers) != 0 {
+		buf = append(buf, `,"com_handlers":`...)
+		if o.ComHandlers == nil {
+			buf = append(buf, "null"...)
+		} else {
+			buf = append(buf, '[')
+			for i2 := range o.ComHandlers {
+				if i2 > 0 {
+					buf = append(buf, ',')
+				}
+				buf = appendJSONString(buf, o.ComHandlers[i2])
+			}
+			buf = append(buf, ']')
+		}
+	}
+	buf = append(buf, `,"enabled":`...)
+	buf = strconv.AppendBool(buf, o.Enabled)
+	if len(o.Triggers) != 0 {
+		buf = append(buf, `,"triggers":`...)
+		if o.Triggers == nil {
+			buf = append(buf, "null"...)
+		} else {
+			buf = append(buf, '[')
+			for i3 := range o.Triggers {
+				if i3 > 0 {
+					buf = append(buf, ',')
+				}
+				buf = appendJSONString(buf, o.Triggers[i3])
+			}
+			buf = append(buf, ']')
+		}
+	}
+	buf = append(buf, `,"user":`...)
+	buf = appendJSONString(buf, o.User)
+	buf = append(buf, `,"logon_type":`...)
+	buf = appendJSONString(buf, o.LogonType)
+	buf = append(buf, `,"run_level":`...)
+	buf = appendJSONString(buf, o.RunLevel)
+	if len(o.Privileges) != 0 {
+		buf = append(buf, `,"privileges":`...)
+		if o.Privileges == nil {
+			buf = append(buf, "null"...)
+		} else {
+			buf = append(buf, '[')
+			for i4 := range o.Privileges {
+				if i4 > 0 {
+					buf = append(buf, ',')
+				}
+				buf = appendJSONString(buf, o.Privileges[i4])
+			}
+			buf = append(buf, ']')
+		}
+	}
+	if !o.LastRun.IsZero() {
+		buf = append(buf, `,"last_run":`...)
+		if buf, err = appendJSONTime(buf, o.LastRun); err != nil {
+			return nil, err
+		}
+	}
+	if !o.NextRun.IsZero() {
+		buf = append(buf, `,"next_run":`...)
+		if buf, err = appendJSONTime(buf, o.NextRun); err != nil {
+			return nil, err
+		}
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *ScheduledTask) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Name) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NAME", Value: t.FormatField(o.Name, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Path) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PATH", Value: t.FormatField(o.Path, textlogModifiersNone)})
+	}
+	if !(o.Commands == nil) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Commands)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "COMMAND", Value: t.FormatField(o.Commands, textlogModifiersOmitempty)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Enabled) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ENABLED", Value: t.FormatField(o.Enabled, textlogModifiersNone)})
+	}
+	if !(o.Triggers == nil) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Triggers)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TRIGGERS", Value: t.FormatField(o.Triggers, textlogModifiersOmitempty)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.User) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "USER", Value: t.FormatField(o.User, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LogonType) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LOGON_TYPE", Value: t.FormatField(o.LogonType, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.RunLevel) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "RUN_LEVEL", Value: t.FormatField(o.RunLevel, textlogModifiersNone)})
+	}
+	if !(o.Privileges == nil) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.Privileges)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PRIVILEGES", Value: t.FormatField(o.Privileges, textlogModifiersOmitempty)})
+	}
+	if !(isComparableZero(o.LastRun) || o.LastRun.IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.LastRun)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LASTRUN", Value: t.FormatField(o.LastRun, textlogModifiersOmitempty)})
+	}
+	if !(isComparableZero(o.NextRun) || o.NextRun.IsZero()) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.NextRun)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NEXTRUN", Value: t.FormatField(o.NextRun, textlogModifiersOmitempty)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *ScheduledTask) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Name) {
+		return jsonpointer.Pointer{"name"}
+	}
+	if pointee == any(&o.Path) {
+		return jsonpointer.Pointer{"path"}
+	}
+	if pointee == any(&o.Commands) {
+		return jsonpointer.Pointer{"commands"}
+	}
+	if p := o.Commands.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"commands"}, p...)
+	}
+	if pointee == any(&o.ComHandlers) {
+		return jsonpointer.Pointer{"com_handlers"}
+	}
+	if p := o.ComHandlers.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"com_handlers"}, p...)
+	}
+	if pointee == any(&o.Enabled) {
+		return jsonpointer.Pointer{"enabled"}
+	}
+	if pointee == any(&o.Triggers) {
+		return jsonpointer.Pointer{"triggers"}
+	}
+	if p := o.Triggers.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"triggers"}, p...)
+	}
+	if pointee == any(&o.User) {
+		return jsonpointer.Pointer{"user"}
+	}
+	if pointee == any(&o.LogonType) {
+		return jsonpointer.Pointer{"logon_type"}
+	}
+	if pointee == any(&o.RunLevel) {
+		return jsonpointer.Pointer{"run_level"}
+	}
+	if pointee == any(&o.Privileges) {
+		return jsonpointer.Pointer{"privileges"}
+	}
+	if p := o.Privileges.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"privileges"}, p...)
+	}
+	if pointee == any(&o.LastRun) {
+		return jsonpointer.Pointer{"last_run"}
+	}
+	if pointee == any(&o.NextRun) {
+		return jsonpointer.Pointer{"next_run"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *ScheduledTask) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Name) {
+		return "NAME", true
+	}
+	if pointee == any(&o.Path) {
+		return "PATH", true
+	}
+	if pointee == any(&o.Commands) {
+		return "COMMAND", true
+	}
+	if label, ok := o.Commands.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "COMMAND"), true
+	}
+	if pointee == any(&o.ComHandlers) {
+		return "COM_HANDLER", true
+	}
+	if label, ok := o.ComHandlers.RelativeTextPointer(pointee); ok {
+		return jsonlog.ConcatTextLabels("COM_HANDLER", label), true
+	}
+	if pointee == any(&o.Enabled) {
+		return "ENABLED", true
+	}
+	if pointee == any(&o.Triggers) {
+		return "TRIGGERS", true
+	}
+	if label, ok := o.Triggers.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "TRIGGERS"), true
+	}
+	if pointee == any(&o.User) {
+		return "USER", true
+	}
+	if pointee == any(&o.LogonType) {
+		return "LOGON_TYPE", true
+	}
+	if pointee == any(&o.RunLevel) {
+		return "RUN_LEVEL", true
+	}
+	if pointee == any(&o.Privileges) {
+		return "PRIVILEGES", true
+	}
+	if label, ok := o.Privileges.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "PRIVILEGES"), true
+	}
+	if pointee == any(&o.LastRun) {
+		return "LASTRUN", true
+	}
+	if pointee == any(&o.NextRun) {
+		return "NEXTRUN", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *SdbEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"entry":`...)
+	if buf, err = appendJSONValue(buf, &o.Entry); err != nil {
+		return nil, err
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *SdbEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Entry) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ENTRY", Value: t.FormatField(o.Entry, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *SdbEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Entry) {
+		return jsonpointer.Pointer{"entry"}
+	}
+	if p := o.Entry.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"entry"}, p...)
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *SdbEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Entry) {
+		return "ENTRY", true
+	}
+	if label, ok := o.Entry.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "ENTRY"), true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *SdnQueryEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"time":`...)
+	if buf, err = appendJSONTime(buf, o.Time); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"file":`...)
+	buf = appendJSONString(buf, o.Filepath)
+	buf = append(buf, `,"sha1":`...)
+	buf = appendJSONString(buf, o.Sha1)
+	buf = append(buf, `,"sha256":`...)
+	buf = appendJSONString(buf, o.Sha256)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *SdnQueryEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Time) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TIME", Value: t.FormatField(o.Time, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Filepath) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FILE", Value: t.FormatField(o.Filepath, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Sha1) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SHA1", Value: t.FormatField(o.Sha1, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Sha256) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SHA256", Value: t.FormatField(o.Sha256, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *SdnQueryEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Time) {
+		return jsonpointer.Pointer{"time"}
+	}
+	if pointee == any(&o.Filepath) {
+		return jsonpointer.Pointer{"file"}
+	}
+	if pointee == any(&o.Sha1) {
+		return jsonpointer.Pointer{"sha1"}
+	}
+	if pointee == any(&o.Sha256) {
+		return jsonpointer.Pointer{"sha256"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *SdnQueryEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Time) {
+		return "TIME", true
+	}
+	if pointee == any(&o.Filepath) {
+		return "FILE", true
+	}
+	if pointee == any(&o.Sha1) {
+		return "SHA1", true
+	}
+	if pointee == any(&o.Sha256) {
+		return "SHA256", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *ShellbagEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"path":`...)
+	buf = appendJSONString(buf, o.Path)
+	buf = append(buf, `,"name":`...)
+	buf = appendJSONString(buf, o.Name)
+	buf = append(buf, `,"date_access":`...)
+	if buf, err = appendJSONTime(buf, o.DateAccess); err != nil {
+		return nil, err
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *ShellbagEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Path) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PATH", Value: t.FormatField(o.Path, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Name) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NAME", Value: t.FormatField(o.Name, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.DateAccess) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "DATE_ACCESS", Value: t.FormatField(o.DateAccess, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *ShellbagEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Path) {
+		return jsonpointer.Pointer{"path"}
+	}
+	if pointee == any(&o.Name) {
+		return jsonpointer.Pointer{"name"}
+	}
+	if pointee == any(&o.DateAccess) {
+		return jsonpointer.Pointer{"date_access"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *ShellbagEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Path) {
+		return "PATH", true
+	}
+	if pointee == any(&o.Name) {
+		return "NAME", true
+	}
+	if pointee == any(&o.DateAccess) {
+		return "DATE_ACCESS", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *ShimCache) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"entries":`...)
+	buf = strconv.AppendInt(buf, int64(o.Entries), 10)
+	buf = append(buf, `,"last_known_entries":`...)
+	buf = strconv.AppendInt(buf, int64(o.LastKnownEntries), 10)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *ShimCache) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Entries) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ENTRIES", Value: t.FormatField(o.Entries, textlogModifiersNone)})
+	}
+	if !(o.LastKnownEntries == 0) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.LastKnownEntries)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PREVIOUS_ENTRIES", Value: t.FormatField(o.LastKnownEntries, textlogModifiersOmitempty)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *ShimCache) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Entries) {
+		return jsonpointer.Pointer{"entries"}
+	}
+	if pointee == any(&o.LastKnownEntries) {
+		return jsonpointer.Pointer{"last_known_entries"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *ShimCache) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Entries) {
+		return "ENTRIES", true
+	}
+	if pointee == any(&o.LastKnownEntries) {
+		return "PREVIOUS_ENTRIES", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *ShimCacheEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"timestamp":`...)
+	if buf, err = appendJSONTime(buf, o.Timestamp); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"exec_flag":`...)
+	if o.ExecFlag == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = strconv.AppendBool(buf, (*o.ExecFlag))
+	}
+	buf = append(buf, `,"path":`...)
+	buf = appendJSONString(buf, o.Path)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *ShimCacheEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Timestamp) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TIMESTAMP", Value: t.FormatField(o.Timestamp, textlogModifiersNone)})
+	}
+	if !(o.ExecFlag == nil) && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.ExecFlag)) {
+		var value0 any = o.ExecFlag
+		if o.ExecFlag != nil {
+			value0 = (*o.ExecFlag)
+		}
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "EXEC_FLAG", Value: t.FormatField(value0, textlogModifiersOmitempty)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Path) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PATH", Value: t.FormatField(o.Path, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *ShimCacheEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Timestamp) {
+		return jsonpointer.Pointer{"timestamp"}
+	}
+	if pointee == any(&o.ExecFlag) {
+		return jsonpointer.Pointer{"exec_flag"}
+	}
+	if pointee == any(o.ExecFlag) {
+		return jsonpointer.Pointer{"exec_flag"}
+	}
+	if pointee == any(&o.Path) {
+		return jsonpointer.Pointer{"path"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *ShimCacheEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Timestamp) {
+		return "TIMESTAMP", true
+	}
+	if pointee == any(o.ExecFlag) {
+		return "EXEC_FLAG", true
+	}
+	if pointee == any(&o.ExecFlag) {
+		return "EXEC_FLAG", true
+	}
+	if pointee == any(&o.Path) {
+		return "PATH", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *SparseData) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"elements":`...)
+	if o.Elements == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i1 := range o.Elements {
+			if i1 > 0 {
+				buf = append(buf, ',')
+			}
+			start2 := len(buf)
+			buf = append(buf, `,"offset":`...)
+			buf = strconv.AppendUint(buf, o.Elements[i1].Offset, 10)
+			buf = append(buf, `,"data":`...)
+			start3 := len(buf)
+			buf = append(buf, `,"data":`...)
+			buf = appendJSONString(buf, o.Elements[i1].Data.EncodedData)
+			buf = append(buf, `,"encoding":`...)
+			buf = appendJSONString(buf, string(o.Elements[i1].Data.Encoding))
+			buf = closeJSONObject(buf, start3)
+			buf = closeJSONObject(buf, start2)
+		}
+		buf = append(buf, ']')
+	}
+	buf = append(buf, `,"length":`...)
+	buf = strconv.AppendInt(buf, o.Length, 10)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *SparseData) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *SparseData) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Elements) {
+		return jsonpointer.Pointer{"elements"}
+	}
+	for i0 := range o.Elements {
+		if pointee == any(&o.Elements[i0]) {
+			return jsonpointer.Pointer{"elements", strconv.Itoa(i0)}
+		}
+		if pointee == any(&o.Elements[i0].Offset) {
+			return jsonpointer.Pointer{"elements", strconv.Itoa(i0), "offset"}
+		}
+		if pointee == any(&o.Elements[i0].Data) {
+			return jsonpointer.Pointer{"elements", strconv.Itoa(i0), "data"}
+		}
+		if pointee == any(&o.Elements[i0].Data.EncodedData) {
+			return jsonpointer.Pointer{"elements", strconv.Itoa(i0), "data", "data"}
+		}
+		if pointee == any(&o.Elements[i0].Data.Encoding) {
+			return jsonpointer.Pointer{"elements", strconv.Itoa(i0), "data", "encoding"}
+		}
+	}
+	if pointee == any(&o.Length) {
+		return jsonpointer.Pointer{"length"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *SparseData) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Elements) {
+		return "", true
+	}
+	if pointee == any(&o.Length) {
+		return "", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *SystemdService) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"command":`...)
+	buf = appendJSONString(buf, o.Command)
+	buf = append(buf, `,"run_as_user":`...)
+	buf = appendJSONString(buf, o.RunAsUser)
+	buf = append(buf, `,"run_as_group":`...)
+	buf = appendJSONString(buf, o.RunAsGroup)
+	buf = append(buf, `,"unit":`...)
+	if buf, err = o.Unit.appendJSON(buf); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"image":`...)
+	if buf, err = o.Image.appendJSON(buf); err != nil {
+		return nil, err
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *SystemdService) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Command) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "COMMAND", Value: t.FormatField(o.Command, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.RunAsUser) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "RUN_AS_USER", Value: t.FormatField(o.RunAsUser, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.RunAsGroup) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "RUN_AS_GROUP", Value: t.FormatField(o.RunAsGroup, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.Unit) {
+		for _, pair := range o.Unit.marshalTextLog(t) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("UNIT", pair.Key), Value: pair.Value})
+		}
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.Image) {
+		for _, pair := range o.Image.marshalTextLog(t) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("IMAGE", pair.Key), Value: pair.Value})
+		}
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *SystemdService) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Command) {
+		return jsonpointer.Pointer{"command"}
+	}
+	if pointee == any(&o.RunAsUser) {
+		return jsonpointer.Pointer{"run_as_user"}
+	}
+	if pointee == any(&o.RunAsGroup) {
+		return jsonpointer.Pointer{"run_as_group"}
+	}
+	if pointee == any(&o.Unit) {
+		return jsonpointer.Pointer{"unit"}
+	}
+	if pointee == any(o.Unit) {
+		return jsonpointer.Pointer{"unit"}
+	}
+	if p := o.Unit.relativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"unit"}, p...)
+	}
+	if pointee == any(&o.Image) {
+		return jsonpointer.Pointer{"image"}
+	}
+	if pointee == any(o.Image) {
+		return jsonpointer.Pointer{"image"}
+	}
+	if p := o.Image.relativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"image"}, p...)
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *SystemdService) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Command) {
+		return "COMMAND", true
+	}
+	if pointee == any(&o.RunAsUser) {
+		return "RUN_AS_USER", true
+	}
+	if pointee == any(&o.RunAsGroup) {
+		return "RUN_AS_GROUP", true
+	}
+	if pointee == any(o.Unit) {
+		return "UNIT", true
+	}
+	if pointee == any(&o.Unit) {
+		return "UNIT", true
+	}
+	if label, ok := o.Unit.relativeTextPointer(pointee); ok {
+		return jsonlog.ConcatTextLabels("UNIT", label), true
+	}
+	if pointee == any(o.Image) {
+		return "IMAGE", true
+	}
+	if pointee == any(&o.Image) {
+		return "IMAGE", true
+	}
+	if label, ok := o.Image.relativeTextPointer(pointee); ok {
+		return jsonlog.ConcatTextLabels("IMAGE", label), true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *TeamViewerPassword) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"password":`...)
+	buf = appendJSONString(buf, o.Password)
+	buf = append(buf, `,"name":`...)
+	buf = appendJSONString(buf, o.Name)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *TeamViewerPassword) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Password) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PASSWORD", Value: t.FormatField(o.Password, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Name) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NAME", Value: t.FormatField(o.Name, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *TeamViewerPassword) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Password) {
+		return jsonpointer.Pointer{"password"}
+	}
+	if pointee == any(&o.Name) {
+		return jsonpointer.Pointer{"name"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *TeamViewerPassword) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Password) {
+		return "PASSWORD", true
+	}
+	if pointee == any(&o.Name) {
+		return "NAME", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *Thread) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"id":`...)
+	buf = strconv.AppendUint(buf, uint64(o.ThreadId), 10)
+	buf = append(buf, `,"stack":`...)
+	if o.Stack == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i1 := range o.Stack {
+			if i1 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.Stack[i1])
+		}
+		buf = append(buf, ']')
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *Thread) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *Thread) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.ThreadId) {
+		return jsonpointer.Pointer{"id"}
+	}
+	if pointee == any(&o.Stack) {
+		return jsonpointer.Pointer{"stack"}
+	}
+	if p := o.Stack.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"stack"}, p...)
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *Thread) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.ThreadId) {
+		return "", true
+	}
+	if pointee == any(&o.Stack) {
+		return "", true
+	}
+	if label, ok := o.Stack.RelativeTextPointer(pointee); ok {
+		return label, true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *TomcatUser) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"user":`...)
+	buf = appendJSONString(buf, o.User)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *TomcatUser) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.User) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "USER", Value: t.FormatField(o.User, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *TomcatUser) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.User) {
+		return jsonpointer.Pointer{"user"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *TomcatUser) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.User) {
+		return "USER", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *UALEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"authenticated_user_name":`...)
+	buf = appendJSONString(buf, o.AuthenticatedUserName)
+	buf = append(buf, `,"address":`...)
+	buf = appendJSONString(buf, o.Address)
+	buf = append(buf, `,"total_accesses":`...)
+	buf = strconv.AppendUint(buf, uint64(o.TotalAccesses), 10)
+	buf = append(buf, `,"role_guid":`...)
+	if buf, err = appendJSONValue(buf, &o.RoleGuid); err != nil {
+		return nil, err
+	}
+	if len(o.RoleName) != 0 {
+		buf = append(buf, `,"role_name":`...)
+		buf = appendJSONString(buf, o.RoleName)
+	}
+	if len(o.ProductName) != 0 {
+		buf = append(buf, `,"product_name":`...)
+		buf = appendJSONString(buf, o.ProductName)
+	}
+	buf = append(buf, `,"tenant_id":`...)
+	if buf, err = appendJSONValue(buf, &o.TenantId); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"insert_date":`...)
+	if buf, err = appendJSONTime(buf, o.InsertDate); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"last_access":`...)
+	if buf, err = appendJSONTime(buf, o.LastAccess); err != nil {
+		return nil, err
+	}
+	if len(o.ClientName) != 0 {
+		buf = append(buf, `,"client_name":`...)
+		buf = appendJSONString(buf, o.ClientName)
+	}
+	buf = append(buf, `,"accesses_by_day":`...)
+	if buf, err = appendJSONValue(buf, &o.AccessesByDay); err != nil {
+		return nil, err
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *UALEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.AuthenticatedUserName) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "AUTHENTICATED_USER_NAME", Value: t.FormatField(o.AuthenticatedUserName, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Address) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ADDRESS", Value: t.FormatField(o.Address, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.TotalAccesses) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TOTAL_ACCESSES", Value: t.FormatField(o.TotalAccesses, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.RoleGuid) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ROLE_GUID", Value: t.FormatField(o.RoleGuid, textlogModifiersNone)})
+	}
+	if !(o.RoleName == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.RoleName)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ROLE_NAME", Value: t.FormatField(o.RoleName, textlogModifiersOmitempty)})
+	}
+	if !(o.ProductName == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.ProductName)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PRODUCT_NAME", Value: t.FormatField(o.ProductName, textlogModifiersOmitempty)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.TenantId) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TENANT_ID", Value: t.FormatField(o.TenantId, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.InsertDate) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "INSERT_DATE", Value: t.FormatField(o.InsertDate, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LastAccess) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LAST_ACCESS", Value: t.FormatField(o.LastAccess, textlogModifiersNone)})
+	}
+	if !(o.ClientName == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.ClientName)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "CLIENT_NAME", Value: t.FormatField(o.ClientName, textlogModifiersOmitempty)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *UALEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.AuthenticatedUserName) {
+		return jsonpointer.Pointer{"authenticated_user_name"}
+	}
+	if pointee == any(&o.Address) {
+		return jsonpointer.Pointer{"address"}
+	}
+	if pointee == any(&o.TotalAccesses) {
+		return jsonpointer.Pointer{"total_accesses"}
+	}
+	if pointee == any(&o.RoleGuid) {
+		return jsonpointer.Pointer{"role_guid"}
+	}
+	for i0 := range o.RoleGuid {
+		if pointee == any(&o.RoleGuid[i0]) {
+			return jsonpointer.Pointer{"role_guid", strconv.Itoa(i0)}
+		}
+	}
+	if pointee == any(&o.RoleName) {
+		return jsonpointer.Pointer{"role_name"}
+	}
+	if pointee == any(&o.ProductName) {
+		return jsonpointer.Pointer{"product_name"}
+	}
+	if pointee == any(&o.TenantId) {
+		return jsonpointer.Pointer{"tenant_id"}
+	}
+	for i1 := range o.TenantId {
+		if pointee == any(&o.TenantId[i1]) {
+			return jsonpointer.Pointer{"tenant_id", strconv.Itoa(i1)}
+		}
+	}
+	if pointee == any(&o.InsertDate) {
+		return jsonpointer.Pointer{"insert_date"}
+	}
+	if pointee == any(&o.LastAccess) {
+		return jsonpointer.Pointer{"last_access"}
+	}
+	if pointee == any(&o.ClientName) {
+		return jsonpointer.Pointer{"client_name"}
+	}
+	if pointee == any(&o.AccessesByDay) {
+		return jsonpointer.Pointer{"accesses_by_day"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *UALEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.AuthenticatedUserName) {
+		return "AUTHENTICATED_USER_NAME", true
+	}
+	if pointee == any(&o.Address) {
+		return "ADDRESS", true
+	}
+	if pointee == any(&o.TotalAccesses) {
+		return "TOTAL_ACCESSES", true
+	}
+	if pointee == any(&o.RoleGuid) {
+		return "ROLE_GUID", true
+	}
+	if pointee == any(&o.RoleName) {
+		return "ROLE_NAME", true
+	}
+	if pointee == any(&o.ProductName) {
+		return "PRODUCT_NAME", true
+	}
+	if pointee == any(&o.TenantId) {
+		return "TENANT_ID", true
+	}
+	if pointee == any(&o.InsertDate) {
+		return "INSERT_DATE", true
+	}
+	if pointee == any(&o.LastAccess) {
+		return "LAST_ACCESS", true
+	}
+	if pointee == any(&o.ClientName) {
+		return "CLIENT_NAME", true
+	}
+	if pointee == any(&o.AccessesByDay) {
+		return "-", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *UnixPermissions) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"owner":`...)
+	buf = appendJSONString(buf, o.Owner)
+	buf = append(buf, `,"group":`...)
+	buf = appendJSONString(buf, o.Group)
+	buf = append(buf, `,"mask":`...)
+	start1 := len(buf)
+	buf = append(buf, `,"user":`...)
+	start2 := len(buf)
+	buf = append(buf, `,"readable":`...)
+	buf = strconv.AppendBool(buf, o.Mask.User.Readable)
+	buf = append(buf, `,"writable":`...)
+	buf = strconv.AppendBool(buf, o.Mask.User.Writable)
+	buf = append(buf, `,"executable":`...)
+	buf = strconv.AppendBool(buf, o.Mask.User.Executable)
+	buf = closeJSONObject(buf, start2)
+	buf = append(buf, `,"group":`...)
+	start3 := len(buf)
+	buf = append(buf, `,"readable":`...)
+	buf = strconv.AppendBool(buf, o.Mask.Group.Readable)
+	buf = append(buf, `,"writable":`...)
+	buf = strconv.AppendBool(buf, o.Mask.Group.Writable)
+	buf = append(buf, `,"executable":`...)
+	buf = strconv.AppendBool(buf, o.Mask.Group.Executable)
+	buf = closeJSONObject(buf, start3)
+	buf = append(buf, `,"world":`...)
+	start4 := len(buf)
+	buf = append(buf, `,"readable":`...)
+	buf = strconv.AppendBool(buf, o.Mask.World.Readable)
+	buf = append(buf, `,"writable":`...)
+	buf = strconv.AppendBool(buf, o.Mask.World.Writable)
+	buf = append(buf, `,"executable":`...)
+	buf = strconv.AppendBool(buf, o.Mask.World.Executable)
+	buf = closeJSONObject(buf, start4)
+	buf = closeJSONObject(buf, start1)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *UnixPermissions) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Owner) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "OWNER", Value: t.FormatField(o.Owner, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Group) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "GROUP", Value: t.FormatField(o.Group, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Mask) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PERMISSIONS", Value: t.FormatField(o.Mask, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *UnixPermissions) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Owner) {
+		return jsonpointer.Pointer{"owner"}
+	}
+	if pointee == any(&o.Group) {
+		return jsonpointer.Pointer{"group"}
+	}
+	if pointee == any(&o.Mask) {
+		return jsonpointer.Pointer{"mask"}
+	}
+	if pointee == any(&o.Mask.User) {
+		return jsonpointer.Pointer{"mask", "user"}
+	}
+	if pointee == any(&o.Mask.User.Readable) {
+		return jsonpointer.Pointer{"mask", "user", "readable"}
+	}
+	if pointee == any(&o.Mask.User.Writable) {
+		return jsonpointer.Pointer{"mask", "user", "writable"}
+	}
+	if pointee == any(&o.Mask.User.Executable) {
+		return jsonpointer.Pointer{"mask", "user", "executable"}
+	}
+	if pointee == any(&o.Mask.Group) {
+		return jsonpointer.Pointer{"mask", "group"}
+	}
+	if pointee == any(&o.Mask.Group.Readable) {
+		return jsonpointer.Pointer{"mask", "group", "readable"}
+	}
+	if pointee == any(&o.Mask.Group.Writable) {
+		return jsonpointer.Pointer{"mask", "group", "writable"}
+	}
+	if pointee == any(&o.Mask.Group.Executable) {
+		return jsonpointer.Pointer{"mask", "group", "executable"}
+	}
+	if pointee == any(&o.Mask.World) {
+		return jsonpointer.Pointer{"mask", "world"}
+	}
+	if pointee == any(&o.Mask.World.Readable) {
+		return jsonpointer.Pointer{"mask", "world", "readable"}
+	}
+	if pointee == any(&o.Mask.World.Writable) {
+		return jsonpointer.Pointer{"mask", "world", "writable"}
+	}
+	if pointee == any(&o.Mask.World.Executable) {
+		return jsonpointer.Pointer{"mask", "world", "executable"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *UnixPermissions) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Owner) {
+		return "OWNER", true
+	}
+	if pointee == any(&o.Group) {
+		return "GROUP", true
+	}
+	if pointee == any(&o.Mask) {
+		return "PERMISSIONS", true
+	}
+	if pointee == any(&o.Mask.User) {
+		return "PERMISSIONS", true
+	}
+	if pointee == any(&o.Mask.User.Readable) {
+		return "PERMISSIONS", true
+	}
+	if pointee == any(&o.Mask.User.Writable) {
+		return "PERMISSIONS", true
+	}
+	if pointee == any(&o.Mask.User.Executable) {
+		return "PERMISSIONS", true
+	}
+	if pointee == any(&o.Mask.Group) {
+		return "PERMISSIONS", true
+	}
+	if pointee == any(&o.Mask.Group.Readable) {
+		return "PERMISSIONS", true
+	}
+	if pointee == any(&o.Mask.Group.Writable) {
+		return "PERMISSIONS", true
+	}
+	if pointee == any(&o.Mask.Group.Executable) {
+		return "PERMISSIONS", true
+	}
+	if pointee == any(&o.Mask.World) {
+		return "PERMISSIONS", true
+	}
+	if pointee == any(&o.Mask.World.Readable) {
+		return "PERMISSIONS", true
+	}
+	if pointee == any(&o.Mask.World.Writable) {
+		return "PERMISSIONS", true
+	}
+	if pointee == any(&o.Mask.World.Executable) {
+		return "PERMISSIONS", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *UnixUser) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"name":`...)
+	buf = appendJSONString(buf, o.Name)
+	buf = append(buf, `,"uid":`...)
+	buf = appendJSONString(buf, o.Uid)
+	buf = append(buf, `,"gid":`...)
+	buf = appendJSONString(buf, o.Gid)
+	buf = append(buf, `,"full_name":`...)
+	buf = appendJSONString(buf, o.FullName)
+	buf = append(buf, `,"home":`...)
+	buf = appendJSONString(buf, o.Home)
+	buf = append(buf, `,"shell":`...)
+	buf = appendJSONString(buf, o.Shell)
+	buf = append(buf, `,"crontab":`...)
+	buf = appendJSONString(buf, o.Crontab)
+	buf = append(buf, `,"access_files":`...)
+	if o.AccessFiles == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i1 := range o.AccessFiles {
+			if i1 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.AccessFiles[i1])
+		}
+		buf = append(buf, ']')
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *UnixUser) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Name) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NAME", Value: t.FormatField(o.Name, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Uid) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "USERID", Value: t.FormatField(o.Uid, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Gid) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "GROUPID", Value: t.FormatField(o.Gid, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.FullName) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FULL_NAME", Value: t.FormatField(o.FullName, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Home) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "HOME", Value: t.FormatField(o.Home, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Shell) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SHELL", Value: t.FormatField(o.Shell, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *UnixUser) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Name) {
+		return jsonpointer.Pointer{"name"}
+	}
+	if pointee == any(&o.Uid) {
+		return jsonpointer.Pointer{"uid"}
+	}
+	if pointee == any(&o.Gid) {
+		return jsonpointer.Pointer{"gid"}
+	}
+	if pointee == any(&o.FullName) {
+		return jsonpointer.Pointer{"full_name"}
+	}
+	if pointee == any(&o.Home) {
+		return jsonpointer.Pointer{"home"}
+	}
+	if pointee == any(&o.Shell) {
+		return jsonpointer.Pointer{"shell"}
+	}
+	if pointee == any(&o.Crontab) {
+		return jsonpointer.Pointer{"crontab"}
+	}
+	if pointee == any(&o.AccessFiles) {
+		return jsonpointer.Pointer{"access_files"}
+	}
+	for i0 := range o.AccessFiles {
+		if pointee == any(&o.AccessFiles[i0]) {
+			return jsonpointer.Pointer{"access_files", strconv.Itoa(i0)}
+		}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *UnixUser) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Name) {
+		return "NAME", true
+	}
+	if pointee == any(&o.Uid) {
+		return "USERID", true
+	}
+	if pointee == any(&o.Gid) {
+		return "GROUPID", true
+	}
+	if pointee == any(&o.FullName) {
+		return "FULL_NAME", true
+	}
+	if pointee == any(&o.Home) {
+		return "HOME", true
+	}
+	if pointee == any(&o.Shell) {
+		return "SHELL", true
+	}
+	if pointee == any(&o.Crontab) {
+		return "-", true
+	}
+	if pointee == any(&o.AccessFiles) {
+		return "-", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *UsnEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"event_time":`...)
+	if buf, err = appendJSONTime(buf, o.EventTime); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"filename":`...)
+	buf = appendJSONString(buf, o.Filename)
+	buf = append(buf, `,"reasons":`...)
+	if o.Reasons == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i1 := range o.Reasons {
+			if i1 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.Reasons[i1])
+		}
+		buf = append(buf, ']')
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *UsnEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.EventTime) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "EVENT_TIME", Value: t.FormatField(o.EventTime, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Filename) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FILENAME", Value: t.FormatField(o.Filename, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Reasons) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "REASON", Value: t.FormatField(o.Reasons, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *UsnEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.EventTime) {
+		return jsonpointer.Pointer{"event_time"}
+	}
+	if pointee == any(&o.Filename) {
+		return jsonpointer.Pointer{"filename"}
+	}
+	if pointee == any(&o.Reasons) {
+		return jsonpointer.Pointer{"reasons"}
+	}
+	if p := o.Reasons.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"reasons"}, p...)
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *UsnEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.EventTime) {
+		return "EVENT_TIME", true
+	}
+	if pointee == any(&o.Filename) {
+		return "FILENAME", true
+	}
+	if pointee == any(&o.Reasons) {
+		return "REASON", true
+	}
+	if label, ok := o.Reasons.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "REASON"), true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *WebDownload) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"url":`...)
+	buf = appendJSONString(buf, o.URL)
+	buf = append(buf, `,"time":`...)
+	if buf, err = appendJSONTime(buf, o.Time); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"file":`...)
+	if buf, err = o.File.appendJSON(buf); err != nil {
+		return nil, err
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *WebDownload) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.URL) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "URL", Value: t.FormatField(o.URL, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Time) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TIME", Value: t.FormatField(o.Time, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.File) {
+		for _, pair := range o.File.marshalTextLog(t) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("FILE", pair.Key), Value: pair.Value})
+		}
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *WebDownload) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.URL) {
+		return jsonpointer.Pointer{"url"}
+	}
+	if pointee == any(&o.Time) {
+		return jsonpointer.Pointer{"time"}
+	}
+	if pointee == any(&o.File) {
+		return jsonpointer.Pointer{"file"}
+	}
+	if pointee == any(o.File) {
+		return jsonpointer.Pointer{"file"}
+	}
+	if p := o.File.relativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"file"}, p...)
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *WebDownload) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.URL) {
+		return "URL", true
+	}
+	if pointee == any(&o.Time) {
+		return "TIME", true
+	}
+	if pointee == any(o.File) {
+		return "FILE", true
+	}
+	if pointee == any(&o.File) {
+		return "FILE", true
+	}
+	if label, ok := o.File.relativeTextPointer(pointee); ok {
+		return jsonlog.ConcatTextLabels("FILE", label), true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *WebPageVisit) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"url":`...)
+	buf = appendJSONString(buf, o.URL)
+	buf = append(buf, `,"time":`...)
+	if buf, err = appendJSONTime(buf, o.Time); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"title":`...)
+	buf = appendJSONString(buf, o.Title)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *WebPageVisit) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.URL) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "URL", Value: t.FormatField(o.URL, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Time) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TIME", Value: t.FormatField(o.Time, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Title) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "TITLE", Value: t.FormatField(o.Title, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *WebPageVisit) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.URL) {
+		return jsonpointer.Pointer{"url"}
+	}
+	if pointee == any(&o.Time) {
+		return jsonpointer.Pointer{"time"}
+	}
+	if pointee == any(&o.Title) {
+		return jsonpointer.Pointer{"title"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *WebPageVisit) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.URL) {
+		return "URL", true
+	}
+	if pointee == any(&o.Time) {
+		return "TIME", true
+	}
+	if pointee == any(&o.Title) {
+		return "TITLE", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *WindowsEvent) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"event":`...)
+	buf = appendJSONString(buf, o.Event)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *WindowsEvent) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Event) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "EVENT", Value: t.FormatField(o.Event, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *WindowsEvent) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Event) {
+		return jsonpointer.Pointer{"event"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *WindowsEvent) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Event) {
+		return "EVENT", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *WindowsEventlogEntry) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"entry":`...)
+	if buf, err = appendJSONValue(buf, &o.Entry); err != nil {
+		return nil, err
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *WindowsEventlogEntry) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.EventId) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "EVENT_ID", Value: t.FormatField(o.EventId, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.EventLevel) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "EVENT_LEVEL", Value: t.FormatField(o.EventLevel, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.EventTime) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "EVENT_TIME", Value: t.FormatField(o.EventTime, textlogModifiersNone)})
+	}
+	if !(o.EventChannel == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.EventChannel)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "EVENT_CHANNEL", Value: t.FormatField(o.EventChannel, textlogModifiersOmitempty)})
+	}
+	if !(o.EventComputer == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.EventComputer)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "EVENT_COMPUTER", Value: t.FormatField(o.EventComputer, textlogModifiersOmitempty)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Entry) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ENTRY", Value: t.FormatField(o.Entry, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *WindowsEventlogEntry) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.EventId) {
+		return jsonpointer.Pointer{"-"}
+	}
+	if pointee == any(&o.EventLevel) {
+		return jsonpointer.Pointer{"-"}
+	}
+	if pointee == any(&o.EventTime) {
+		return jsonpointer.Pointer{"-"}
+	}
+	if pointee == any(&o.EventChannel) {
+		return jsonpointer.Pointer{"-"}
+	}
+	if pointee == any(&o.EventComputer) {
+		return jsonpointer.Pointer{"-"}
+	}
+	if pointee == any(&o.Entry) {
+		return jsonpointer.Pointer{"entry"}
+	}
+	if p := o.Entry.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"entry"}, p...)
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *WindowsEventlogEntry) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.EventId) {
+		return "EVENT_ID", true
+	}
+	if pointee == any(&o.EventLevel) {
+		return "EVENT_LEVEL", true
+	}
+	if pointee == any(&o.EventTime) {
+		return "EVENT_TIME", true
+	}
+	if pointee == any(&o.EventChannel) {
+		return "EVENT_CHANNEL", true
+	}
+	if pointee == any(&o.EventComputer) {
+		return "EVENT_COMPUTER", true
+	}
+	if pointee == any(&o.Entry) {
+		return "ENTRY", true
+	}
+	if label, ok := o.Entry.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "ENTRY"), true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *WindowsMutex) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"mutex":`...)
+	buf = appendJSONString(buf, o.Mutex)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *WindowsMutex) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Mutex) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "MUTEX", Value: t.FormatField(o.Mutex, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *WindowsMutex) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Mutex) {
+		return jsonpointer.Pointer{"mutex"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *WindowsMutex) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Mutex) {
+		return "MUTEX", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *WindowsPermissions) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"owner":`...)
+	buf = appendJSONString(buf, o.Owner)
+	buf = append(buf, `,"acl":`...)
+	if o.Permissions == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i1 := range o.Permissions {
+			if i1 > 0 {
+				buf = append(buf, ',')
+			}
+			start2 := len(buf)
+			buf = append(buf, `,"group":`...)
+			buf = appendJSONString(buf, o.Permissions[i1].Group)
+			buf = append(buf, `,"access":`...)
+			if buf, err = appendJSONValue(buf, &o.Permissions[i1].Access); err != nil {
+				return nil, err
+			}
+			buf = closeJSONObject(buf, start2)
+		}
+		buf = append(buf, ']')
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *WindowsPermissions) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Owner) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "OWNER", Value: t.FormatField(o.Owner, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Permissions) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PERMISSIONS", Value: t.FormatField(o.Permissions, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *WindowsPermissions) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Owner) {
+		return jsonpointer.Pointer{"owner"}
+	}
+	if pointee == any(&o.Permissions) {
+		return jsonpointer.Pointer{"acl"}
+	}
+	for i0 := range o.Permissions {
+		if pointee == any(&o.Permissions[i0]) {
+			return jsonpointer.Pointer{"acl", strconv.Itoa(i0)}
+		}
+		if pointee == any(&o.Permissions[i0].Group) {
+			return jsonpointer.Pointer{"acl", strconv.Itoa(i0), "group"}
+		}
+		if pointee == any(&o.Permissions[i0].Access) {
+			return jsonpointer.Pointer{"acl", strconv.Itoa(i0), "access"}
+		}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *WindowsPermissions) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Owner) {
+		return "OWNER", true
+	}
+	if pointee == any(&o.Permissions) {
+		return "PERMISSIONS", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *WindowsPipe) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"pipe":`...)
+	buf = appendJSONString(buf, o.Pipe)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *WindowsPipe) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Pipe) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PIPE", Value: t.FormatField(o.Pipe, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *WindowsPipe) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Pipe) {
+		return jsonpointer.Pointer{"pipe"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *WindowsPipe) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Pipe) {
+		return "PIPE", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *WindowsPipeList) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"pipes":`...)
+	if o.Pipes == nil {
+		buf = append(buf, "null"...)
+	} else {
+		buf = append(buf, '[')
+		for i1 := range o.Pipes {
+			if i1 > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, o.Pipes[i1])
+		}
+		buf = append(buf, ']')
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *WindowsPipeList) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Pipes) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PIPES", Value: t.FormatField(o.Pipes, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *WindowsPipeList) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Pipes) {
+		return jsonpointer.Pointer{"pipes"}
+	}
+	if p := o.Pipes.RelativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"pipes"}, p...)
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *WindowsPipeList) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Pipes) {
+		return "PIPES", true
+	}
+	if label, ok := o.Pipes.RelativeTextPointer(pointee); ok {
+		return textLabelOr(label, "PIPES"), true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *WindowsService) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"key":`...)
+	buf = appendJSONString(buf, o.Key)
+	buf = append(buf, `,"key_name":`...)
+	buf = appendJSONString(buf, o.KeyName)
+	buf = append(buf, `,"service_name":`...)
+	buf = appendJSONString(buf, o.ServiceName)
+	buf = append(buf, `,"modified":`...)
+	if buf, err = appendJSONTime(buf, o.Modified); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"start_type":`...)
+	buf = appendJSONString(buf, o.StartType)
+	buf = append(buf, `,"service_type":`...)
+	buf = appendJSONString(buf, o.ServiceType)
+	buf = append(buf, `,"user":`...)
+	buf = appendJSONString(buf, o.User)
+	buf = append(buf, `,"description":`...)
+	buf = appendJSONString(buf, o.Description)
+	buf = append(buf, `,"failure_command":`...)
+	buf = appendJSONString(buf, o.FailureCommand)
+	buf = append(buf, `,"image":`...)
+	if buf, err = o.Image.appendJSON(buf); err != nil {
+		return nil, err
+	}
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *WindowsService) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Key) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "KEY", Value: t.FormatField(o.Key, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.KeyName) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "KEY_NAME", Value: t.FormatField(o.KeyName, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ServiceName) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SERVICE_NAME", Value: t.FormatField(o.ServiceName, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Modified) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "MODIFIED", Value: t.FormatField(o.Modified, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.StartType) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "START_TYPE", Value: t.FormatField(o.StartType, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.ServiceType) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "SERVICE_TYPE", Value: t.FormatField(o.ServiceType, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.User) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "USER", Value: t.FormatField(o.User, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Description) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "DESCRIPTION", Value: t.FormatField(o.Description, textlogModifiersNone)})
+	}
+	if !(o.FailureCommand == "") && (t.Omit == nil || !t.Omit(textlogModifiersOmitempty, o.FailureCommand)) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FAILURE_COMMAND", Value: t.FormatField(o.FailureCommand, textlogModifiersOmitempty)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersExpand, o.Image) {
+		for _, pair := range o.Image.marshalTextLog(t) {
+			entry = append(entry, jsonlog.TextlogValuePair{Key: jsonlog.ConcatTextLabels("IMAGE", pair.Key), Value: pair.Value})
+		}
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *WindowsService) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Key) {
+		return jsonpointer.Pointer{"key"}
+	}
+	if pointee == any(&o.KeyName) {
+		return jsonpointer.Pointer{"key_name"}
+	}
+	if pointee == any(&o.ServiceName) {
+		return jsonpointer.Pointer{"service_name"}
+	}
+	if pointee == any(&o.Modified) {
+		return jsonpointer.Pointer{"modified"}
+	}
+	if pointee == any(&o.StartType) {
+		return jsonpointer.Pointer{"start_type"}
+	}
+	if pointee == any(&o.ServiceType) {
+		return jsonpointer.Pointer{"service_type"}
+	}
+	if pointee == any(&o.User) {
+		return jsonpointer.Pointer{"user"}
+	}
+	if pointee == any(&o.Description) {
+		return jsonpointer.Pointer{"description"}
+	}
+	if pointee == any(&o.FailureCommand) {
+		return jsonpointer.Pointer{"failure_command"}
+	}
+	if pointee == any(&o.Image) {
+		return jsonpointer.Pointer{"image"}
+	}
+	if pointee == any(o.Image) {
+		return jsonpointer.Pointer{"image"}
+	}
+	if p := o.Image.relativeJsonPointer(pointee); p != nil {
+		return append(jsonpointer.Pointer{"image"}, p...)
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *WindowsService) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Key) {
+		return "KEY", true
+	}
+	if pointee == any(&o.KeyName) {
+		return "KEY_NAME", true
+	}
+	if pointee == any(&o.ServiceName) {
+		return "SERVICE_NAME", true
+	}
+	if pointee == any(&o.Modified) {
+		return "MODIFIED", true
+	}
+	if pointee == any(&o.StartType) {
+		return "START_TYPE", true
+	}
+	if pointee == any(&o.ServiceType) {
+		return "SERVICE_TYPE", true
+	}
+	if pointee == any(&o.User) {
+		return "USER", true
+	}
+	if pointee == any(&o.Description) {
+		return "DESCRIPTION", true
+	}
+	if pointee == any(&o.FailureCommand) {
+		return "FAILURE_COMMAND", true
+	}
+	if pointee == any(o.Image) {
+		return "IMAGE", true
+	}
+	if pointee == any(&o.Image) {
+		return "IMAGE", true
+	}
+	if label, ok := o.Image.relativeTextPointer(pointee); ok {
+		return jsonlog.ConcatTextLabels("IMAGE", label), true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *WindowsUser) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	var err error
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.LogObjectHeader.Type)
+	buf = append(buf, `,"user":`...)
+	buf = appendJSONString(buf, o.User)
+	buf = append(buf, `,"full_name":`...)
+	buf = appendJSONString(buf, o.FullName)
+	buf = append(buf, `,"is_admin":`...)
+	buf = strconv.AppendBool(buf, o.IsAdmin)
+	buf = append(buf, `,"last_logon":`...)
+	if buf, err = appendJSONTime(buf, o.LastLogon); err != nil {
+		return nil, err
+	}
+	buf = append(buf, `,"bad_password_count":`...)
+	buf = strconv.AppendInt(buf, int64(o.BadPasswordCount), 10)
+	buf = append(buf, `,"num_logons":`...)
+	buf = strconv.AppendInt(buf, int64(o.NumberOfLogons), 10)
+	buf = append(buf, `,"pass_age":`...)
+	buf = strconv.AppendInt(buf, int64(o.PasswordAge), 10)
+	buf = append(buf, `,"no_expire":`...)
+	buf = strconv.AppendBool(buf, o.PasswordNeverExpires)
+	buf = append(buf, `,"active":`...)
+	buf = strconv.AppendBool(buf, o.IsEnabled)
+	buf = append(buf, `,"locked":`...)
+	buf = strconv.AppendBool(buf, o.IsLocked)
+	buf = append(buf, `,"comment":`...)
+	buf = appendJSONString(buf, o.Comment)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *WindowsUser) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.User) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "USER", Value: t.FormatField(o.User, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.FullName) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FULL_NAME", Value: t.FormatField(o.FullName, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.IsAdmin) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "IS_ADMIN", Value: t.FormatField(o.IsAdmin, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.LastLogon) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LAST_LOGON", Value: t.FormatField(o.LastLogon, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.BadPasswordCount) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "BAD_PASSWORD_COUNT", Value: t.FormatField(o.BadPasswordCount, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.NumberOfLogons) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NUM_LOGONS", Value: t.FormatField(o.NumberOfLogons, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.PasswordAge) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "PASS_AGE", Value: t.FormatField(o.PasswordAge, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.PasswordNeverExpires) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "NO_EXPIRE", Value: t.FormatField(o.PasswordNeverExpires, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.IsEnabled) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "ACTIVE", Value: t.FormatField(o.IsEnabled, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.IsLocked) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LOCKED", Value: t.FormatField(o.IsLocked, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Comment) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "COMMENT", Value: t.FormatField(o.Comment, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *WindowsUser) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.User) {
+		return jsonpointer.Pointer{"user"}
+	}
+	if pointee == any(&o.FullName) {
+		return jsonpointer.Pointer{"full_name"}
+	}
+	if pointee == any(&o.IsAdmin) {
+		return jsonpointer.Pointer{"is_admin"}
+	}
+	if pointee == any(&o.LastLogon) {
+		return jsonpointer.Pointer{"last_logon"}
+	}
+	if pointee == any(&o.BadPasswordCount) {
+		return jsonpointer.Pointer{"bad_password_count"}
+	}
+	if pointee == any(&o.NumberOfLogons) {
+		return jsonpointer.Pointer{"num_logons"}
+	}
+	if pointee == any(&o.PasswordAge) {
+		return jsonpointer.Pointer{"pass_age"}
+	}
+	if pointee == any(&o.PasswordNeverExpires) {
+		return jsonpointer.Pointer{"no_expire"}
+	}
+	if pointee == any(&o.IsEnabled) {
+		return jsonpointer.Pointer{"active"}
+	}
+	if pointee == any(&o.IsLocked) {
+		return jsonpointer.Pointer{"locked"}
+	}
+	if pointee == any(&o.Comment) {
+		return jsonpointer.Pointer{"comment"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *WindowsUser) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.LogObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.User) {
+		return "USER", true
+	}
+	if pointee == any(&o.FullName) {
+		return "FULL_NAME", true
+	}
+	if pointee == any(&o.IsAdmin) {
+		return "IS_ADMIN", true
+	}
+	if pointee == any(&o.LastLogon) {
+		return "LAST_LOGON", true
+	}
+	if pointee == any(&o.BadPasswordCount) {
+		return "BAD_PASSWORD_COUNT", true
+	}
+	if pointee == any(&o.NumberOfLogons) {
+		return "NUM_LOGONS", true
+	}
+	if pointee == any(&o.PasswordAge) {
+		return "PASS_AGE", true
+	}
+	if pointee == any(&o.PasswordNeverExpires) {
+		return "NO_EXPIRE", true
+	}
+	if pointee == any(&o.IsEnabled) {
+		return "ACTIVE", true
+	}
+	if pointee == any(&o.IsLocked) {
+		return "LOCKED", true
+	}
+	if pointee == any(&o.Comment) {
+		return "COMMENT", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *WmiElement) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"key":`...)
+	buf = appendJSONString(buf, o.Key)
+	buf = append(buf, `,"filter_type":`...)
+	buf = appendJSONString(buf, o.Filtertype)
+	buf = append(buf, `,"event_filter_name":`...)
+	buf = appendJSONString(buf, o.Eventfiltername)
+	buf = append(buf, `,"event_consumer_name":`...)
+	buf = appendJSONString(buf, o.Eventconsumername)
+	buf = append(buf, `,"event_filter":`...)
+	buf = appendJSONString(buf, o.Eventfilter)
+	buf = append(buf, `,"event_consumer":`...)
+	buf = appendJSONString(buf, o.Eventconsumer)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *WmiElement) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Key) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "KEY", Value: t.FormatField(o.Key, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Filtertype) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "FILTERTYPE", Value: t.FormatField(o.Filtertype, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Eventfiltername) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "EVENTFILTERNAME", Value: t.FormatField(o.Eventfiltername, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Eventconsumername) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "EVENTCONSUMERNAME", Value: t.FormatField(o.Eventconsumername, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Eventfilter) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "EVENTFILTER", Value: t.FormatField(o.Eventfilter, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Eventconsumer) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "EVENTCONSUMER", Value: t.FormatField(o.Eventconsumer, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *WmiElement) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Key) {
+		return jsonpointer.Pointer{"key"}
+	}
+	if pointee == any(&o.Filtertype) {
+		return jsonpointer.Pointer{"filter_type"}
+	}
+	if pointee == any(&o.Eventfiltername) {
+		return jsonpointer.Pointer{"event_filter_name"}
+	}
+	if pointee == any(&o.Eventconsumername) {
+		return jsonpointer.Pointer{"event_consumer_name"}
+	}
+	if pointee == any(&o.Eventfilter) {
+		return jsonpointer.Pointer{"event_filter"}
+	}
+	if pointee == any(&o.Eventconsumer) {
+		return jsonpointer.Pointer{"event_consumer"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *WmiElement) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Key) {
+		return "KEY", true
+	}
+	if pointee == any(&o.Filtertype) {
+		return "FILTERTYPE", true
+	}
+	if pointee == any(&o.Eventfiltername) {
+		return "EVENTFILTERNAME", true
+	}
+	if pointee == any(&o.Eventconsumername) {
+		return "EVENTCONSUMERNAME", true
+	}
+	if pointee == any(&o.Eventfilter) {
+		return "EVENTFILTER", true
+	}
+	if pointee == any(&o.Eventconsumer) {
+		return "EVENTCONSUMER", true
+	}
+	return "", false
+}
+
+// appendJSON appends the JSON encoding of o to buf, like json.Marshal.
+func (o *WmiStartupCommand) appendJSON(buf []byte) ([]byte, error) {
+	if o == nil {
+		return append(buf, "null"...), nil
+	}
+	start0 := len(buf)
+	buf = append(buf, `,"type":`...)
+	buf = appendJSONString(buf, o.ObjectHeader.Type)
+	buf = append(buf, `,"location":`...)
+	buf = appendJSONString(buf, o.Location)
+	buf = append(buf, `,"caption":`...)
+	buf = appendJSONString(buf, o.Caption)
+	buf = append(buf, `,"command":`...)
+	buf = appendJSONString(buf, o.Command)
+	buf = closeJSONObject(buf, start0)
+	return buf, nil
+}
+
+// marshalTextLog returns the text log entry of o, like TextlogFormatter.FormatEntry.
+func (o *WmiStartupCommand) marshalTextLog(t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	if o == nil {
+		return nil
+	}
+	var entry jsonlog.TextlogEntry
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Location) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "LOCATION", Value: t.FormatField(o.Location, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Caption) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "CAPTION", Value: t.FormatField(o.Caption, textlogModifiersNone)})
+	}
+	if t.Omit == nil || !t.Omit(textlogModifiersNone, o.Command) {
+		entry = append(entry, jsonlog.TextlogValuePair{Key: "COMMAND", Value: t.FormatField(o.Command, textlogModifiersNone)})
+	}
+	return entry
+}
+
+// relativeJsonPointer returns the JSON pointer to pointee relative to o, like jsonlog.RelativeJsonPointer.
+func (o *WmiStartupCommand) relativeJsonPointer(pointee any) jsonpointer.Pointer {
+	if o == nil {
+		return nil
+	}
+	if pointee == any(o) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return jsonpointer.Pointer{}
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return jsonpointer.Pointer{"type"}
+	}
+	if pointee == any(&o.Location) {
+		return jsonpointer.Pointer{"location"}
+	}
+	if pointee == any(&o.Caption) {
+		return jsonpointer.Pointer{"caption"}
+	}
+	if pointee == any(&o.Command) {
+		return jsonpointer.Pointer{"command"}
+	}
+	return nil
+}
+
+// relativeTextPointer returns the text label of pointee relative to o, like jsonlog.RelativeTextLabel.
+func (o *WmiStartupCommand) relativeTextPointer(pointee any) (string, bool) {
+	if o == nil {
+		return "", false
+	}
+	if pointee == any(o) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader) {
+		return "", true
+	}
+	if pointee == any(&o.ObjectHeader.Type) {
+		return "", true
+	}
+	if pointee == any(&o.Location) {
+		return "LOCATION", true
+	}
+	if pointee == any(&o.Caption) {
+		return "CAPTION", true
+	}
+	if pointee == any(&o.Command) {
+		return "COMMAND", true
+	}
+	return "", false
+}
+
+func init() {
+	jsonlog.RegisterGenerated(reflect.TypeOf((*AmcacheEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*AmcacheEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*AmcacheEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*AmcacheEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*AmcacheEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*AntiVirusExclude)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*AntiVirusExclude).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*AntiVirusExclude).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*AntiVirusExclude).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*AntiVirusExclude).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*AntiVirusProduct)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*AntiVirusProduct).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*AntiVirusProduct).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*AntiVirusProduct).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*AntiVirusProduct).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*Assessment)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*Assessment).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*Assessment).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*Assessment).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*Assessment).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*AtJob)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*AtJob).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*AtJob).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer { return object.(*AtJob).relativeJsonPointer(pointee) },
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*AtJob).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*AuditLogEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*AuditLogEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*AuditLogEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*AuditLogEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*AuditLogEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*AuthorizedKeysEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*AuthorizedKeysEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*AuthorizedKeysEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*AuthorizedKeysEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*AuthorizedKeysEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*AutorunEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*AutorunEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*AutorunEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*AutorunEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*AutorunEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*CronJob)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*CronJob).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*CronJob).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*CronJob).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*CronJob).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*DeepDiveChunk)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*DeepDiveChunk).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*DeepDiveChunk).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*DeepDiveChunk).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*DeepDiveChunk).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*DetectionAddEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*DetectionAddEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*DetectionAddEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*DetectionAddEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*DetectionAddEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*DnsCacheEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*DnsCacheEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*DnsCacheEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*DnsCacheEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*DnsCacheEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*DoublePulsarHandshake)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*DoublePulsarHandshake).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*DoublePulsarHandshake).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*DoublePulsarHandshake).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*DoublePulsarHandshake).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*EBPFProgram)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*EBPFProgram).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*EBPFProgram).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*EBPFProgram).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*EBPFProgram).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*EmsDetectionEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*EmsDetectionEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*EmsDetectionEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*EmsDetectionEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*EmsDetectionEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*EndOfLifeReport)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*EndOfLifeReport).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*EndOfLifeReport).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*EndOfLifeReport).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*EndOfLifeReport).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*EnvironmentVariable)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*EnvironmentVariable).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*EnvironmentVariable).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*EnvironmentVariable).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*EnvironmentVariable).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*EstimatedImpactEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*EstimatedImpactEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*EstimatedImpactEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*EstimatedImpactEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*EstimatedImpactEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*EventlogProcessStart)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*EventlogProcessStart).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*EventlogProcessStart).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*EventlogProcessStart).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*EventlogProcessStart).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*File)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*File).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*File).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer { return object.(*File).relativeJsonPointer(pointee) },
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*File).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*FirewallRule)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*FirewallRule).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*FirewallRule).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*FirewallRule).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*FirewallRule).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*GroupsXmlUser)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*GroupsXmlUser).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*GroupsXmlUser).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*GroupsXmlUser).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*GroupsXmlUser).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*HostInfo)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*HostInfo).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*HostInfo).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*HostInfo).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*HostInfo).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*HostsFileEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*HostsFileEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*HostsFileEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*HostsFileEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*HostsFileEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*HotfixSummary)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*HotfixSummary).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*HotfixSummary).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*HotfixSummary).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*HotfixSummary).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*InitdService)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*InitdService).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*InitdService).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*InitdService).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*InitdService).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*JournaldEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*JournaldEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*JournaldEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*JournaldEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*JournaldEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*JumplistEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*JumplistEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*JumplistEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*JumplistEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*JumplistEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*KnowledgeDBEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*KnowledgeDBEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*KnowledgeDBEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*KnowledgeDBEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*KnowledgeDBEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*LinuxKernelModule)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*LinuxKernelModule).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*LinuxKernelModule).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*LinuxKernelModule).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*LinuxKernelModule).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*LogLine)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*LogLine).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*LogLine).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*LogLine).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*LogLine).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*LoggedInUser)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*LoggedInUser).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*LoggedInUser).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*LoggedInUser).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*LoggedInUser).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*LsaSession)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*LsaSession).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*LsaSession).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*LsaSession).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*LsaSession).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*Message)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*Message).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*Message).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*Message).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*Message).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*MftFileEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*MftFileEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*MftFileEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*MftFileEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*MftFileEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*MsOfficeConnectionCacheEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) {
+			return object.(*MsOfficeConnectionCacheEntry).appendJSON(buf)
+		},
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*MsOfficeConnectionCacheEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*MsOfficeConnectionCacheEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*MsOfficeConnectionCacheEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*NetworkConnectingThread)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*NetworkConnectingThread).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*NetworkConnectingThread).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*NetworkConnectingThread).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*NetworkConnectingThread).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*NetworkSession)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*NetworkSession).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*NetworkSession).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*NetworkSession).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*NetworkSession).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*NetworkShare)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*NetworkShare).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*NetworkShare).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*NetworkShare).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*NetworkShare).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*PSMacEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*PSMacEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*PSMacEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*PSMacEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*PSMacEntry).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*PlatformInfoAIX)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*PlatformInfoAIX).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*PlatformInfoAIX).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*PlatformInfoAIX).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*PlatformInfoAIX).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*PlatformInfoLinux)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*PlatformInfoLinux).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*PlatformInfoLinux).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*PlatformInfoLinux).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*PlatformInfoLinux).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*PlatformInfoMacos)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*PlatformInfoMacos).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*PlatformInfoMacos).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*PlatformInfoMacos).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*PlatformInfoMacos).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*PlatformInfoWindows)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*PlatformInfoWindows).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*PlatformInfoWindows).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*PlatformInfoWindows).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*PlatformInfoWindows).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*PluginStructuredData)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*PluginStructuredData).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*PluginStructuredData).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*PluginStructuredData).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*PluginStructuredData).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*PrefetchInfo)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*PrefetchInfo).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*PrefetchInfo).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*PrefetchInfo).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*PrefetchInfo).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*Process)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*Process).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*Process).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*Process).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*Process).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*ProcessConnectionObject)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*ProcessConnectionObject).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*ProcessConnectionObject).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*ProcessConnectionObject).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*ProcessConnectionObject).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*ProcessHandle)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*ProcessHandle).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*ProcessHandle).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*ProcessHandle).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*ProcessHandle).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*ProfileFolder)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*ProfileFolder).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*ProfileFolder).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*ProfileFolder).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*ProfileFolder).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*QuarantineEvent)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*QuarantineEvent).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*QuarantineEvent).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*QuarantineEvent).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*QuarantineEvent).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*RawFirewallRule)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*RawFirewallRule).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*RawFirewallRule).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*RawFirewallRule).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*RawFirewallRule).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*Reason)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*Reason).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*Reason).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*Reason).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*Reason).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*RegisteredDebugger)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*RegisteredDebugger).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*RegisteredDebugger).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*RegisteredDebugger).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*RegisteredDebugger).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*RegistryKey)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*RegistryKey).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*RegistryKey).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*RegistryKey).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*RegistryKey).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*RegistryScheduledTask)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*RegistryScheduledTask).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*RegistryScheduledTask).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*RegistryScheduledTask).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*RegistryScheduledTask).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*RegistryValue)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*RegistryValue).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*RegistryValue).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*RegistryValue).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*RegistryValue).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*Rootkit)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*Rootkit).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*Rootkit).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*Rootkit).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*Rootkit).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*SRUMResourceUsageEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*SRUMResourceUsageEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*SRUMResourceUsageEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*SRUMResourceUsageEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*SRUMResourceUsageEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*ScanInfo)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*ScanInfo).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*ScanInfo).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*ScanInfo).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*ScanInfo).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*ScheduledTask)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*ScheduledTask).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*ScheduledTask).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*ScheduledTask).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*ScheduledTask).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*SdbEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*SdbEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*SdbEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*SdbEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*SdbEntry).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*SdnQueryEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*SdnQueryEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*SdnQueryEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*SdnQueryEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*SdnQueryEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*ShellbagEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*ShellbagEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*ShellbagEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*ShellbagEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*ShellbagEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*ShimCache)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*ShimCache).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*ShimCache).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*ShimCache).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*ShimCache).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*ShimCacheEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*ShimCacheEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*ShimCacheEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*ShimCacheEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*ShimCacheEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*SparseData)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*SparseData).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*SparseData).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*SparseData).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*SparseData).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*SystemdService)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*SystemdService).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*SystemdService).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*SystemdService).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*SystemdService).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*TeamViewerPassword)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*TeamViewerPassword).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*TeamViewerPassword).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*TeamViewerPassword).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*TeamViewerPassword).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*Thread)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*Thread).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*Thread).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*Thread).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*Thread).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*TomcatUser)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*TomcatUser).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*TomcatUser).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*TomcatUser).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*TomcatUser).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*UALEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*UALEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*UALEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*UALEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*UALEntry).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*UnixPermissions)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*UnixPermissions).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*UnixPermissions).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*UnixPermissions).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*UnixPermissions).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*UnixUser)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*UnixUser).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*UnixUser).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*UnixUser).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*UnixUser).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*UsnEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*UsnEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*UsnEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*UsnEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*UsnEntry).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*WebDownload)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*WebDownload).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*WebDownload).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*WebDownload).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*WebDownload).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*WebPageVisit)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*WebPageVisit).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*WebPageVisit).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*WebPageVisit).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*WebPageVisit).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*WindowsEvent)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*WindowsEvent).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*WindowsEvent).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*WindowsEvent).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*WindowsEvent).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*WindowsEventlogEntry)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*WindowsEventlogEntry).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*WindowsEventlogEntry).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*WindowsEventlogEntry).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*WindowsEventlogEntry).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*WindowsMutex)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*WindowsMutex).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*WindowsMutex).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*WindowsMutex).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*WindowsMutex).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*WindowsPermissions)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*WindowsPermissions).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*WindowsPermissions).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*WindowsPermissions).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*WindowsPermissions).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*WindowsPipe)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*WindowsPipe).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*WindowsPipe).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*WindowsPipe).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*WindowsPipe).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*WindowsPipeList)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*WindowsPipeList).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*WindowsPipeList).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*WindowsPipeList).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*WindowsPipeList).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*WindowsService)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*WindowsService).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*WindowsService).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*WindowsService).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*WindowsService).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*WindowsUser)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*WindowsUser).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*WindowsUser).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*WindowsUser).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*WindowsUser).relativeTextPointer(pointee)
+		},
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*WmiElement)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*WmiElement).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*WmiElement).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*WmiElement).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) { return object.(*WmiElement).relativeTextPointer(pointee) },
+	})
+	jsonlog.RegisterGenerated(reflect.TypeOf((*WmiStartupCommand)(nil)), jsonlog.Generated{
+		AppendJSON: func(object any, buf []byte) ([]byte, error) { return object.(*WmiStartupCommand).appendJSON(buf) },
+		MarshalTextLog: func(object any, t jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+			return object.(*WmiStartupCommand).marshalTextLog(t)
+		},
+		RelativeJsonPointer: func(object any, pointee any) jsonpointer.Pointer {
+			return object.(*WmiStartupCommand).relativeJsonPointer(pointee)
+		},
+		RelativeTextPointer: func(object any, pointee any) (string, bool) {
+			return object.(*WmiStartupCommand).relativeTextPointer(pointee)
+		},
+	})
+}
@@ -0,0 +1,217 @@
+// Code generated by jsonloggen. DO NOT EDIT.
+
+//go:build !jsonloggen
+
+package thorlog
+
+import (
+	"reflect"
+
+	"github.com/NextronSystems/jsonlog"
+)
+
+type reflectiveAmcacheEntry AmcacheEntry
+type reflectiveAntiVirusExclude AntiVirusExclude
+type reflectiveAntiVirusProduct AntiVirusProduct
+type reflectiveAssessment Assessment
+type reflectiveAtJob AtJob
+type reflectiveAuditLogEntry AuditLogEntry
+type reflectiveAuthorizedKeysEntry AuthorizedKeysEntry
+type reflectiveAutorunEntry AutorunEntry
+type reflectiveCronJob CronJob
+type reflectiveDeepDiveChunk DeepDiveChunk
+type reflectiveDetectionAddEntry DetectionAddEntry
+type reflectiveDnsCacheEntry DnsCacheEntry
+type reflectiveDoublePulsarHandshake DoublePulsarHandshake
+type reflectiveEBPFProgram EBPFProgram
+type reflectiveEmsDetectionEntry EmsDetectionEntry
+type reflectiveEndOfLifeReport EndOfLifeReport
+type reflectiveEnvironmentVariable EnvironmentVariable
+type reflectiveEstimatedImpactEntry EstimatedImpactEntry
+type reflectiveEventlogProcessStart EventlogProcessStart
+type reflectiveFile File
+type reflectiveFirewallRule FirewallRule
+type reflectiveGroupsXmlUser GroupsXmlUser
+type reflectiveHostInfo HostInfo
+type reflectiveHostsFileEntry HostsFileEntry
+type reflectiveHotfixSummary HotfixSummary
+type reflectiveInitdService InitdService
+type reflectiveJournaldEntry JournaldEntry
+type reflectiveJumplistEntry JumplistEntry
+type reflectiveKnowledgeDBEntry KnowledgeDBEntry
+type reflectiveLinuxKernelModule LinuxKernelModule
+type reflectiveLogLine LogLine
+type reflectiveLoggedInUser LoggedInUser
+type reflectiveLsaSession LsaSession
+type reflectiveMessage Message
+type reflectiveMftFileEntry MftFileEntry
+type reflectiveMsOfficeConnectionCacheEntry MsOfficeConnectionCacheEntry
+type reflectiveNetworkConnectingThread NetworkConnectingThread
+type reflectiveNetworkSession NetworkSession
+type reflectiveNetworkShare NetworkShare
+type reflectivePSMacEntry PSMacEntry
+type reflectivePlatformInfoAIX PlatformInfoAIX
+type reflectivePlatformInfoLinux PlatformInfoLinux
+type reflectivePlatformInfoMacos PlatformInfoMacos
+type reflectivePlatformInfoWindows PlatformInfoWindows
+type reflectivePluginStructuredData PluginStructuredData
+type reflectivePrefetchInfo PrefetchInfo
+type reflectiveProcess Process
+type reflectiveProcessConnectionObject ProcessConnectionObject
+type reflectiveProcessHandle ProcessHandle
+type reflectiveProfileFolder ProfileFolder
+type reflectiveQuarantineEvent QuarantineEvent
+type reflectiveRawFirewallRule RawFirewallRule
+type reflectiveReason Reason
+type reflectiveRegisteredDebugger RegisteredDebugger
+type reflectiveRegistryKey RegistryKey
+type reflectiveRegistryScheduledTask RegistryScheduledTask
+type reflectiveRegistryValue RegistryValue
+type reflectiveRootkit Rootkit
+type reflectiveSRUMResourceUsageEntry SRUMResourceUsageEntry
+type reflectiveScanInfo ScanInfo
+type reflectiveScheduledTask ScheduledTask
+type reflectiveSdbEntry SdbEntry
+type reflectiveSdnQueryEntry SdnQueryEntry
+type reflectiveShellbagEntry ShellbagEntry
+type reflectiveShimCache ShimCache
+type reflectiveShimCacheEntry ShimCacheEntry
+type reflectiveSparseData SparseData
+type reflectiveSystemdService SystemdService
+type reflectiveTeamViewerPassword TeamViewerPassword
+type reflectiveThread Thread
+type reflectiveTomcatUser TomcatUser
+type reflectiveUALEntry UALEntry
+type reflectiveUnixPermissions UnixPermissions
+type reflectiveUnixUser UnixUser
+type reflectiveUsnEntry UsnEntry
+type reflectiveWebDownload WebDownload
+type reflectiveWebPageVisit WebPageVisit
+type reflectiveWindowsEvent WindowsEvent
+type reflectiveWindowsEventlogEntry WindowsEventlogEntry
+type reflectiveWindowsMutex WindowsMutex
+type reflectiveWindowsPermissions WindowsPermissions
+type reflectiveWindowsPipe WindowsPipe
+type reflectiveWindowsPipeList WindowsPipeList
+type reflectiveWindowsService WindowsService
+type reflectiveWindowsUser WindowsUser
+type reflectiveWmiElement WmiElement
+type reflectiveWmiStartupCommand WmiStartupCommand
+
+// reflectiveObjects converts log objects with generated methods into types with the same fields,
+// but without the generated methods, so that the reflection based implementations are used for them.
+var reflectiveObjects = map[reflect.Type]func(object jsonlog.Object) any{
+	reflect.TypeOf(&AmcacheEntry{}):     func(object jsonlog.Object) any { return (*reflectiveAmcacheEntry)(object.(*AmcacheEntry)) },
+	reflect.TypeOf(&AntiVirusExclude{}): func(object jsonlog.Object) any { return (*reflectiveAntiVirusExclude)(object.(*AntiVirusExclude)) },
+	reflect.TypeOf(&AntiVirusProduct{}): func(object jsonlog.Object) any { return (*reflectiveAntiVirusProduct)(object.(*AntiVirusProduct)) },
+	reflect.TypeOf(&Assessment{}):       func(object jsonlog.Object) any { return (*reflectiveAssessment)(object.(*Assessment)) },
+	reflect.TypeOf(&AtJob{}):            func(object jsonlog.Object) any { return (*reflectiveAtJob)(object.(*AtJob)) },
+	reflect.TypeOf(&AuditLogEntry{}):    func(object jsonlog.Object) any { return (*reflectiveAuditLogEntry)(object.(*AuditLogEntry)) },
+	reflect.TypeOf(&AuthorizedKeysEntry{}): func(object jsonlog.Object) any {
+		return (*reflectiveAuthorizedKeysEntry)(object.(*AuthorizedKeysEntry))
+	},
+	reflect.TypeOf(&AutorunEntry{}):      func(object jsonlog.Object) any { return (*reflectiveAutorunEntry)(object.(*AutorunEntry)) },
+	reflect.TypeOf(&CronJob{}):           func(object jsonlog.Object) any { return (*reflectiveCronJob)(object.(*CronJob)) },
+	reflect.TypeOf(&DeepDiveChunk{}):     func(object jsonlog.Object) any { return (*reflectiveDeepDiveChunk)(object.(*DeepDiveChunk)) },
+	reflect.TypeOf(&DetectionAddEntry{}): func(object jsonlog.Object) any { return (*reflectiveDetectionAddEntry)(object.(*DetectionAddEntry)) },
+	reflect.TypeOf(&DnsCacheEntry{}):     func(object jsonlog.Object) any { return (*reflectiveDnsCacheEntry)(object.(*DnsCacheEntry)) },
+	reflect.TypeOf(&DoublePulsarHandshake{}): func(object jsonlog.Object) any {
+		return (*reflectiveDoublePulsarHandshake)(object.(*DoublePulsarHandshake))
+	},
+	reflect.TypeOf(&EBPFProgram{}):       func(object jsonlog.Object) any { return (*reflectiveEBPFProgram)(object.(*EBPFProgram)) },
+	reflect.TypeOf(&EmsDetectionEntry{}): func(object jsonlog.Object) any { return (*reflectiveEmsDetectionEntry)(object.(*EmsDetectionEntry)) },
+	reflect.TypeOf(&EndOfLifeReport{}):   func(object jsonlog.Object) any { return (*reflectiveEndOfLifeReport)(object.(*EndOfLifeReport)) },
+	reflect.TypeOf(&EnvironmentVariable{}): func(object jsonlog.Object) any {
+		return (*reflectiveEnvironmentVariable)(object.(*EnvironmentVariable))
+	},
+	reflect.TypeOf(&EstimatedImpactEntry{}): func(object jsonlog.Object) any {
+		return (*reflectiveEstimatedImpactEntry)(object.(*EstimatedImpactEntry))
+	},
+	reflect.TypeOf(&EventlogProcessStart{}): func(object jsonlog.Object) any {
+		return (*reflectiveEventlogProcessStart)(object.(*EventlogProcessStart))
+	},
+	reflect.TypeOf(&File{}):              func(object jsonlog.Object) any { return (*reflectiveFile)(object.(*File)) },
+	reflect.TypeOf(&FirewallRule{}):      func(object jsonlog.Object) any { return (*reflectiveFirewallRule)(object.(*FirewallRule)) },
+	reflect.TypeOf(&GroupsXmlUser{}):     func(object jsonlog.Object) any { return (*reflectiveGroupsXmlUser)(object.(*GroupsXmlUser)) },
+	reflect.TypeOf(&HostInfo{}):          func(object jsonlog.Object) any { return (*reflectiveHostInfo)(object.(*HostInfo)) },
+	reflect.TypeOf(&HostsFileEntry{}):    func(object jsonlog.Object) any { return (*reflectiveHostsFileEntry)(object.(*HostsFileEntry)) },
+	reflect.TypeOf(&HotfixSummary{}):     func(object jsonlog.Object) any { return (*reflectiveHotfixSummary)(object.(*HotfixSummary)) },
+	reflect.TypeOf(&InitdService{}):      func(object jsonlog.Object) any { return (*reflectiveInitdService)(object.(*InitdService)) },
+	reflect.TypeOf(&JournaldEntry{}):     func(object jsonlog.Object) any { return (*reflectiveJournaldEntry)(object.(*JournaldEntry)) },
+	reflect.TypeOf(&JumplistEntry{}):     func(object jsonlog.Object) any { return (*reflectiveJumplistEntry)(object.(*JumplistEntry)) },
+	reflect.TypeOf(&KnowledgeDBEntry{}):  func(object jsonlog.Object) any { return (*reflectiveKnowledgeDBEntry)(object.(*KnowledgeDBEntry)) },
+	reflect.TypeOf(&LinuxKernelModule{}): func(object jsonlog.Object) any { return (*reflectiveLinuxKernelModule)(object.(*LinuxKernelModule)) },
+	reflect.TypeOf(&LogLine{}):           func(object jsonlog.Object) any { return (*reflectiveLogLine)(object.(*LogLine)) },
+	reflect.TypeOf(&LoggedInUser{}):      func(object jsonlog.Object) any { return (*reflectiveLoggedInUser)(object.(*LoggedInUser)) },
+	reflect.TypeOf(&LsaSession{}):        func(object jsonlog.Object) any { return (*reflectiveLsaSession)(object.(*LsaSession)) },
+	reflect.TypeOf(&Message{}):           func(object jsonlog.Object) any { return (*reflectiveMessage)(object.(*Message)) },
+	reflect.TypeOf(&MftFileEntry{}):      func(object jsonlog.Object) any { return (*reflectiveMftFileEntry)(object.(*MftFileEntry)) },
+	reflect.TypeOf(&MsOfficeConnectionCacheEntry{}): func(object jsonlog.Object) any {
+		return (*reflectiveMsOfficeConnectionCacheEntry)(object.(*MsOfficeConnectionCacheEntry))
+	},
+	reflect.TypeOf(&NetworkConnectingThread{}): func(object jsonlog.Object) any {
+		return (*reflectiveNetworkConnectingThread)(object.(*NetworkConnectingThread))
+	},
+	reflect.TypeOf(&NetworkSession{}):    func(object jsonlog.Object) any { return (*reflectiveNetworkSession)(object.(*NetworkSession)) },
+	reflect.TypeOf(&NetworkShare{}):      func(object jsonlog.Object) any { return (*reflectiveNetworkShare)(object.(*NetworkShare)) },
+	reflect.TypeOf(&PSMacEntry{}):        func(object jsonlog.Object) any { return (*reflectivePSMacEntry)(object.(*PSMacEntry)) },
+	reflect.TypeOf(&PlatformInfoAIX{}):   func(object jsonlog.Object) any { return (*reflectivePlatformInfoAIX)(object.(*PlatformInfoAIX)) },
+	reflect.TypeOf(&PlatformInfoLinux{}): func(object jsonlog.Object) any { return (*reflectivePlatformInfoLinux)(object.(*PlatformInfoLinux)) },
+	reflect.TypeOf(&PlatformInfoMacos{}): func(object jsonlog.Object) any { return (*reflectivePlatformInfoMacos)(object.(*PlatformInfoMacos)) },
+	reflect.TypeOf(&PlatformInfoWindows{}): func(object jsonlog.Object) any {
+		return (*reflectivePlatformInfoWindows)(object.(*PlatformInfoWindows))
+	},
+	reflect.TypeOf(&PluginStructuredData{}): func(object jsonlog.Object) any {
+		return (*reflectivePluginStructuredData)(object.(*PluginStructuredData))
+	},
+	reflect.TypeOf(&PrefetchInfo{}): func(object jsonlog.Object) any { return (*reflectivePrefetchInfo)(object.(*PrefetchInfo)) },
+	reflect.TypeOf(&Process{}):      func(object jsonlog.Object) any { return (*reflectiveProcess)(object.(*Process)) },
+	reflect.TypeOf(&ProcessConnectionObject{}): func(object jsonlog.Object) any {
+		return (*reflectiveProcessConnectionObject)(object.(*ProcessConnectionObject))
+	},
+	reflect.TypeOf(&ProcessHandle{}):      func(object jsonlog.Object) any { return (*reflectiveProcessHandle)(object.(*ProcessHandle)) },
+	reflect.TypeOf(&ProfileFolder{}):      func(object jsonlog.Object) any { return (*reflectiveProfileFolder)(object.(*ProfileFolder)) },
+	reflect.TypeOf(&QuarantineEvent{}):    func(object jsonlog.Object) any { return (*reflectiveQuarantineEvent)(object.(*QuarantineEvent)) },
+	reflect.TypeOf(&RawFirewallRule{}):    func(object jsonlog.Object) any { return (*reflectiveRawFirewallRule)(object.(*RawFirewallRule)) },
+	reflect.TypeOf(&Reason{}):             func(object jsonlog.Object) any { return (*reflectiveReason)(object.(*Reason)) },
+	reflect.TypeOf(&RegisteredDebugger{}): func(object jsonlog.Object) any { return (*reflectiveRegisteredDebugger)(object.(*RegisteredDebugger)) },
+	reflect.TypeOf(&RegistryKey{}):        func(object jsonlog.Object) any { return (*reflectiveRegistryKey)(object.(*RegistryKey)) },
+	reflect.TypeOf(&RegistryScheduledTask{}): func(object jsonlog.Object) any {
+		return (*reflectiveRegistryScheduledTask)(object.(*RegistryScheduledTask))
+	},
+	reflect.TypeOf(&RegistryValue{}): func(object jsonlog.Object) any { return (*reflectiveRegistryValue)(object.(*RegistryValue)) },
+	reflect.TypeOf(&Rootkit{}):       func(object jsonlog.Object) any { return (*reflectiveRootkit)(object.(*Rootkit)) },
+	reflect.TypeOf(&SRUMResourceUsageEntry{}): func(object jsonlog.Object) any {
+		return (*reflectiveSRUMResourceUsageEntry)(object.(*SRUMResourceUsageEntry))
+	},
+	reflect.TypeOf(&ScanInfo{}):           func(object jsonlog.Object) any { return (*reflectiveScanInfo)(object.(*ScanInfo)) },
+	reflect.TypeOf(&ScheduledTask{}):      func(object jsonlog.Object) any { return (*reflectiveScheduledTask)(object.(*ScheduledTask)) },
+	reflect.TypeOf(&SdbEntry{}):           func(object jsonlog.Object) any { return (*reflectiveSdbEntry)(object.(*SdbEntry)) },
+	reflect.TypeOf(&SdnQueryEntry{}):      func(object jsonlog.Object) any { return (*reflectiveSdnQueryEntry)(object.(*SdnQueryEntry)) },
+	reflect.TypeOf(&ShellbagEntry{}):      func(object jsonlog.Object) any { return (*reflectiveShellbagEntry)(object.(*ShellbagEntry)) },
+	reflect.TypeOf(&ShimCache{}):          func(object jsonlog.Object) any { return (*reflectiveShimCache)(object.(*ShimCache)) },
+	reflect.TypeOf(&ShimCacheEntry{}):     func(object jsonlog.Object) any { return (*reflectiveShimCacheEntry)(object.(*ShimCacheEntry)) },
+	reflect.TypeOf(&SparseData{}):         func(object jsonlog.Object) any { return (*reflectiveSparseData)(object.(*SparseData)) },
+	reflect.TypeOf(&SystemdService{}):     func(object jsonlog.Object) any { return (*reflectiveSystemdService)(object.(*SystemdService)) },
+	reflect.TypeOf(&TeamViewerPassword{}): func(object jsonlog.Object) any { return (*reflectiveTeamViewerPassword)(object.(*TeamViewerPassword)) },
+	reflect.TypeOf(&Thread{}):             func(object jsonlog.Object) any { return (*reflectiveThread)(object.(*Thread)) },
+	reflect.TypeOf(&TomcatUser{}):         func(object jsonlog.Object) any { return (*reflectiveTomcatUser)(object.(*TomcatUser)) },
+	reflect.TypeOf(&UALEntry{}):           func(object jsonlog.Object) any { return (*reflectiveUALEntry)(object.(*UALEntry)) },
+	reflect.TypeOf(&UnixPermissions{}):    func(object jsonlog.Object) any { return (*reflectiveUnixPermissions)(object.(*UnixPermissions)) },
+	reflect.TypeOf(&UnixUser{}):           func(object jsonlog.Object) any { return (*reflectiveUnixUser)(object.(*UnixUser)) },
+	reflect.TypeOf(&UsnEntry{}):           func(object jsonlog.Object) any { return (*reflectiveUsnEntry)(object.(*UsnEntry)) },
+	reflect.TypeOf(&WebDownload{}):        func(object jsonlog.Object) any { return (*reflectiveWebDownload)(object.(*WebDownload)) },
+	reflect.TypeOf(&WebPageVisit{}):       func(object jsonlog.Object) any { return (*reflectiveWebPageVisit)(object.(*WebPageVisit)) },
+	reflect.TypeOf(&WindowsEvent{}):       func(object jsonlog.Object) any { return (*reflectiveWindowsEvent)(object.(*WindowsEvent)) },
+	reflect.TypeOf(&WindowsEventlogEntry{}): func(object jsonlog.Object) any {
+		return (*reflectiveWindowsEventlogEntry)(object.(*WindowsEventlogEntry))
+	},
+	reflect.TypeOf(&WindowsMutex{}):       func(object jsonlog.Object) any { return (*reflectiveWindowsMutex)(object.(*WindowsMutex)) },
+	reflect.TypeOf(&WindowsPermissions{}): func(object jsonlog.Object) any { return (*reflectiveWindowsPermissions)(object.(*WindowsPermissions)) },
+	reflect.TypeOf(&WindowsPipe{}):        func(object jsonlog.Object) any { return (*reflectiveWindowsPipe)(object.(*WindowsPipe)) },
+	reflect.TypeOf(&WindowsPipeList{}):    func(object jsonlog.Object) any { return (*reflectiveWindowsPipeList)(object.(*WindowsPipeList)) },
+	reflect.TypeOf(&WindowsService{}):     func(object jsonlog.Object) any { return (*reflectiveWindowsService)(object.(*WindowsService)) },
+	reflect.TypeOf(&WindowsUser{}):        func(object jsonlog.Object) any { return (*reflectiveWindowsUser)(object.(*WindowsUser)) },
+	reflect.TypeOf(&WmiElement{}):         func(object jsonlog.Object) any { return (*reflectiveWmiElement)(object.(*WmiElement)) },
+	reflect.TypeOf(&WmiStartupCommand{}):  func(object jsonlog.Object) any { return (*reflectiveWmiStartupCommand)(object.(*WmiStartupCommand)) },
+}
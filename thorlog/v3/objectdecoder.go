@@ -0,0 +1,283 @@
+package thorlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"github.com/NextronSystems/jsonlog/jsonpointer"
+)
+
+// objectDecoder unmarshals JSON into Go values, resolving embedded log objects using a registry.
+//
+// Since encoding/json offers no way to pass the registry to nested UnmarshalJSON methods,
+// values that contain embedded log objects are walked by the objectDecoder itself if a registry
+// other than DefaultRegistry is used. All other values are unmarshalled using encoding/json.
+//
+// All errors returned by an objectDecoder are UnmarshalErrors. If encoding/json fails,
+// the value is decoded again, walking all values, to locate the element that caused the error.
+type objectDecoder struct {
+	registry *Registry
+	// strict causes unknown fields to be rejected, like json.Decoder.DisallowUnknownFields.
+	strict bool
+	// pointer points to the decoded value within the JSON passed to the outermost UnmarshalJSON call.
+	pointer jsonpointer.Pointer
+	// walk causes all values to be walked by the objectDecoder, not only those that embed log objects.
+	walk bool
+}
+
+// registryUnmarshaler is implemented by types whose UnmarshalJSON method unmarshals embedded log objects.
+// unmarshalJSONWith must behave like UnmarshalJSON, but use the decoder for all unmarshalling.
+// All errors that it returns must be UnmarshalErrors that are located using the decoder's pointer.
+type registryUnmarshaler interface {
+	unmarshalJSONWith(data []byte, d objectDecoder) error
+}
+
+var registryUnmarshalerType = reflect.TypeOf((*registryUnmarshaler)(nil)).Elem()
+
+// at returns a decoder for the element with the given key or index.
+func (d objectDecoder) at(token string) objectDecoder {
+	d.pointer = appendPointer(d.pointer, token)
+	return d
+}
+
+// errorAt returns an UnmarshalError for the element with the given key or index.
+func (d objectDecoder) errorAt(token string, err error) error {
+	return newUnmarshalError(d.at(token).pointer, err)
+}
+
+// unmarshal unmarshals data into the value that v points to.
+func (d objectDecoder) unmarshal(data []byte, v any) error {
+	if d.walk || d.registry.orDefault() != DefaultRegistry {
+		return d.decodeValue(data, reflect.ValueOf(v).Elem())
+	}
+	err := d.unmarshalPlain(data, v)
+	if err == nil {
+		return nil
+	}
+	// Decode again into an empty value, walking all values, to locate the error
+	d.walk = true
+	locatedErr := d.decodeValue(data, reflect.New(reflect.TypeOf(v).Elem()).Elem())
+	if locatedErr != nil {
+		return locatedErr
+	}
+	return newUnmarshalError(d.pointer, err)
+}
+
+func (d objectDecoder) unmarshalPlain(data []byte, v any) error {
+	if !d.strict {
+		return json.Unmarshal(data, v)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+// decodeLeaf decodes a value using encoding/json.
+func (d objectDecoder) decodeLeaf(data []byte, v reflect.Value) error {
+	if err := d.unmarshalPlain(data, v.Addr().Interface()); err != nil {
+		return newUnmarshalError(d.pointer, err)
+	}
+	return nil
+}
+
+func (d objectDecoder) decodeValue(data []byte, v reflect.Value) error {
+	err := d.decodeNonLeafValue(data, v)
+	if err != nil && v.Addr().Type().Implements(objectInterfaceType) {
+		return withObjectType(data, err)
+	}
+	return err
+}
+
+func (d objectDecoder) decodeNonLeafValue(data []byte, v reflect.Value) error {
+	if !d.isWalked(v.Type()) {
+		return d.decodeLeaf(data, v)
+	}
+	trimmedData := bytes.TrimSpace(data)
+	if string(trimmedData) == "null" {
+		switch v.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+			v.Set(reflect.Zero(v.Type()))
+		}
+		return nil
+	}
+
+	if unmarshaler, isUnmarshaler := v.Addr().Interface().(registryUnmarshaler); isUnmarshaler {
+		// Like encoding/json, don't pass on strictness to custom unmarshalers
+		d.strict = false
+		return unmarshaler.unmarshalJSONWith(data, d)
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return d.decodeValue(data, v.Elem())
+	case reflect.Interface:
+		var embeddedObject EmbeddedObject
+		if err := embeddedObject.unmarshalJSONWith(data, d); err != nil {
+			return err
+		}
+		if embeddedObject.Object == nil || !reflect.TypeOf(embeddedObject.Object).Implements(v.Type()) {
+			return newUnmarshalError(d.pointer, unexpectedObjectError(embeddedObject.Object, v.Type().Name()))
+		}
+		v.Set(reflect.ValueOf(embeddedObject.Object))
+		return nil
+	case reflect.Struct:
+		if len(trimmedData) == 0 || trimmedData[0] != '{' {
+			return d.decodeLeaf(data, v)
+		}
+		return d.decodeStruct(data, v)
+	case reflect.Slice, reflect.Array:
+		if len(trimmedData) == 0 || trimmedData[0] != '[' {
+			return d.decodeLeaf(data, v)
+		}
+		var elements []json.RawMessage
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return newUnmarshalError(d.pointer, err)
+		}
+		if v.Kind() == reflect.Slice {
+			v.Set(reflect.MakeSlice(v.Type(), len(elements), len(elements)))
+		}
+		for i := 0; i < v.Len(); i++ {
+			if i >= len(elements) {
+				v.Index(i).Set(reflect.Zero(v.Type().Elem()))
+			} else if err := d.at(strconv.Itoa(i)).decodeValue(elements[i], v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		if len(trimmedData) == 0 || trimmedData[0] != '{' {
+			return d.decodeLeaf(data, v)
+		}
+		var elements map[string]json.RawMessage
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return newUnmarshalError(d.pointer, err)
+		}
+		if v.IsNil() {
+			v.Set(reflect.MakeMapWithSize(v.Type(), len(elements)))
+		}
+		for key, rawElement := range elements {
+			element := reflect.New(v.Type().Elem()).Elem()
+			if err := d.at(key).decodeValue(rawElement, element); err != nil {
+				return err
+			}
+			v.SetMapIndex(reflect.ValueOf(key).Convert(v.Type().Key()), element)
+		}
+		return nil
+	default:
+		return d.decodeLeaf(data, v)
+	}
+}
+
+func (d objectDecoder) decodeStruct(data []byte, v reflect.Value) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if _, err := decoder.Token(); err != nil {
+		return newUnmarshalError(d.pointer, err)
+	}
+	fields := jsonFields(v.Type())
+	for decoder.More() {
+		keyToken, err := decoder.Token()
+		if err != nil {
+			return newUnmarshalError(d.pointer, err)
+		}
+		key, _ := keyToken.(string)
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return d.errorAt(key, err)
+		}
+		field, found := findJsonField(fields, key)
+		if !found {
+			if d.strict {
+				return d.errorAt(key, errors.New("unknown field"))
+			}
+			continue
+		}
+		if err := d.at(key).decodeValue(value, fieldByIndex(v, field.Index)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fieldByIndex works like reflect.Value.FieldByIndex, but allocates nil pointers to embedded structs.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i, fieldIndex := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(fieldIndex)
+	}
+	return v
+}
+
+// isWalked returns whether values of the given type are walked by the decoder,
+// or whether they are passed to encoding/json.
+func (d objectDecoder) isWalked(t reflect.Type) bool {
+	if !d.walk {
+		return embedsLogObjects(t)
+	}
+	if t.Kind() != reflect.Interface && reflect.PointerTo(t).Implements(unmarshalerType) {
+		return reflect.PointerTo(t).Implements(registryUnmarshalerType)
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		return t.Implements(objectInterfaceType)
+	case reflect.Map:
+		return t.Key().Kind() == reflect.String
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Struct:
+		return true
+	default:
+		return false
+	}
+}
+
+var embedsLogObjectsCache sync.Map
+
+// embedsLogObjects returns whether unmarshalling a value of the given type involves
+// resolving log object types, i.e. whether it depends on the registry that is used.
+func embedsLogObjects(t reflect.Type) bool {
+	if cached, isCached := embedsLogObjectsCache.Load(t); isCached {
+		return cached.(bool)
+	}
+	result := typeEmbedsLogObjects(t, map[reflect.Type]bool{})
+	embedsLogObjectsCache.Store(t, result)
+	return result
+}
+
+func typeEmbedsLogObjects(t reflect.Type, visited map[reflect.Type]bool) bool {
+	if visited[t] {
+		return false
+	}
+	visited[t] = true
+
+	if t.Kind() != reflect.Interface && reflect.PointerTo(t).Implements(unmarshalerType) {
+		// Types with custom unmarshalling can only be handled if they support it explicitly
+		return reflect.PointerTo(t).Implements(registryUnmarshalerType)
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		return t.Implements(objectInterfaceType)
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return typeEmbedsLogObjects(t.Elem(), visited)
+	case reflect.Map:
+		return t.Key().Kind() == reflect.String && typeEmbedsLogObjects(t.Elem(), visited)
+	case reflect.Struct:
+		for _, field := range jsonFields(t) {
+			if typeEmbedsLogObjects(field.Type, visited) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
@@ -9,22 +9,132 @@ import (
 // LogObjectTypes is a map of all log object types. Each log object type must be registered using AddLogObjectType.
 var LogObjectTypes = map[string]jsonlog.Object{}
 
+// DefaultRegistry is the registry that contains all log object types in LogObjectTypes.
+// It is used whenever no other registry is specified.
+var DefaultRegistry = &Registry{
+	types: LogObjectTypes,
+	names: map[string]string{},
+}
+
 // FindLogObjectType looks up a log object type by name, ignoring case. It
 // returns the correctly-folded name (with which the type can be found in
 // LogObjectTypes) and true if found, or "" and false if not found.
 func FindLogObjectType(name string) (string, bool) {
-	for registeredName := range LogObjectTypes {
-		if strings.EqualFold(registeredName, name) {
-			return registeredName, true
-		}
-	}
-	return "", false
+	return DefaultRegistry.Find(name)
 }
 
 // AddLogObjectType registers a new log object type. It panics if a log object type with the same name is already registered.
 func AddLogObjectType(name string, obj jsonlog.Object) {
-	if _, ok := LogObjectTypes[name]; ok {
+	DefaultRegistry.Add(name, obj)
+}
+
+// Registry maps log object type names to the Go types that are used to unmarshal them.
+//
+// A registry may have a parent registry, in which case all types of the parent are
+// available in the registry as well, unless they are overridden.
+// This allows e.g. replacing the type of a single log object with a custom implementation
+// without affecting other users of the parent registry.
+//
+// A Registry must not be modified while it, or a registry derived from it, is in use.
+// Lookups, on the other hand, are safe for concurrent use.
+type Registry struct {
+	parent *Registry
+	types  map[string]jsonlog.Object
+	// names maps lower case names to the names in types.
+	names map[string]string
+}
+
+// NewRegistry creates a new registry that contains all types of parent, if parent is not nil.
+func NewRegistry(parent *Registry) *Registry {
+	return &Registry{
+		parent: parent,
+		types:  map[string]jsonlog.Object{},
+		names:  map[string]string{},
+	}
+}
+
+// Add registers a new log object type. It panics if a log object type with the same name
+// is already registered in this registry or one of its parents.
+func (r *Registry) Add(name string, obj jsonlog.Object) {
+	if r.Lookup(name) != nil {
 		panic("duplicate log object type: " + name)
 	}
-	LogObjectTypes[name] = obj
+	r.Override(name, obj)
+}
+
+// Override registers a log object type, replacing any type with the same name that is
+// registered in this registry or one of its parents.
+// If obj is nil, the type is removed from the registry, and objects of this type are unmarshalled as UnknownObject.
+func (r *Registry) Override(name string, obj jsonlog.Object) {
+	r.types[name] = obj
+	r.names[strings.ToLower(name)] = name
+}
+
+// Lookup returns the registered log object for the given type name, or nil if the type is not registered.
+// Unlike Find, the name must match exactly.
+func (r *Registry) Lookup(name string) jsonlog.Object {
+	for registry := r; registry != nil; registry = registry.parent {
+		if obj, found := registry.types[name]; found {
+			return obj
+		}
+	}
+	return nil
+}
+
+// Find looks up a log object type by name, ignoring case. It
+// returns the correctly-folded name (with which the type can be found using Lookup)
+// and true if found, or "" and false if not found.
+func (r *Registry) Find(name string) (string, bool) {
+	lowerName := strings.ToLower(name)
+	for registry := r; registry != nil; registry = registry.parent {
+		registeredName, found := registry.names[lowerName]
+		if !found {
+			// Types may have been added to LogObjectTypes directly, without an entry in names
+			registeredName, found = registry.scan(name)
+		}
+		if !found {
+			continue
+		}
+		if registry.types[registeredName] == nil {
+			return "", false
+		}
+		return registeredName, true
+	}
+	return "", false
+}
+
+// scan looks up a name in the types of this registry (without its parents), ignoring case.
+// If several names match, the lexicographically smallest one is returned.
+func (r *Registry) scan(name string) (string, bool) {
+	var match string
+	var found bool
+	for registeredName := range r.types {
+		if strings.EqualFold(registeredName, name) && (!found || registeredName < match) {
+			match, found = registeredName, true
+		}
+	}
+	return match, found
+}
+
+// Types returns all log object types in this registry, including those of its parents, by name.
+func (r *Registry) Types() map[string]jsonlog.Object {
+	var types = map[string]jsonlog.Object{}
+	if r.parent != nil {
+		types = r.parent.Types()
+	}
+	for name, obj := range r.types {
+		if obj == nil {
+			delete(types, name)
+		} else {
+			types[name] = obj
+		}
+	}
+	return types
+}
+
+func (r *Registry) orDefault() *Registry {
+	if r == nil {
+		return DefaultRegistry
+	}
+	return r
 }
@@ -0,0 +1,111 @@
+package thorlog
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type customFile struct {
+	jsonlog.ObjectHeader
+
+	Path  string `json:"path" textlog:"file"`
+	Owner string `json:"owner" textlog:"owner"`
+}
+
+func (customFile) observed() {}
+
+func TestRegistry_Find(t *testing.T) {
+	name, found := DefaultRegistry.Find("FILE")
+	assert.True(t, found)
+	assert.Equal(t, typeFile, name)
+
+	name, found = FindLogObjectType("Windows Permissions")
+	assert.True(t, found)
+	assert.Equal(t, typeWindowsPermissions, name)
+
+	_, found = FindLogObjectType("no such type")
+	assert.False(t, found)
+
+	registry := NewRegistry(DefaultRegistry)
+	registry.Add("Custom File", &customFile{})
+	registry.Override(typeProcess, nil)
+	name, found = registry.Find("custom file")
+	assert.True(t, found)
+	assert.Equal(t, "Custom File", name)
+	name, found = registry.Find("FILE")
+	assert.True(t, found)
+	assert.Equal(t, typeFile, name)
+	_, found = registry.Find(typeProcess)
+	assert.False(t, found)
+	_, found = DefaultRegistry.Find("custom file")
+	assert.False(t, found)
+}
+
+func TestRegistry_FindDirectlyAdded(t *testing.T) {
+	LogObjectTypes["Directly Added"] = &customFile{}
+	defer delete(LogObjectTypes, "Directly Added")
+
+	name, found := FindLogObjectType("directly added")
+	assert.True(t, found)
+	assert.Equal(t, "Directly Added", name)
+	name, found = NewRegistry(DefaultRegistry).Find("DIRECTLY ADDED")
+	assert.True(t, found)
+	assert.Equal(t, "Directly Added", name)
+}
+
+func TestRegistry_Add(t *testing.T) {
+	registry := NewRegistry(DefaultRegistry)
+	assert.Panics(t, func() { registry.Add(typeFile, &customFile{}) })
+	assert.NotPanics(t, func() { registry.Override(typeFile, &customFile{}) })
+	assert.Equal(t, &customFile{}, registry.Lookup(typeFile))
+	assert.Equal(t, &File{}, DefaultRegistry.Lookup(typeFile))
+}
+
+func TestRegistry_Types(t *testing.T) {
+	registry := NewRegistry(DefaultRegistry)
+	registry.Override(typeFile, &customFile{})
+	registry.Override(typeProcess, nil)
+
+	types := registry.Types()
+	assert.Equal(t, &customFile{}, types[typeFile])
+	assert.NotContains(t, types, typeProcess)
+	assert.Equal(t, len(LogObjectTypes)-1, len(types))
+}
+
+func TestEmbeddedObject_UnmarshalJSON_Registry(t *testing.T) {
+	const assessment = `{"type":"THOR assessment","meta":{"time":"2024-09-24T14:18:46+02:00","level":"Alert","module":"Test","scan_id":"abdc","event_id":"","hostname":"host"},"message":"test","subject":{"type":"file","path":"path/to/file","owner":"root"},"score":70,"reasons":null,"context":[{"object":{"type":"file","path":"other"},"relations":[{"relation_type":"related to","relation_name":"","unique":false}]},{"object":{"type":"process","pid":5,"image":{"type":"file","path":"/bin/sh","permissions":{"type":"Unix permissions","owner":"root"}}},"relations":[{"relation_type":"related to","relation_name":"","unique":false}]}],"log_version":"v3.0.0"}`
+
+	registry := NewRegistry(DefaultRegistry)
+	registry.Override(typeFile, &customFile{})
+
+	var object = EmbeddedObject{Registry: registry}
+	require.NoError(t, json.Unmarshal([]byte(assessment), &object))
+	parsedAssessment, isAssessment := object.Object.(*Assessment)
+	require.True(t, isAssessment)
+	assert.Equal(t, &customFile{
+		ObjectHeader: jsonlog.ObjectHeader{Type: typeFile},
+		Path:         "path/to/file",
+		Owner:        "root",
+	}, parsedAssessment.Subject)
+
+	require.Len(t, parsedAssessment.EventContext, 2)
+	assert.IsType(t, &customFile{}, parsedAssessment.EventContext[0].Object)
+	process, isProcess := parsedAssessment.EventContext[1].Object.(*Process)
+	require.True(t, isProcess)
+	require.NotNil(t, process.Image)
+	assert.Equal(t, "/bin/sh", process.Image.Path)
+	assert.IsType(t, &UnixPermissions{}, process.Image.Permissions)
+
+	// Nested objects are resolved using the registry, too
+	registry.Override(typeUnixPermissions, nil)
+	assert.EqualError(t, json.Unmarshal([]byte(assessment), &object), "/context/1/object/image/permissions: unknown type 'Unix permissions'")
+
+	// The default registry is not affected by the overrides
+	var defaultObject EmbeddedObject
+	require.NoError(t, json.Unmarshal([]byte(assessment), &defaultObject))
+	assert.IsType(t, &File{}, defaultObject.Object.(*Assessment).Subject)
+}
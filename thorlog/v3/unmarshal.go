@@ -1,14 +1,15 @@
 package thorlog
 
 import (
-	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 
 	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/jsonpointer"
 )
 
 var ErrNoLogObject = errors.New("JSON does not contain a log object")
@@ -35,38 +36,76 @@ func (u *UnknownObject) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-func (u UnknownObject) MarshalTextLog(f jsonlog.TextlogFormatter) (jsonlog.TextlogEntry, error) {
-	return marshalUnknownJsonObject(f, "", u.Data)
+// MarshalTextLog formats the data of the object for the text log. Nested objects and lists are flattened,
+// with keys in the same style as for known objects, e.g. OUTER_INNER for nested objects and KEY_1 for lists.
+// Keys are sorted to produce a deterministic output. Like for known objects, the type is not included.
+//
+// Values that can not be represented in the text log are omitted and reported to the formatter's OnError function.
+func (u UnknownObject) MarshalTextLog(f jsonlog.TextlogFormatter) jsonlog.TextlogEntry {
+	var fields jsonlog.TextlogEntry
+	for _, key := range sortedKeys(u.Data) {
+		if key == "type" {
+			continue
+		}
+		fields = append(fields, marshalUnknownJsonObject(f, key, u.Data[key])...)
+	}
+	return fields
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
-func marshalUnknownJsonObject(f jsonlog.TextlogFormatter, k string, v any) (jsonlog.TextlogEntry, error) {
+func marshalUnknownJsonObject(f jsonlog.TextlogFormatter, k string, v any) jsonlog.TextlogEntry {
 	var fields jsonlog.TextlogEntry
 	switch value := v.(type) {
 	case map[string]any:
-		subfields, err := UnknownObject{Data: value}.MarshalTextLog(f)
-		if err != nil {
-			return nil, err
-		}
-		for _, subfield := range subfields {
-			fields = append(fields, jsonlog.TextlogValuePair{
-				Key:   jsonlog.ConcatTextLabels(k, subfield.Key),
-				Value: subfield.Value,
-			})
+		for _, subkey := range sortedKeys(value) {
+			fields = append(fields, marshalUnknownJsonObject(f, jsonlog.ConcatTextLabels(k, subkey), value[subkey])...)
 		}
 	case []any:
 		for i, subvalue := range value {
-			subfields, err := marshalUnknownJsonObject(f, k, subvalue)
-			if err != nil {
-				return nil, err
+			fields = append(fields, marshalUnknownJsonList(f, k, i, subvalue)...)
+		}
+	default:
+		reflectValue := reflect.ValueOf(value)
+		switch reflectValue.Kind() {
+		case reflect.Map:
+			// Not created by json.Unmarshal, but e.g. by a converter
+			var keys = make(map[string]reflect.Value, reflectValue.Len())
+			for _, mapKey := range reflectValue.MapKeys() {
+				keys[fmt.Sprint(mapKey.Interface())] = mapKey
+			}
+			var subvalues = make(map[string]any, len(keys))
+			for subkey, mapKey := range keys {
+				subvalues[subkey] = reflectValue.MapIndex(mapKey).Interface()
+			}
+			return marshalUnknownJsonObject(f, k, subvalues)
+		case reflect.Slice, reflect.Array:
+			if reflectValue.Type().Elem().Kind() == reflect.Uint8 {
+				break // Byte slices are formatted as a single value
 			}
-			for _, subfield := range subfields {
-				fields = append(fields, jsonlog.TextlogValuePair{
-					Key:   jsonlog.ConcatTextLabels(subfield.Key, strconv.Itoa(i+1)),
-					Value: subfield.Value,
-				})
+			for i := 0; i < reflectValue.Len(); i++ {
+				fields = append(fields, marshalUnknownJsonList(f, k, i, reflectValue.Index(i).Interface())...)
 			}
+			return fields
+		case reflect.Struct:
+			for _, subfield := range f.Format(value) {
+				subfield.Key = jsonlog.ConcatTextLabels(k, subfield.Key)
+				fields = append(fields, subfield)
+			}
+			return fields
+		case reflect.Func, reflect.Chan, reflect.UnsafePointer, reflect.Complex64, reflect.Complex128:
+			if f.OnError != nil {
+				f.OnError(fmt.Errorf("cannot format %s: unsupported value of type %T", k, value))
+			}
+			return nil
 		}
-	default:
 		var formattedValue string
 		if f.FormatValue != nil {
 			formattedValue = f.FormatValue(value, nil)
@@ -78,33 +117,64 @@ func marshalUnknownJsonObject(f jsonlog.TextlogFormatter, k string, v any) (json
 			Value: formattedValue,
 		})
 	}
-	return fields, nil
+	return fields
+}
+
+// marshalUnknownJsonList formats the element with the given index in a list, appending the (one-based) index to its keys.
+func marshalUnknownJsonList(f jsonlog.TextlogFormatter, k string, index int, v any) jsonlog.TextlogEntry {
+	subfields := marshalUnknownJsonObject(f, k, v)
+	for i := range subfields {
+		subfields[i].Key = jsonlog.ConcatTextLabels(subfields[i].Key, strconv.Itoa(index+1))
+	}
+	return subfields
 }
 
 // EmbeddedObject is a utility type for unmarshalling THOR log objects from JSON.
 type EmbeddedObject struct {
 	jsonlog.Object
+
+	// Mode controls how fields in the JSON that are not part of the object type are handled.
+	// It must be set before unmarshalling. By default, such fields cause unmarshalling to fail.
+	Mode DecodeMode `json:"-"`
+	// UnknownFields contains the fields that were ignored during unmarshalling
+	// if Mode is DecodeLenient or DecodeCollect.
+	UnknownFields UnknownFields `json:"-"`
+	// UnknownPointers contains the JSON pointers of the fields that were ignored during unmarshalling
+	// if Mode is DecodeCollect.
+	UnknownPointers []jsonpointer.Pointer `json:"-"`
+	// Registry is used to look up the object type, and the types of all objects embedded within the object.
+	// If it is nil, DefaultRegistry is used.
+	Registry *Registry `json:"-"`
 }
 
 func (e *EmbeddedObject) UnmarshalJSON(data []byte) error {
+	return e.unmarshalJSONWith(data, objectDecoder{})
+}
+
+func (e *EmbeddedObject) unmarshalJSONWith(data []byte, d objectDecoder) error {
 	var details map[string]any
 	err := json.Unmarshal(data, &details)
 	if err != nil {
-		return err
+		return newUnmarshalError(d.pointer, err)
 	}
 	if details == nil {
 		return nil
 	}
 	objectType, exists := details["type"]
 	if !exists {
-		return ErrNoLogObject
+		return newUnmarshalError(d.pointer, ErrNoLogObject)
 	}
 	objectTypeString, isString := objectType.(string)
 	if !isString {
-		return ErrNoLogObject
+		return newUnmarshalError(d.pointer, ErrNoLogObject)
 	}
 
-	objectBlank := LogObjectTypes[objectTypeString]
+	registry := e.Registry
+	if registry == nil {
+		registry = d.registry
+	}
+	registry = registry.orDefault()
+	objectBlank := registry.Lookup(objectTypeString)
 	if objectBlank == nil {
 		e.Object = &UnknownObject{
 			Data:         details,
@@ -114,9 +184,28 @@ func (e *EmbeddedObject) UnmarshalJSON(data []byte) error {
 	}
 	object := reflect.New(reflect.TypeOf(objectBlank).Elem()).Interface().(jsonlog.Object)
 
-	decoder := json.NewDecoder(bytes.NewReader(data))
-	decoder.DisallowUnknownFields()
-	err = decoder.Decode(object)
+	if e.Mode != DecodeStrict {
+		var unknownFields []unknownField
+		data, unknownFields, err = removeUnknownFields(data, reflect.TypeOf(object), jsonpointer.Pointer{}, registry)
+		if err != nil {
+			return &UnmarshalError{Pointer: d.pointer, Type: objectTypeString, Err: err}
+		}
+		e.UnknownFields = nil
+		e.UnknownPointers = nil
+		for _, field := range unknownFields {
+			if e.UnknownFields == nil {
+				e.UnknownFields = UnknownFields{}
+			}
+			e.UnknownFields[field.Pointer.String()] = field.Value
+			if e.Mode == DecodeCollect {
+				e.UnknownPointers = append(e.UnknownPointers, field.Pointer)
+			}
+		}
+	}
+
+	d.registry = registry
+	d.strict = true
+	err = d.unmarshal(data, object)
 	if err != nil {
 		return err
 	}
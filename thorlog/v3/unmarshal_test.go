@@ -0,0 +1,51 @@
+package thorlog
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnknownObject_MarshalTextLog(t *testing.T) {
+	const assessmentJson = `{"type":"THOR assessment","message":"test","subject":{"type":"new subject","name":"abc","size":12,"nested":{"b":"2","a":"1"},"list":["x",{"key":"y"}],"enabled":true},"context":[{"object":{"type":"new context object","id":"ctx"},"relations":[{"relation_type":"related to","relation_name":"other","unique":true}]}]}`
+
+	var assessment Assessment
+	require.NoError(t, json.Unmarshal([]byte(assessmentJson), &assessment))
+	require.IsType(t, &UnknownObject{}, assessment.Subject)
+
+	var formatter jsonlog.TextlogFormatter
+	for i := 0; i < 10; i++ { // Map ordering is random, so try multiple times
+		assert.Equal(t, jsonlog.TextlogEntry{
+			{Key: "ENABLED", Value: "true"},
+			{Key: "LIST_1", Value: "x"},
+			{Key: "LIST_KEY_2", Value: "y"},
+			{Key: "NAME", Value: "abc"},
+			{Key: "NESTED_A", Value: "1"},
+			{Key: "NESTED_B", Value: "2"},
+			{Key: "SIZE", Value: "12"},
+		}, formatter.Format(assessment.Subject))
+	}
+	assert.Equal(t, jsonlog.TextlogEntry{{Key: "OTHER_ID", Value: "ctx"}}, formatter.Format(assessment.EventContext))
+}
+
+func TestUnknownObject_MarshalTextLogErrors(t *testing.T) {
+	object := &UnknownObject{
+		ObjectHeader: jsonlog.ObjectHeader{Type: "custom"},
+		Data: map[string]any{
+			"callback": func() {},
+			"values":   map[int]string{2: "b", 1: "a"},
+		},
+	}
+
+	var errs []error
+	formatter := jsonlog.TextlogFormatter{OnError: func(err error) { errs = append(errs, err) }}
+	assert.Equal(t, jsonlog.TextlogEntry{
+		{Key: "VALUES_1", Value: "a"},
+		{Key: "VALUES_2", Value: "b"},
+	}, formatter.Format(object))
+	require.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], "cannot format callback: unsupported value of type func()")
+}
@@ -0,0 +1,76 @@
+package thorlog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/NextronSystems/jsonlog"
+	"github.com/NextronSystems/jsonlog/jsonpointer"
+)
+
+// UnmarshalError describes an error that occurred while unmarshalling a log object from JSON.
+type UnmarshalError struct {
+	// Pointer points to the JSON element that could not be unmarshalled.
+	// It is relative to the JSON that was passed to the outermost UnmarshalJSON call.
+	Pointer jsonpointer.Pointer
+	// Type is the type of the innermost log object that contains the element, if known.
+	Type string
+	// Err is the error that occurred.
+	Err error
+}
+
+func (u *UnmarshalError) Error() string {
+	if len(u.Pointer) == 0 {
+		return u.Err.Error()
+	}
+	return fmt.Sprintf("%s: %v", u.Pointer, u.Err)
+}
+
+func (u *UnmarshalError) Unwrap() error {
+	return u.Err
+}
+
+// newUnmarshalError wraps err in an UnmarshalError for the given pointer.
+// If err already is an UnmarshalError, its pointer is assumed to be relative to the given pointer.
+func newUnmarshalError(pointer jsonpointer.Pointer, err error) *UnmarshalError {
+	var unmarshalError *UnmarshalError
+	if errors.As(err, &unmarshalError) {
+		return &UnmarshalError{
+			Pointer: appendPointer(pointer, unmarshalError.Pointer...),
+			Type:    unmarshalError.Type,
+			Err:     unmarshalError.Err,
+		}
+	}
+	return &UnmarshalError{Pointer: pointer, Err: err}
+}
+
+// withObjectType sets the type of the log object in data as the type of err,
+// if err is an UnmarshalError that does not have a type yet.
+func withObjectType(data []byte, err error) error {
+	var unmarshalError *UnmarshalError
+	if errors.As(err, &unmarshalError) && unmarshalError.Type == "" {
+		var header jsonlog.ObjectHeader
+		if json.Unmarshal(data, &header) == nil {
+			unmarshalError.Type = header.Type
+		}
+	}
+	return err
+}
+
+// appendPointer appends tokens to a pointer without modifying the original pointer.
+func appendPointer(pointer jsonpointer.Pointer, tokens ...string) jsonpointer.Pointer {
+	return append(pointer[:len(pointer):len(pointer)], tokens...)
+}
+
+// unexpectedObjectError returns the error for an embedded object that may not appear at its position.
+func unexpectedObjectError(object jsonlog.Object, expected string) *UnmarshalError {
+	if object == nil {
+		return &UnmarshalError{Err: fmt.Errorf("missing %s", expected)}
+	}
+	objectType := object.EmbeddedHeader().Type
+	if _, isUnknown := object.(*UnknownObject); isUnknown {
+		return &UnmarshalError{Type: objectType, Err: fmt.Errorf("unknown type '%s'", objectType)}
+	}
+	return &UnmarshalError{Type: objectType, Err: fmt.Errorf("type '%s' is not a valid %s", objectType, expected)}
+}
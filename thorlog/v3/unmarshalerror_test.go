@@ -0,0 +1,116 @@
+package thorlog
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/NextronSystems/jsonlog/jsonpointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalError(t *testing.T) {
+	const relations = `"relations":[{"relation_type":"related to","relation_name":"","unique":false}]`
+	for _, testcase := range []struct {
+		name    string
+		json    string
+		target  any
+		pointer jsonpointer.Pointer
+		typ     string
+		message string
+		// sentinel is an error that the UnmarshalError should wrap
+		sentinel error
+		// typeError indicates that the UnmarshalError should wrap a *json.UnmarshalTypeError
+		typeError bool
+	}{
+		{
+			name:    "UnknownPermissionsType",
+			json:    `{"type":"THOR assessment","message":"test","subject":{"type":"file","path":"a"},"context":[{"object":{"type":"file","path":"b"},` + relations + `},{"object":{"type":"at job"},` + relations + `},{"object":{"type":"file","path":"c","permissions":{"type":"ACL v2"}},` + relations + `}]}`,
+			target:  &EmbeddedObject{},
+			pointer: jsonpointer.Pointer{"context", "2", "object", "permissions"},
+			typ:     "ACL v2",
+			message: "/context/2/object/permissions: unknown type 'ACL v2'",
+		},
+		{
+			name:    "InvalidSubjectType",
+			json:    `{"type":"THOR assessment","message":"test","subject":{"type":"Unix permissions"}}`,
+			target:  &Assessment{},
+			pointer: jsonpointer.Pointer{"subject"},
+			typ:     "Unix permissions",
+			message: "/subject: type 'Unix permissions' is not a valid observed object",
+		},
+		{
+			name:      "InvalidFieldValue",
+			json:      `{"type":"THOR assessment","message":"test","subject":{"type":"process","pid":1,"image":{"type":"file","path":"a","size":"large"}}}`,
+			target:    &EmbeddedObject{},
+			pointer:   jsonpointer.Pointer{"subject", "image", "size"},
+			typ:       "file",
+			typeError: true,
+		},
+		{
+			name:     "MissingObjectType",
+			json:     `{"object":{"path":"a"},` + relations + `}`,
+			target:   &ContextObject{},
+			pointer:  jsonpointer.Pointer{"object"},
+			sentinel: ErrNoLogObject,
+		},
+		{
+			name:    "UnresolvableReference",
+			json:    `{"type":"THOR assessment","message":"test","subject":{"type":"file","path":"a"},"reasons":[{"type":"reason","summary":"r","signature":{"score":1},"matched":[{"data":{"data":"abc","encoding":"plain"},"field":"/nonexistent"}]}]}`,
+			target:  &Assessment{},
+			pointer: jsonpointer.Pointer{"reasons", "0", "matched", "0", "field"},
+			typ:     "THOR assessment",
+		},
+		{
+			name:    "UnknownField",
+			json:    `{"type":"THOR message","message":"test","new_field":1}`,
+			target:  &EmbeddedObject{},
+			pointer: jsonpointer.Pointer{"new_field"},
+			typ:     "THOR message",
+			message: "/new_field: unknown field",
+		},
+		{
+			name:    "InvalidPlatformType",
+			json:    `{"type":"system information","hostname":"host","platform":{"type":"file","path":"a"}}`,
+			target:  &HostInfo{},
+			pointer: jsonpointer.Pointer{"platform"},
+			typ:     "file",
+			message: "/platform: type 'file' is not a valid platform information",
+		},
+		{
+			name:     "MissingRootObjectType",
+			json:     `{"path":"a"}`,
+			target:   &EmbeddedObject{},
+			sentinel: ErrNoLogObject,
+			message:  ErrNoLogObject.Error(),
+		},
+		{
+			name:      "InvalidNestedFieldValue",
+			json:      `{"type":"file","path":"a","permissions":{"type":"Unix permissions","owner":"root","mask":{"user":{"readable":"yes"}}}}`,
+			target:    &File{},
+			pointer:   jsonpointer.Pointer{"permissions", "mask", "user", "readable"},
+			typ:       "Unix permissions",
+			typeError: true,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			err := json.Unmarshal([]byte(testcase.json), testcase.target)
+			require.Error(t, err)
+			var unmarshalError *UnmarshalError
+			require.True(t, errors.As(err, &unmarshalError), "unexpected error type %T: %v", err, err)
+			assert.Equal(t, testcase.pointer, unmarshalError.Pointer)
+			assert.Equal(t, testcase.typ, unmarshalError.Type)
+			if testcase.message != "" {
+				assert.EqualError(t, err, testcase.message)
+			}
+			if testcase.sentinel != nil {
+				assert.ErrorIs(t, err, testcase.sentinel)
+			}
+			if testcase.typeError {
+				var typeError *json.UnmarshalTypeError
+				assert.ErrorAs(t, err, &typeError)
+			}
+		})
+	}
+}
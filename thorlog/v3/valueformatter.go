@@ -0,0 +1,122 @@
+package thorlog
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/NextronSystems/jsonlog"
+	"golang.org/x/exp/slices"
+)
+
+// ValueFormatter formats single values for the text log following THOR's conventions:
+//
+//   - time.Time values are formatted using TimeLayout, or TimeLayoutWithMillis for fields with the
+//     ModifierWithMilliseconds modifier. Zero times are formatted as an empty string.
+//   - time.Duration values are rounded to full seconds, or to milliseconds if they are shorter than a second.
+//   - nil values and nil pointers are formatted as an empty string; other pointers are dereferenced.
+//   - All other values, including types with a String method like Memory and HexNumber, are formatted using fmt.Sprint.
+//
+// These conventions can be extended or replaced using ModifierFormatters and TypeFormatters.
+type ValueFormatter struct {
+	// TimeLayout is the layout used for time.Time values.
+	TimeLayout string
+	// TimeLayoutWithMillis is the layout used for time.Time values in fields with the ModifierWithMilliseconds modifier.
+	TimeLayoutWithMillis string
+	// Location is the location that times are converted to before formatting.
+	// If it is nil, times are formatted in their own location.
+	Location *time.Location
+
+	// ModifierFormatters contains formatters for fields with specific textlog modifiers.
+	// If a field has multiple modifiers with a formatter, the formatter for the first modifier is used.
+	// ModifierFormatters take precedence over TypeFormatters.
+	ModifierFormatters map[string]func(value any, modifiers []string) string
+	// TypeFormatters contains formatters for values of specific types.
+	// They take precedence over the default conventions.
+	TypeFormatters map[reflect.Type]func(value any, modifiers []string) string
+}
+
+// NewValueFormatter creates a ValueFormatter with THOR's default time layouts.
+func NewValueFormatter() *ValueFormatter {
+	return &ValueFormatter{
+		TimeLayout:           "2006-01-02T15:04:05Z07:00",
+		TimeLayoutWithMillis: "2006-01-02T15:04:05.000Z07:00",
+		ModifierFormatters:   map[string]func(value any, modifiers []string) string{},
+		TypeFormatters:       map[reflect.Type]func(value any, modifiers []string) string{},
+	}
+}
+
+// NewTextlogFormatter creates a text log formatter that formats values using a ValueFormatter with the default settings.
+func NewTextlogFormatter() jsonlog.TextlogFormatter {
+	return NewValueFormatter().TextlogFormatter()
+}
+
+// TextlogFormatter returns a text log formatter that formats values using f.
+func (f *ValueFormatter) TextlogFormatter() jsonlog.TextlogFormatter {
+	return jsonlog.TextlogFormatter{FormatValue: f.Format}
+}
+
+// Format formats a single value with the given textlog modifiers.
+// It can be used as jsonlog.TextlogFormatter.FormatValue.
+func (f *ValueFormatter) Format(value any, modifiers []string) string {
+	for _, modifier := range modifiers {
+		if formatter := f.ModifierFormatters[modifier]; formatter != nil {
+			return formatter(value, modifiers)
+		}
+	}
+
+	reflectValue := reflect.ValueOf(value)
+	for reflectValue.Kind() == reflect.Ptr {
+		if reflectValue.IsNil() {
+			break
+		}
+		if formatter := f.TypeFormatters[reflectValue.Type()]; formatter != nil {
+			return formatter(value, modifiers)
+		}
+		reflectValue = reflectValue.Elem()
+		value = reflectValue.Interface()
+	}
+	if reflectValue.IsValid() {
+		if formatter := f.TypeFormatters[reflectValue.Type()]; formatter != nil {
+			return formatter(value, modifiers)
+		}
+	}
+
+	switch typedValue := value.(type) {
+	case nil:
+		return ""
+	case time.Time:
+		return f.formatTime(typedValue, modifiers)
+	case time.Duration:
+		return formatDuration(typedValue)
+	}
+	if reflectValue.Kind() == reflect.Ptr {
+		// nil pointer
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+func (f *ValueFormatter) formatTime(timestamp time.Time, modifiers []string) string {
+	if timestamp.IsZero() {
+		return ""
+	}
+	if f.Location != nil {
+		timestamp = timestamp.In(f.Location)
+	}
+	layout := f.TimeLayout
+	if slices.Contains(modifiers, ModifierWithMilliseconds) {
+		layout = f.TimeLayoutWithMillis
+	}
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	return timestamp.Format(layout)
+}
+
+func formatDuration(duration time.Duration) string {
+	if duration < time.Second && duration > -time.Second {
+		return duration.Round(time.Millisecond).String()
+	}
+	return duration.Round(time.Second).String()
+}
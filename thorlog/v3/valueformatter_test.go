@@ -0,0 +1,69 @@
+package thorlog
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValueFormatter_Format(t *testing.T) {
+	timestamp := time.Date(2024, 9, 24, 12, 35, 41, 123456789, time.UTC)
+	var nilTime *time.Time
+
+	formatter := NewValueFormatter()
+	for _, testcase := range []struct {
+		name      string
+		value     any
+		modifiers []string
+		expected  string
+	}{
+		{"Time", timestamp, nil, "2024-09-24T12:35:41Z"},
+		{"TimeWithMillis", timestamp, []string{"omitempty", ModifierWithMilliseconds}, "2024-09-24T12:35:41.123Z"},
+		{"TimePointer", &timestamp, nil, "2024-09-24T12:35:41Z"},
+		{"ZeroTime", time.Time{}, nil, ""},
+		{"NilTime", nilTime, nil, ""},
+		{"Nil", nil, nil, ""},
+		{"Duration", 90*time.Minute + 1500*time.Millisecond, nil, "1h30m2s"},
+		{"ShortDuration", 1234567 * time.Microsecond / 10, nil, "123ms"},
+		{"True", true, nil, "true"},
+		{"False", false, nil, "false"},
+		{"Memory", Memory(3 * 1024 * 1024), nil, "3MB"},
+		{"HexNumber", HexNumber(255), nil, "0xff"},
+		{"String", "text", nil, "text"},
+		{"Integer", 42, nil, "42"},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			assert.Equal(t, testcase.expected, formatter.Format(testcase.value, testcase.modifiers))
+		})
+	}
+}
+
+func TestValueFormatter_Hooks(t *testing.T) {
+	formatter := NewValueFormatter()
+	formatter.Location = time.FixedZone("UTC+2", 2*60*60)
+	formatter.TypeFormatters[reflect.TypeOf(Memory(0))] = func(value any, modifiers []string) string {
+		return "memory"
+	}
+	formatter.ModifierFormatters["upper"] = func(value any, modifiers []string) string {
+		return strings.ToUpper(value.(string))
+	}
+
+	memory := Memory(1)
+	assert.Equal(t, "memory", formatter.Format(memory, nil))
+	assert.Equal(t, "memory", formatter.Format(&memory, nil))
+	assert.Equal(t, "TEXT", formatter.Format("text", []string{"upper"}))
+	assert.Equal(t, "2024-09-24T14:35:41+02:00", formatter.Format(time.Date(2024, 9, 24, 12, 35, 41, 0, time.UTC), nil))
+}
+
+func TestNewTextlogFormatter(t *testing.T) {
+	modified := time.Date(2024, 9, 24, 12, 35, 41, 500000000, time.UTC)
+	file := NewFile("/tmp/file")
+	file.Filetimes = &Filetimes{Mtime: modified}
+
+	entry := NewTextlogFormatter().Format(file)
+	assert.Contains(t, entry.String(), "FILE: /tmp/file")
+	assert.Contains(t, entry.String(), "MODIFIED: 2024-09-24T12:35:41.500Z")
+}